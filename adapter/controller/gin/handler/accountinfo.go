@@ -4,7 +4,6 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
-	"strings"
 
 	"github.com/gin-gonic/gin"
 
@@ -33,44 +32,27 @@ func NewAccountInfoHandler(accountInfoUseCase usecase.AccountInfoUsecase, tokenU
 }
 
 func (a *AccountInfoHandler) GetAccountInformation(c *gin.Context) {
-	authorization := c.GetHeader("Authorization")
-	if authorization == "" {
-		logger.Info("authorization header is required")
-		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "access token is required"))
+	validatedToken, ok := authorizeBearer(c, a.tokenUsecase, usecase.AccountReadScope)
+	if !ok {
 		return
 	}
 
-	parts := strings.Fields(authorization)
-	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-		logger.Info("invalid authorization header")
-		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "invalid access token"))
-		return
-	}
-	token := parts[1]
-
-	validatedToken, err := a.tokenUsecase.Validate(token, "read:account_and_transactions")
-	if err != nil {
-		logger.Info(err.Error())
-		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "invalid access token"))
-		return
-	}
-
-	accountInfo, err := a.accountInfoUseCase.Get(validatedToken.CifNo)
+	accountInfo, err := a.accountInfoUseCase.Get(c.Request.Context(), validatedToken.ClientID, validatedToken.CifNo)
 	if err != nil {
 		if errors.Is(err, usecase.ErrAccountNotFound) || errors.Is(err, usecase.ErrAccountInactive) {
-			logger.Info(err.Error())
-			c.JSON(presenter.NewErrorResponse(http.StatusNotFound, "account not found"))
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeAccountNotFound))
 			return
 		}
-		logger.Error(err.Error())
-		c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, "internal server error"))
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
 		return
 	}
 	c.JSON(http.StatusOK, a.accountInfoToResponse(accountInfo))
 }
 
 func (a *AccountInfoHandler) GetTransactionList(c *gin.Context) {
-	c.JSON(presenter.NewErrorResponse(http.StatusNotImplemented, "not implemented"))
+	c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotImplemented, presenter.ErrorCodeNotImplemented))
 }
 
 func (a *AccountInfoHandler) accountInfoToResponse(accountInfo *usecase.AccountInfo) *presenter.AccountResponse {
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"go-banking-api/adapter/controller/gin/presenter"
@@ -26,8 +27,8 @@ func NewMockAccountInfoUsecase() *MockAccountInfoUsecase {
 	return &MockAccountInfoUsecase{}
 }
 
-func (m *MockAccountInfoUsecase) Get(cifNo int) (*usecase.AccountInfo, error) {
-	args := m.Called(cifNo)
+func (m *MockAccountInfoUsecase) Get(_ context.Context, clientID string, cifNo int) (*usecase.AccountInfo, error) {
+	args := m.Called(clientID, cifNo)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -54,8 +55,9 @@ func (suite *AccountInfoHandlerSuite) TestGet() {
 		Scopes:      "read:account_and_transactions",
 		ExpiresAt:   fixedNow.Add(1 * time.Hour),
 		CifNo:       1,
+		ClientID:    "client-1",
 	}, nil)
-	mockUsecase.On("Get", 1).Return(&usecase.AccountInfo{
+	mockUsecase.On("Get", "client-1", 1).Return(&usecase.AccountInfo{
 		Status:        entity.AccountStatusActive,
 		BranchCode:    "123",
 		AccountNumber: "1234567",
@@ -83,7 +85,7 @@ func (suite *AccountInfoHandlerSuite) TestGet() {
 	suite.Assert().Equal(fixedNow, accountResponse.BaseDate.Time)
 	suite.Assert().Equal("1234", accountResponse.Data.BankCode)
 	suite.Assert().Equal("123", accountResponse.Data.BranchCode)
-	suite.Assert().Equal(presenter.Active, accountResponse.Data.Status)
+	suite.Assert().Equal(presenter.AccountStatusActive, accountResponse.Data.Status)
 	suite.Assert().Equal("1", accountResponse.Data.AccountType)
 	suite.Assert().Equal("1234567", accountResponse.Data.AccountNumber)
 	suite.Assert().Equal("JPY", accountResponse.Data.Currency)
@@ -147,7 +149,7 @@ func (suite *AccountInfoHandlerSuite) TestGet_AccountNotFound() {
 		ExpiresAt:   time.Now().Add(1 * time.Hour),
 		CifNo:       1,
 	}, nil)
-	mockUsecase.On("Get", 1).Return(nil, usecase.ErrAccountNotFound)
+	mockUsecase.On("Get", mock.Anything, 1).Return(nil, usecase.ErrAccountNotFound)
 
 	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
 	request.Header.Set("Authorization", "Bearer access-token-1")
@@ -177,7 +179,7 @@ func (suite *AccountInfoHandlerSuite) TestGet_AccountInactive() {
 		ExpiresAt:   time.Now().Add(1 * time.Hour),
 		CifNo:       1,
 	}, nil)
-	mockUsecase.On("Get", 1).Return(nil, usecase.ErrAccountInactive)
+	mockUsecase.On("Get", mock.Anything, 1).Return(nil, usecase.ErrAccountInactive)
 
 	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
 	request.Header.Set("Authorization", "Bearer access-token-1")
@@ -231,7 +233,7 @@ func (suite *AccountInfoHandlerSuite) TestGet_UsecaseError() {
 		ExpiresAt:   time.Now().Add(1 * time.Hour),
 		CifNo:       1,
 	}, nil)
-	mockUsecase.On("Get", 1).Return(&usecase.AccountInfo{}, errors.New("db error"))
+	mockUsecase.On("Get", mock.Anything, 1).Return(&usecase.AccountInfo{}, errors.New("db error"))
 
 	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
 	request.Header.Set("Authorization", "Bearer access-token-1")
@@ -249,3 +251,34 @@ func (suite *AccountInfoHandlerSuite) TestGet_UsecaseError() {
 	suite.Assert().Equal(http.StatusInternalServerError, errorResponse.Error.Code)
 	suite.Assert().Equal("internal server error", errorResponse.Error.Message)
 }
+
+func (suite *AccountInfoHandlerSuite) TestGet_AccountNotFoundJapanese() {
+	mockUsecase := NewMockAccountInfoUsecase()
+	mockTokenUsecase := NewMockTokenUsecase()
+	suite.accountInfoHandler = NewAccountInfoHandler(mockUsecase, mockTokenUsecase, pkg.FixedClock{})
+
+	mockTokenUsecase.On("Validate", "access-token-1", "read:account_and_transactions").Return(&entity.Token{
+		AccessToken: "access-token-1",
+		Scopes:      "read:account_and_transactions",
+		ExpiresAt:   time.Now().Add(1 * time.Hour),
+		CifNo:       1,
+	}, nil)
+	mockUsecase.On("Get", mock.Anything, 1).Return(nil, usecase.ErrAccountNotFound)
+
+	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
+	request.Header.Set("Authorization", "Bearer access-token-1")
+	request.Header.Set("Accept-Language", "ja-JP,ja;q=0.9,en;q=0.8")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.accountInfoHandler.GetAccountInformation(ginContext)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var errorResponse presenter.ErrorResponse
+	err := json.Unmarshal(bodyBytes, &errorResponse)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	suite.Assert().Equal(http.StatusNotFound, errorResponse.Error.Code)
+	suite.Assert().Equal("口座が見つかりません", errorResponse.Error.Message)
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/api"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+type AuditHandler struct {
+	auditUsecase usecase.AuditUsecase
+	tokenUsecase usecase.TokenUsecase
+}
+
+func NewAuditHandler(auditUsecase usecase.AuditUsecase, tokenUsecase usecase.TokenUsecase) *AuditHandler {
+	return &AuditHandler{
+		auditUsecase: auditUsecase,
+		tokenUsecase: tokenUsecase,
+	}
+}
+
+func (a *AuditHandler) ListAuditEvents(c *gin.Context, params presenter.ListAuditEventsParams) {
+	validatedToken, ok := authorizeBearer(c, a.tokenUsecase, usecase.AuditReadScope)
+	if !ok {
+		return
+	}
+
+	filter := paramsToAuditFilter(params)
+	events, err := a.auditUsecase.List(c.Request.Context(), validatedToken.ClientID, filter)
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidAuditFilter) {
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusBadRequest, presenter.ErrorCodeInvalidRequest))
+			return
+		}
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		return
+	}
+	c.JSON(http.StatusOK, auditEventsToResponse(events, filter.Limit))
+}
+
+func paramsToAuditFilter(params presenter.ListAuditEventsParams) gateway.AuditFilter {
+	filter := gateway.AuditFilter{Limit: usecase.AuditListDefaultLimit}
+	if params.ClientId != nil {
+		filter.ClientID = *params.ClientId
+	}
+	if params.CifNo != nil {
+		filter.CifNo = *params.CifNo
+	}
+	if params.Operation != nil {
+		filter.Operation = entity.AuditOperation(*params.Operation)
+	}
+	if params.From != nil {
+		filter.From = *params.From
+	}
+	if params.To != nil {
+		filter.To = *params.To
+	}
+	if params.After != nil {
+		filter.AfterID = *params.After
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	return filter
+}
+
+// auditEventsToResponse は limit 件ちょうど返した場合のみ次のページのカーソルを付ける
+func auditEventsToResponse(events []entity.AuditEvent, limit int) *presenter.AuditEventListResponse {
+	data := presenter.AuditEventList{Events: make([]presenter.AuditEvent, 0, len(events))}
+	for _, event := range events {
+		response := presenter.AuditEvent{
+			Id:         event.ID,
+			OccurredAt: event.OccurredAt.UTC(),
+			Operation:  presenter.AuditOperation(event.Operation),
+			Outcome:    presenter.AuditEventOutcome(event.Outcome),
+			ClientId:   event.ClientID,
+			PrevHash:   event.PrevHash,
+			Hash:       event.Hash,
+		}
+		if event.Reason != "" {
+			response.Reason = &event.Reason
+		}
+		if event.CifNo != 0 {
+			response.CifNo = &event.CifNo
+		}
+		if event.RequestID != "" {
+			response.RequestId = &event.RequestID
+		}
+		data.Events = append(data.Events, response)
+	}
+	if len(events) > 0 && len(events) == limit {
+		nextCursor := events[len(events)-1].ID
+		data.NextCursor = &nextCursor
+	}
+	return &presenter.AuditEventListResponse{
+		ApiVersion: api.Version,
+		Data:       data,
+	}
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/usecase"
+)
+
+type MockAuditUsecase struct {
+	mock.Mock
+}
+
+func NewMockAuditUsecase() *MockAuditUsecase {
+	return &MockAuditUsecase{}
+}
+
+func (m *MockAuditUsecase) List(_ context.Context, clientID string, filter gateway.AuditFilter) ([]entity.AuditEvent, error) {
+	args := m.Called(clientID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AuditEvent), args.Error(1)
+}
+
+func (m *MockAuditUsecase) Verify(_ context.Context, anchorHash string) (*usecase.AuditVerification, error) {
+	args := m.Called(anchorHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.AuditVerification), args.Error(1)
+}
+
+type AuditHandlerSuite struct {
+	suite.Suite
+	auditUsecase *MockAuditUsecase
+	tokenUsecase *MockTokenUsecase
+	auditHandler *AuditHandler
+}
+
+func TestAuditHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditHandlerSuite))
+}
+
+func (suite *AuditHandlerSuite) SetupTest() {
+	suite.auditUsecase = NewMockAuditUsecase()
+	suite.tokenUsecase = NewMockTokenUsecase()
+	suite.auditHandler = NewAuditHandler(suite.auditUsecase, suite.tokenUsecase)
+}
+
+func (suite *AuditHandlerSuite) serve(authorization string, params presenter.ListAuditEventsParams) *httptest.ResponseRecorder {
+	request, _ := http.NewRequest("GET", "/api/v1/audit-events", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.auditHandler.ListAuditEvents(ginContext, params)
+	return w
+}
+
+func (suite *AuditHandlerSuite) TestList() {
+	occurredAt := time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC)
+	from := occurredAt.Add(-time.Hour)
+	cifNo := 1
+	limit := 2
+	suite.tokenUsecase.On("Validate", "audit-token", usecase.AuditReadScope).Return(&entity.Token{ClientID: "compliance"}, nil)
+	suite.auditUsecase.On("List", "compliance", gateway.AuditFilter{CifNo: 1, From: from, Limit: 2}).Return([]entity.AuditEvent{
+		{ID: 3, OccurredAt: occurredAt, Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess, ClientID: "client-1", CifNo: 1, RequestID: "req-1", PrevHash: "h2", Hash: "h3"},
+		{ID: 5, OccurredAt: occurredAt, Operation: entity.AuditOperationTokenRefresh, Outcome: entity.AuditOutcomeFailure, Reason: "invalid_refresh_token", ClientID: "client-1", CifNo: 1, PrevHash: "h4", Hash: "h5"},
+	}, nil)
+
+	w := suite.serve("Bearer audit-token", presenter.ListAuditEventsParams{CifNo: &cifNo, From: &from, Limit: &limit})
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.AuditEventListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Data.Events, 2)
+	first := response.Data.Events[0]
+	suite.Assert().Equal(int64(3), first.Id)
+	suite.Assert().Equal(presenter.AuditOperation("account.read"), first.Operation)
+	suite.Assert().Equal(presenter.AuditEventOutcome("success"), first.Outcome)
+	suite.Assert().Equal("req-1", *first.RequestId)
+	suite.Assert().Nil(first.Reason)
+	suite.Assert().Equal("h3", first.Hash)
+	suite.Assert().Equal("invalid_refresh_token", *response.Data.Events[1].Reason)
+	suite.Assert().Nil(response.Data.Events[1].RequestId)
+	suite.Require().NotNil(response.Data.NextCursor)
+	suite.Assert().Equal(int64(5), *response.Data.NextCursor)
+}
+
+func (suite *AuditHandlerSuite) TestListLastPage() {
+	suite.tokenUsecase.On("Validate", "audit-token", usecase.AuditReadScope).Return(&entity.Token{ClientID: "compliance"}, nil)
+	suite.auditUsecase.On("List", "compliance", gateway.AuditFilter{Limit: usecase.AuditListDefaultLimit}).Return([]entity.AuditEvent{}, nil)
+
+	w := suite.serve("Bearer audit-token", presenter.ListAuditEventsParams{})
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().JSONEq(`{"apiVersion":"v1","data":{"events":[]}}`, w.Body.String())
+}
+
+func (suite *AuditHandlerSuite) TestListWithoutScope() {
+	suite.tokenUsecase.On("Validate", "account-token", usecase.AuditReadScope).Return(nil, usecase.ErrInvalidScope)
+
+	w := suite.serve("Bearer account-token", presenter.ListAuditEventsParams{})
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.auditUsecase.AssertNotCalled(suite.T(), "List", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditHandlerSuite) TestListMissingAuthorization() {
+	w := suite.serve("", presenter.ListAuditEventsParams{})
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.auditUsecase.AssertNotCalled(suite.T(), "List", mock.Anything, mock.Anything)
+}
+
+func (suite *AuditHandlerSuite) TestListInvalidFilter() {
+	suite.tokenUsecase.On("Validate", "audit-token", usecase.AuditReadScope).Return(&entity.Token{ClientID: "compliance"}, nil)
+	suite.auditUsecase.On("List", "compliance", mock.Anything).Return(nil, usecase.ErrInvalidAuditFilter)
+
+	w := suite.serve("Bearer audit-token", presenter.ListAuditEventsParams{})
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *AuditHandlerSuite) TestListError() {
+	suite.tokenUsecase.On("Validate", "audit-token", usecase.AuditReadScope).Return(&entity.Token{ClientID: "compliance"}, nil)
+	suite.auditUsecase.On("List", "compliance", mock.Anything).Return(nil, errors.New("db error"))
+
+	w := suite.serve("Bearer audit-token", presenter.ListAuditEventsParams{})
+
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
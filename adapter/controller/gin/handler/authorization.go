@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/api"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// AuthorizationHandler は認可コードフローのエンドポイント。
+// /authorize は顧客のブラウザが開き、PAR の request_uri を受け取って銀行の同意画面にリダイレクトする。
+// /authorization-verifications は同意画面が承認・拒否するためのもので、顧客はアクセストークンの発行先から決める。
+// トークンとの交換は /token で行う（TokenHandler）。
+type AuthorizationHandler struct {
+	// authorizationCodeUsecase が nil の場合は認可コードフローを受け付けない
+	authorizationCodeUsecase usecase.AuthorizationCodeUsecase
+	tokenUsecase             usecase.TokenUsecase
+	// consentURI は /authorize から顧客を誘導する銀行の同意画面
+	consentURI string
+	// issuer はリダイレクトに付ける iss（RFC 9207）
+	issuer string
+}
+
+func NewAuthorizationHandler(authorizationCodeUsecase usecase.AuthorizationCodeUsecase, tokenUsecase usecase.TokenUsecase, consentURI string, issuer string) *AuthorizationHandler {
+	return &AuthorizationHandler{
+		authorizationCodeUsecase: authorizationCodeUsecase,
+		tokenUsecase:             tokenUsecase,
+		consentURI:               consentURI,
+		issuer:                   issuer,
+	}
+}
+
+func (h *AuthorizationHandler) Authorize(c *gin.Context, params presenter.AuthorizeParams) {
+	c.Header("Cache-Control", "no-store")
+	if h.authorizationCodeUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+
+	// redirect_uri は request_uri の認可リクエストでしか分からないため、エラーはクライアントにリダイレクトせずに返す
+	authorization, err := h.authorizationCodeUsecase.Authorize(c.Request.Context(), params.ClientId, params.RequestUri)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidRequestURI):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			writeAuthorizationRequestError(c, presenter.InvalidRequestUri, err.Error())
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
+	}
+	c.Redirect(http.StatusFound, withQuery(h.consentURI, url.Values{"authorization_id": {authorization.ID}}))
+}
+
+func (h *AuthorizationHandler) GetAuthorizationVerification(c *gin.Context, authorizationID string) {
+	if h.authorizationCodeUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	if _, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope); !ok {
+		return
+	}
+
+	detail, err := h.authorizationCodeUsecase.Get(c.Request.Context(), authorizationID)
+	if err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	scopes := detail.Scopes()
+	if scopes == nil {
+		scopes = []string{}
+	}
+	c.JSON(http.StatusOK, &presenter.AuthorizationVerificationResponse{
+		ApiVersion: api.Version,
+		Data: presenter.AuthorizationVerification{
+			ClientId:   detail.ClientID,
+			ClientName: detail.ClientName,
+			Scopes:     scopes,
+			ExpiresAt:  detail.ExpiresAt.UTC(),
+		},
+	})
+}
+
+func (h *AuthorizationHandler) ApproveAuthorizationVerification(c *gin.Context, authorizationID string) {
+	if h.authorizationCodeUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	authorization, err := h.authorizationCodeUsecase.Approve(c.Request.Context(), validatedToken.CifNo, authorizationID)
+	if err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	h.writeRedirect(c, authorization, url.Values{"code": {authorization.Code}})
+}
+
+func (h *AuthorizationHandler) DenyAuthorizationVerification(c *gin.Context, authorizationID string) {
+	if h.authorizationCodeUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	authorization, err := h.authorizationCodeUsecase.Deny(c.Request.Context(), validatedToken.CifNo, authorizationID)
+	if err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	h.writeRedirect(c, authorization, url.Values{"error": {"access_denied"}})
+}
+
+// writeRedirect は同意画面が顧客をクライアントに戻す redirect_uri を、state と iss（RFC 9207）を付けて返す
+func (h *AuthorizationHandler) writeRedirect(c *gin.Context, authorization *entity.AuthorizationCode, query url.Values) {
+	c.Header("Cache-Control", "no-store")
+	if authorization.State != "" {
+		query.Set("state", authorization.State)
+	}
+	query.Set("iss", h.issuer)
+	c.JSON(http.StatusOK, &presenter.AuthorizationRedirectResponse{
+		ApiVersion: api.Version,
+		Data:       presenter.AuthorizationRedirect{RedirectUri: withQuery(authorization.RedirectURI, query)},
+	})
+}
+
+func (h *AuthorizationHandler) writeVerificationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrAuthorizationNotFound):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+	case errors.Is(err, usecase.ErrSandboxCustomerRequired):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusForbidden, presenter.ErrorCodeSandboxCustomerRequired))
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
+
+// withQuery は rawURL のクエリを残したまま query を加える。rawURL は設定や登録時に検証済みのもの。
+func withQuery(rawURL string, query url.Values) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	merged := parsed.Query()
+	for key, values := range query {
+		merged[key] = values
+	}
+	parsed.RawQuery = merged.Encode()
+	return parsed.String()
+}
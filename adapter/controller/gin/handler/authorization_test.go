@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/usecase"
+)
+
+type MockAuthorizationCodeUsecase struct {
+	mock.Mock
+}
+
+func NewMockAuthorizationCodeUsecase() *MockAuthorizationCodeUsecase {
+	return &MockAuthorizationCodeUsecase{}
+}
+
+func (m *MockAuthorizationCodeUsecase) Authorize(ctx context.Context, clientID string, requestURI string) (*entity.AuthorizationCode, error) {
+	args := m.Called(clientID, requestURI)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthorizationCode), args.Error(1)
+}
+
+func (m *MockAuthorizationCodeUsecase) Get(ctx context.Context, id string) (*usecase.AuthorizationCodeDetail, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.AuthorizationCodeDetail), args.Error(1)
+}
+
+func (m *MockAuthorizationCodeUsecase) Approve(ctx context.Context, cifNo int, id string) (*entity.AuthorizationCode, error) {
+	args := m.Called(cifNo, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthorizationCode), args.Error(1)
+}
+
+func (m *MockAuthorizationCodeUsecase) Deny(ctx context.Context, cifNo int, id string) (*entity.AuthorizationCode, error) {
+	args := m.Called(cifNo, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AuthorizationCode), args.Error(1)
+}
+
+func (m *MockAuthorizationCodeUsecase) Exchange(ctx context.Context, client *entity.Client, code string, redirectURI string, codeVerifier string) (*entity.Token, error) {
+	args := m.Called(client, code, redirectURI, codeVerifier)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Token), args.Error(1)
+}
+
+type AuthorizationHandlerSuite struct {
+	suite.Suite
+	now                      time.Time
+	authorizationCodeUsecase *MockAuthorizationCodeUsecase
+	tokenUsecase             *MockTokenUsecase
+	handler                  *AuthorizationHandler
+}
+
+func TestAuthorizationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationHandlerSuite))
+}
+
+func (suite *AuthorizationHandlerSuite) SetupTest() {
+	suite.now = time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	suite.authorizationCodeUsecase = NewMockAuthorizationCodeUsecase()
+	suite.tokenUsecase = NewMockTokenUsecase()
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.handler = NewAuthorizationHandler(suite.authorizationCodeUsecase, suite.tokenUsecase, "https://bank.example.com/consent?lang=ja", "https://bank.example.com")
+}
+
+func (suite *AuthorizationHandlerSuite) authorize() *httptest.ResponseRecorder {
+	request := httptest.NewRequest("GET", "/api/v1/authorize?client_id=web-app&request_uri=urn%3Aietf%3Aparams%3Aoauth%3Arequest_uri%3Aabc", nil)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.handler.Authorize(ginContext, presenter.AuthorizeParams{ClientId: "web-app", RequestUri: "urn:ietf:params:oauth:request_uri:abc"})
+	ginContext.Writer.WriteHeaderNow()
+	return w
+}
+
+func (suite *AuthorizationHandlerSuite) newContext(method string, authorization string) (*gin.Context, *httptest.ResponseRecorder) {
+	request, _ := http.NewRequest(method, "/api/v1/authorization-verifications/authorization-1", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	return ginContext, w
+}
+
+func (suite *AuthorizationHandlerSuite) redirectURI(w *httptest.ResponseRecorder) *url.URL {
+	var response presenter.AuthorizationRedirectResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	redirectURI, err := url.Parse(response.Data.RedirectUri)
+	suite.Require().NoError(err)
+	return redirectURI
+}
+
+func (suite *AuthorizationHandlerSuite) TestAuthorize() {
+	suite.authorizationCodeUsecase.On("Authorize", "web-app", "urn:ietf:params:oauth:request_uri:abc").Return(&entity.AuthorizationCode{ID: "authorization-1"}, nil)
+
+	w := suite.authorize()
+
+	// 同意画面のクエリは残したまま authorization_id を付ける
+	suite.Require().Equal(http.StatusFound, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().Equal("https://bank.example.com/consent?authorization_id=authorization-1&lang=ja", w.Header().Get("Location"))
+}
+
+func (suite *AuthorizationHandlerSuite) TestAuthorizeErrors() {
+	suite.authorizationCodeUsecase.On("Authorize", "web-app", mock.Anything).Return(nil, fmt.Errorf("%w: expired", usecase.ErrInvalidRequestURI)).Once()
+	w := suite.authorize()
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	suite.Assert().Empty(w.Header().Get("Location"))
+	var response presenter.AuthorizationRequestError
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.InvalidRequestUri, response.Error)
+
+	suite.authorizationCodeUsecase.On("Authorize", "web-app", mock.Anything).Return(nil, errors.New("db error")).Once()
+	suite.Assert().Equal(http.StatusInternalServerError, suite.authorize().Code)
+}
+
+func (suite *AuthorizationHandlerSuite) TestGetVerification() {
+	suite.authorizationCodeUsecase.On("Get", "authorization-1").Return(&usecase.AuthorizationCodeDetail{
+		AuthorizationCode: entity.AuthorizationCode{
+			ID:        "authorization-1",
+			ClientID:  "web-app",
+			Scope:     "openid read:account_and_transactions",
+			ExpiresAt: suite.now.Add(10 * time.Minute),
+		},
+		ClientName: "Web App",
+	}, nil)
+
+	ginContext, w := suite.newContext("GET", "Bearer app-token")
+	suite.handler.GetAuthorizationVerification(ginContext, "authorization-1")
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.AuthorizationVerificationResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.AuthorizationVerification{
+		ClientId:   "web-app",
+		ClientName: "Web App",
+		Scopes:     []string{"openid", "read:account_and_transactions"},
+		ExpiresAt:  suite.now.Add(10 * time.Minute),
+	}, response.Data)
+}
+
+func (suite *AuthorizationHandlerSuite) TestApprove() {
+	suite.authorizationCodeUsecase.On("Approve", 1, "authorization-1").Return(&entity.AuthorizationCode{
+		Code:        "code-1",
+		RedirectURI: "https://app.example.com/callback?tenant=1",
+		State:       "state-1",
+	}, nil)
+
+	ginContext, w := suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveAuthorizationVerification(ginContext, "authorization-1")
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	redirectURI := suite.redirectURI(w)
+	suite.Assert().Equal("app.example.com", redirectURI.Host)
+	suite.Assert().Equal(url.Values{
+		"tenant": {"1"},
+		"code":   {"code-1"},
+		"state":  {"state-1"},
+		"iss":    {"https://bank.example.com"},
+	}, redirectURI.Query())
+}
+
+func (suite *AuthorizationHandlerSuite) TestDeny() {
+	suite.authorizationCodeUsecase.On("Deny", 1, "authorization-1").Return(&entity.AuthorizationCode{
+		Code:        "code-1",
+		RedirectURI: "https://app.example.com/callback",
+	}, nil)
+
+	ginContext, w := suite.newContext("POST", "Bearer app-token")
+	suite.handler.DenyAuthorizationVerification(ginContext, "authorization-1")
+
+	// 拒否した場合は認可コードを渡さない
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal(url.Values{
+		"error": {"access_denied"},
+		"iss":   {"https://bank.example.com"},
+	}, suite.redirectURI(w).Query())
+}
+
+func (suite *AuthorizationHandlerSuite) TestVerificationErrors() {
+	suite.authorizationCodeUsecase.On("Get", "authorization-1").Return(nil, usecase.ErrAuthorizationNotFound)
+	suite.authorizationCodeUsecase.On("Approve", 1, "authorization-1").Return(nil, usecase.ErrAuthorizationNotFound)
+	suite.authorizationCodeUsecase.On("Deny", 1, "authorization-1").Return(nil, errors.New("db error"))
+	suite.authorizationCodeUsecase.On("Approve", 1, "authorization-2").Return(nil, usecase.ErrSandboxCustomerRequired)
+
+	ginContext, w := suite.newContext("GET", "Bearer app-token")
+	suite.handler.GetAuthorizationVerification(ginContext, "authorization-1")
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveAuthorizationVerification(ginContext, "authorization-1")
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.DenyAuthorizationVerification(ginContext, "authorization-1")
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveAuthorizationVerification(ginContext, "authorization-2")
+	suite.Assert().Equal(http.StatusForbidden, w.Code)
+	suite.Assert().Contains(w.Body.String(), presenter.Message(presenter.ErrorCodeSandboxCustomerRequired, presenter.DefaultLanguage))
+
+	ginContext, w = suite.newContext("POST", "")
+	suite.handler.ApproveAuthorizationVerification(ginContext, "authorization-1")
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *AuthorizationHandlerSuite) TestDisabled() {
+	suite.handler = NewAuthorizationHandler(nil, suite.tokenUsecase, "", "")
+
+	suite.Assert().Equal(http.StatusNotFound, suite.authorize().Code)
+	for _, handle := range []func(*gin.Context, string){suite.handler.GetAuthorizationVerification, suite.handler.ApproveAuthorizationVerification, suite.handler.DenyAuthorizationVerification} {
+		ginContext, w := suite.newContext("POST", "Bearer app-token")
+		handle(ginContext, "authorization-1")
+		suite.Assert().Equal(http.StatusNotFound, w.Code)
+	}
+	suite.tokenUsecase.AssertNotCalled(suite.T(), "Validate", mock.Anything, mock.Anything)
+}
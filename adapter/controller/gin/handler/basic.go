@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// authenticateClient は Basic 認証のクライアント ID とシークレットを検証し、クライアントのレート制限を確認する（middleware.AllowRequest）。
+// 認証に失敗した場合や制限を超えた場合はエラーレスポンスを書き込んで false を返す。
+func authenticateClient(c *gin.Context, clientUsecase usecase.ClientUsecase) (*entity.Client, bool) {
+	clientID, clientSecret, err := parseBasicAuth(c)
+	if err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeClientAuthenticationRequired))
+		return nil, false
+	}
+	withLogFields(c, clientIDField(clientID))
+
+	client, err := clientUsecase.Authenticate(c.Request.Context(), clientID, clientSecret, c.ClientIP())
+	if err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeInvalidClient))
+		return nil, false
+	}
+	if !middleware.AllowRequest(c, client.ClientID, 0) {
+		return nil, false
+	}
+	return client, true
+}
+
+func parseBasicAuth(c *gin.Context) (string, string, error) {
+	authorization := c.GetHeader("Authorization")
+	if authorization == "" {
+		return "", "", errors.New("authorization header is required")
+	}
+
+	parts := strings.Fields(authorization)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Basic") {
+		return "", "", errors.New("invalid authorization header")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", "", errors.New("invalid basic auth")
+	}
+
+	credentials := strings.SplitN(string(decoded), ":", 2)
+	if len(credentials) != 2 {
+		return "", "", errors.New("invalid basic auth")
+	}
+
+	return credentials[0], credentials[1], nil
+}
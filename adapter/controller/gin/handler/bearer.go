@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// authorizeBearer は Authorization ヘッダーのアクセストークンを scope で検証し、発行先のレート制限を確認する（middleware.AllowRequest）。
+// 検証に失敗した場合や制限を超えた場合はエラーレスポンスを書き込んで false を返す。
+func authorizeBearer(c *gin.Context, tokenUsecase usecase.TokenUsecase, scope string) (*entity.Token, bool) {
+	accessToken, ok := parseBearer(c)
+	if !ok {
+		return nil, false
+	}
+
+	validatedToken, err := tokenUsecase.Validate(c.Request.Context(), accessToken, scope)
+	if err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeInvalidAccessToken))
+		return nil, false
+	}
+	withLogFields(c, clientIDField(validatedToken.ClientID))
+	if validatedToken.CifNo != 0 {
+		withLogFields(c, subjectField(validatedToken.CifNo))
+	}
+	if !middleware.AllowRequest(c, validatedToken.ClientID, validatedToken.CifNo) {
+		return nil, false
+	}
+	return validatedToken, true
+}
+
+// parseBearer は Authorization ヘッダーからトークンを取り出す。
+// ヘッダーがない場合や形式が誤っている場合はエラーレスポンスを書き込んで false を返す。
+func parseBearer(c *gin.Context) (string, bool) {
+	authorization := c.GetHeader("Authorization")
+	if authorization == "" {
+		logger.InfoContext(c.Request.Context(), "authorization header is required")
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeAccessTokenRequired))
+		return "", false
+	}
+
+	parts := strings.Fields(authorization)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		logger.InfoContext(c.Request.Context(), "invalid authorization header")
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeInvalidAccessToken))
+		return "", false
+	}
+	return parts[1], true
+}
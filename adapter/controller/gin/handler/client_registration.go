@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// ClientRegistrationHandler は RFC 7591 の登録エンドポイントと RFC 7592 の設定エンドポイント。
+// レスポンスは RFC の形式に合わせ、apiVersion / data で包まない。
+type ClientRegistrationHandler struct {
+	// clientRegistrationUsecase が nil の場合は動的クライアント登録を受け付けない
+	clientRegistrationUsecase usecase.ClientRegistrationUsecase
+	// baseURL は API の外部公開 URL（https://bank.example.com/api/v1 など）。registration_client_uri はこの下に置く。
+	// リクエストの Host ヘッダーは偽装できるため使わない。
+	baseURL string
+}
+
+func NewClientRegistrationHandler(clientRegistrationUsecase usecase.ClientRegistrationUsecase, baseURL string) *ClientRegistrationHandler {
+	return &ClientRegistrationHandler{clientRegistrationUsecase: clientRegistrationUsecase, baseURL: baseURL}
+}
+
+func (h *ClientRegistrationHandler) RegisterClient(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	// 登録はクライアント認証なしで受け付けるため、接続元 IP ごとに制限する
+	if !middleware.AllowRegistration(c) {
+		return
+	}
+	metadata, ok := h.bindMetadata(c, "")
+	if !ok {
+		return
+	}
+
+	client, credentials, err := h.clientRegistrationUsecase.Register(c.Request.Context(), metadata)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	withLogFields(c, clientIDField(client.ClientID))
+	response := h.clientToResponse(client)
+	response.ClientSecret = &credentials.ClientSecret
+	response.RegistrationAccessToken = &credentials.RegistrationAccessToken
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *ClientRegistrationHandler) GetClientConfiguration(c *gin.Context, clientId string) {
+	registrationAccessToken, ok := h.authorize(c, clientId)
+	if !ok {
+		return
+	}
+
+	client, err := h.clientRegistrationUsecase.Get(c.Request.Context(), clientId, registrationAccessToken)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, h.clientToResponse(client))
+}
+
+func (h *ClientRegistrationHandler) UpdateClientConfiguration(c *gin.Context, clientId string) {
+	registrationAccessToken, ok := h.authorize(c, clientId)
+	if !ok {
+		return
+	}
+	metadata, ok := h.bindMetadata(c, clientId)
+	if !ok {
+		return
+	}
+
+	client, credentials, err := h.clientRegistrationUsecase.Update(c.Request.Context(), clientId, registrationAccessToken, metadata)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	response := h.clientToResponse(client)
+	response.RegistrationAccessToken = &credentials.RegistrationAccessToken
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *ClientRegistrationHandler) DeleteClientConfiguration(c *gin.Context, clientId string) {
+	registrationAccessToken, ok := h.authorize(c, clientId)
+	if !ok {
+		return
+	}
+
+	if err := h.clientRegistrationUsecase.Delete(c.Request.Context(), clientId, registrationAccessToken); err != nil {
+		h.writeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// enabled は登録を受け付けない設定の場合に 404 を書き込んで false を返す
+func (h *ClientRegistrationHandler) enabled(c *gin.Context) bool {
+	// シークレットや登録アクセストークンを含むため、どのレスポンスもキャッシュさせない
+	c.Header("Cache-Control", "no-store")
+	if h.clientRegistrationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return false
+	}
+	return true
+}
+
+// authorize は設定エンドポイントの登録アクセストークンを取り出す
+func (h *ClientRegistrationHandler) authorize(c *gin.Context, clientID string) (string, bool) {
+	if !h.enabled(c) {
+		return "", false
+	}
+	withLogFields(c, clientIDField(clientID))
+	return parseBearer(c)
+}
+
+// bindMetadata はリクエストのメタデータを読み込む。clientID を指定した場合は本文の client_id と一致させる。
+func (h *ClientRegistrationHandler) bindMetadata(c *gin.Context, clientID string) (usecase.ClientMetadata, bool) {
+	var request presenter.ClientMetadata
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		h.writeRegistrationError(c, presenter.InvalidClientMetadata, "request body must be a client metadata object")
+		return usecase.ClientMetadata{}, false
+	}
+	if clientID != "" && request.ClientId != nil && *request.ClientId != clientID {
+		h.writeRegistrationError(c, presenter.InvalidClientMetadata, "client_id does not match the client configuration endpoint")
+		return usecase.ClientMetadata{}, false
+	}
+
+	metadata := usecase.ClientMetadata{ClientName: request.ClientName}
+	if request.RedirectUris != nil {
+		metadata.RedirectURIs = *request.RedirectUris
+	}
+	if request.GrantTypes != nil {
+		metadata.GrantTypes = *request.GrantTypes
+	}
+	if request.TokenEndpointAuthMethod != nil {
+		metadata.TokenEndpointAuthMethod = *request.TokenEndpointAuthMethod
+	}
+	if request.Scope != nil {
+		metadata.Scopes = strings.Fields(*request.Scope)
+	}
+	return metadata, true
+}
+
+func (h *ClientRegistrationHandler) clientToResponse(client *entity.Client) presenter.ClientInformation {
+	response := presenter.ClientInformation{
+		ClientId:                client.ClientID,
+		ClientName:              client.ClientName,
+		RedirectUris:            client.RedirectURIList(),
+		GrantTypes:              client.GrantTypeList(),
+		TokenEndpointAuthMethod: client.TokenEndpointAuthMethod,
+		Scope:                   client.Scope,
+		RegistrationClientUri:   h.baseURL + "/register/" + url.PathEscape(client.ClientID),
+	}
+	if response.RedirectUris == nil {
+		response.RedirectUris = []string{}
+	}
+	response.ClientIdIssuedAt = client.CreatedAt.Unix()
+	return response
+}
+
+func (h *ClientRegistrationHandler) writeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRedirectURI):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		h.writeRegistrationError(c, presenter.InvalidRedirectUri, err.Error())
+	case errors.Is(err, usecase.ErrInvalidClientMetadata):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		h.writeRegistrationError(c, presenter.InvalidClientMetadata, err.Error())
+	case errors.Is(err, usecase.ErrInvalidRegistrationToken):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeInvalidAccessToken))
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
+
+func (h *ClientRegistrationHandler) writeRegistrationError(c *gin.Context, code presenter.RegistrationErrorError, description string) {
+	c.JSON(http.StatusBadRequest, presenter.RegistrationError{Error: code, ErrorDescription: &description})
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/usecase"
+)
+
+type ClientRegistrationHandlerSuite struct {
+	suite.Suite
+	clientRegistrationUsecase *MockClientRegistrationUsecase
+	router                    *gin.Engine
+	client                    *entity.Client
+}
+
+func TestClientRegistrationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ClientRegistrationHandlerSuite))
+}
+
+func (suite *ClientRegistrationHandlerSuite) SetupTest() {
+	suite.clientRegistrationUsecase = NewMockClientRegistrationUsecase()
+	suite.router = suite.newRouter(NewClientRegistrationHandler(suite.clientRegistrationUsecase, "https://bank.example.com/api/v1"))
+	suite.client = &entity.Client{
+		ClientID:   "client-1",
+		ClientName: "sandbox app",
+		Scope:      "read:account_and_transactions",
+		// ローテーション後のシークレットしか残っていなくても、登録日時はクライアントのものを返す
+		Secrets:                 []entity.ClientSecret{{ID: 2, CreatedAt: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)}},
+		RedirectURIs:            "https://app.example.com/callback",
+		GrantTypes:              entity.GrantTypeRefreshToken,
+		TokenEndpointAuthMethod: entity.TokenEndpointAuthClientSecretBasic,
+		Sandbox:                 true,
+		CreatedAt:               time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// newRouter はパスの clientId を渡すため、ハンドラーを直接呼ばずにルーターを通す。rateLimitUsecase が nil の場合は制限しない。
+func (suite *ClientRegistrationHandlerSuite) newRouter(h *ClientRegistrationHandler, rateLimitUsecase ...usecase.RateLimitUsecase) *gin.Engine {
+	router := gin.New()
+	if len(rateLimitUsecase) > 0 {
+		router.Use(middleware.RateLimit(rateLimitUsecase[0]))
+	}
+	router.POST("/api/v1/register", h.RegisterClient)
+	router.GET("/api/v1/register/:clientId", func(c *gin.Context) { h.GetClientConfiguration(c, c.Param("clientId")) })
+	router.PUT("/api/v1/register/:clientId", func(c *gin.Context) { h.UpdateClientConfiguration(c, c.Param("clientId")) })
+	router.DELETE("/api/v1/register/:clientId", func(c *gin.Context) { h.DeleteClientConfiguration(c, c.Param("clientId")) })
+	return router
+}
+
+func (suite *ClientRegistrationHandlerSuite) do(method string, path string, body string, token string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(method, path, bytes.NewReader([]byte(body)))
+	request.Host = "api.example.com"
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	suite.router.ServeHTTP(w, request)
+	return w
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestRegisterClient() {
+	suite.clientRegistrationUsecase.On("Register", usecase.ClientMetadata{
+		ClientName:   "sandbox app",
+		RedirectURIs: []string{"https://app.example.com/callback"},
+		Scopes:       []string{"read:account_and_transactions"},
+	}).Return(suite.client, &usecase.RegistrationCredentials{ClientSecret: "secret-1", RegistrationAccessToken: "registration-token"}, nil)
+
+	w := suite.do(http.MethodPost, "/api/v1/register",
+		`{"client_name":"sandbox app","redirect_uris":["https://app.example.com/callback"],"scope":"read:account_and_transactions"}`, "")
+
+	suite.Require().Equal(http.StatusCreated, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	var response presenter.ClientInformation
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("client-1", response.ClientId)
+	// Host ヘッダーではなく設定した URL を使う
+	suite.Assert().Equal("https://bank.example.com/api/v1/register/client-1", response.RegistrationClientUri)
+	suite.Assert().Equal(int64(1766275200), response.ClientIdIssuedAt)
+	suite.Assert().Equal(int64(0), response.ClientSecretExpiresAt)
+	suite.Assert().Equal([]string{"refresh_token"}, response.GrantTypes)
+	suite.Require().NotNil(response.ClientSecret)
+	suite.Assert().Equal("secret-1", *response.ClientSecret)
+	suite.Require().NotNil(response.RegistrationAccessToken)
+	suite.Assert().Equal("registration-token", *response.RegistrationAccessToken)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestRegisterClientInvalidMetadata() {
+	suite.clientRegistrationUsecase.On("Register", usecase.ClientMetadata{ClientName: "app", RedirectURIs: []string{"http://app.example.com"}}).
+		Return(nil, nil, usecase.ErrInvalidRedirectURI)
+
+	w := suite.do(http.MethodPost, "/api/v1/register", `{"client_name":"app","redirect_uris":["http://app.example.com"]}`, "")
+	suite.Require().Equal(http.StatusBadRequest, w.Code)
+	var response presenter.RegistrationError
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.InvalidRedirectUri, response.Error)
+
+	w = suite.do(http.MethodPost, "/api/v1/register", `[]`, "")
+	suite.Require().Equal(http.StatusBadRequest, w.Code)
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.InvalidClientMetadata, response.Error)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestRegisterClientRateLimited() {
+	rateLimitUsecase := NewMockRateLimitUsecase()
+	rateLimitUsecase.On("AllowSource", "192.0.2.1").Return(nil, nil)
+	rateLimitUsecase.On("AllowRegistration", "192.0.2.1").Return(&gateway.RateLimitResult{Limit: 5, RetryAfter: 6 * time.Second}, usecase.ErrRateLimited)
+	suite.router = suite.newRouter(NewClientRegistrationHandler(suite.clientRegistrationUsecase, "https://bank.example.com/api/v1"), rateLimitUsecase)
+
+	w := suite.do(http.MethodPost, "/api/v1/register", `{"client_name":"app"}`, "")
+
+	suite.Assert().Equal(http.StatusTooManyRequests, w.Code)
+	suite.Assert().Equal("6", w.Header().Get(middleware.RetryAfterHeader))
+	suite.clientRegistrationUsecase.AssertNotCalled(suite.T(), "Register", mock.Anything)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestRegistrationDisabled() {
+	suite.router = suite.newRouter(NewClientRegistrationHandler(nil, "https://bank.example.com/api/v1"))
+
+	suite.Assert().Equal(http.StatusNotFound, suite.do(http.MethodPost, "/api/v1/register", `{"client_name":"app"}`, "").Code)
+	suite.Assert().Equal(http.StatusNotFound, suite.do(http.MethodGet, "/api/v1/register/client-1", "", "registration-token").Code)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestGetClientConfiguration() {
+	suite.clientRegistrationUsecase.On("Get", "client-1", "registration-token").Return(suite.client, nil)
+	suite.clientRegistrationUsecase.On("Get", "client-1", "wrong").Return(nil, usecase.ErrInvalidRegistrationToken)
+
+	w := suite.do(http.MethodGet, "/api/v1/register/client-1", "", "registration-token")
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.ClientInformation
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("https://bank.example.com/api/v1/register/client-1", response.RegistrationClientUri)
+	suite.Assert().Equal([]string{"https://app.example.com/callback"}, response.RedirectUris)
+	// 登録後はシークレットも登録アクセストークンも返さない
+	suite.Assert().Nil(response.ClientSecret)
+	suite.Assert().Nil(response.RegistrationAccessToken)
+
+	suite.Assert().Equal(http.StatusUnauthorized, suite.do(http.MethodGet, "/api/v1/register/client-1", "", "wrong").Code)
+	suite.Assert().Equal(http.StatusUnauthorized, suite.do(http.MethodGet, "/api/v1/register/client-1", "", "").Code)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestUpdateClientConfiguration() {
+	suite.clientRegistrationUsecase.On("Update", "client-1", "registration-token", usecase.ClientMetadata{ClientName: "renamed app"}).
+		Return(suite.client, &usecase.RegistrationCredentials{RegistrationAccessToken: "new-token"}, nil)
+
+	w := suite.do(http.MethodPut, "/api/v1/register/client-1", `{"client_id":"client-1","client_name":"renamed app"}`, "registration-token")
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.ClientInformation
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().NotNil(response.RegistrationAccessToken)
+	suite.Assert().Equal("new-token", *response.RegistrationAccessToken)
+
+	// 本文の client_id はパスと一致させる
+	w = suite.do(http.MethodPut, "/api/v1/register/client-1", `{"client_id":"client-2","client_name":"renamed app"}`, "registration-token")
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	suite.clientRegistrationUsecase.AssertNumberOfCalls(suite.T(), "Update", 1)
+}
+
+func (suite *ClientRegistrationHandlerSuite) TestDeleteClientConfiguration() {
+	suite.clientRegistrationUsecase.On("Delete", "client-1", "registration-token").Return(nil)
+	suite.clientRegistrationUsecase.On("Delete", "client-1", "wrong").Return(usecase.ErrInvalidRegistrationToken)
+
+	suite.Assert().Equal(http.StatusNoContent, suite.do(http.MethodDelete, "/api/v1/register/client-1", "", "registration-token").Code)
+	suite.Assert().Equal(http.StatusUnauthorized, suite.do(http.MethodDelete, "/api/v1/register/client-1", "", "wrong").Code)
+}
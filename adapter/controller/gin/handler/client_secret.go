@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/api"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// ClientSecretHandler は認証したクライアント自身のシークレットをローテーションする
+type ClientSecretHandler struct {
+	clientSecretUsecase usecase.ClientSecretUsecase
+	clientUsecase       usecase.ClientUsecase
+	clock               pkg.Clock
+}
+
+func NewClientSecretHandler(clientSecretUsecase usecase.ClientSecretUsecase, clientUsecase usecase.ClientUsecase, clock pkg.Clock) *ClientSecretHandler {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &ClientSecretHandler{
+		clientSecretUsecase: clientSecretUsecase,
+		clientUsecase:       clientUsecase,
+		clock:               clock,
+	}
+}
+
+func (h *ClientSecretHandler) ListClientSecrets(c *gin.Context) {
+	client, ok := authenticateClient(c, h.clientUsecase)
+	if !ok {
+		return
+	}
+
+	secrets, err := h.clientSecretUsecase.List(c.Request.Context(), client.ClientID)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	data := presenter.ClientSecretList{Secrets: make([]presenter.ClientSecret, 0, len(secrets))}
+	for _, secret := range secrets {
+		data.Secrets = append(data.Secrets, h.secretToResponse(secret))
+	}
+	c.JSON(http.StatusOK, &presenter.ClientSecretListResponse{ApiVersion: api.Version, Data: data})
+}
+
+func (h *ClientSecretHandler) CreateClientSecret(c *gin.Context) {
+	client, ok := authenticateClient(c, h.clientUsecase)
+	if !ok {
+		return
+	}
+
+	secret, plain, err := h.clientSecretUsecase.Add(c.Request.Context(), client.ClientID)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	data := h.secretToResponse(*secret)
+	data.ClientSecret = &plain
+	c.JSON(http.StatusCreated, &presenter.ClientSecretResponse{ApiVersion: api.Version, Data: data})
+}
+
+func (h *ClientSecretHandler) RetireClientSecret(c *gin.Context, secretId int64) {
+	client, ok := authenticateClient(c, h.clientUsecase)
+	if !ok {
+		return
+	}
+
+	// 本文は省略でき、その場合は直ちに失効させる
+	var request presenter.RetireClientSecretRequest
+	if err := c.ShouldBindJSON(&request); err != nil && !errors.Is(err, io.EOF) {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusBadRequest, presenter.ErrorCodeInvalidRequest))
+		return
+	}
+	var notAfter time.Time
+	if request.NotAfter != nil {
+		notAfter = *request.NotAfter
+	}
+
+	secret, err := h.clientSecretUsecase.Retire(c.Request.Context(), client.ClientID, secretId, notAfter)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, &presenter.ClientSecretResponse{ApiVersion: api.Version, Data: h.secretToResponse(*secret)})
+}
+
+func (h *ClientSecretHandler) secretToResponse(secret entity.ClientSecret) presenter.ClientSecret {
+	response := presenter.ClientSecret{
+		Id:        secret.ID,
+		CreatedAt: secret.CreatedAt.UTC(),
+		Active:    secret.IsActive(h.clock.Now()),
+	}
+	if secret.NotAfter != nil {
+		notAfter := secret.NotAfter.UTC()
+		response.NotAfter = &notAfter
+	}
+	return response
+}
+
+func (h *ClientSecretHandler) writeError(c *gin.Context, err error) {
+	lang := c.GetHeader("Accept-Language")
+	switch {
+	case errors.Is(err, usecase.ErrInvalidNotAfter):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(lang, http.StatusBadRequest, presenter.ErrorCodeInvalidRequest))
+	case errors.Is(err, usecase.ErrClientSecretNotFound):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(lang, http.StatusNotFound, presenter.ErrorCodeNotFound))
+	case errors.Is(err, usecase.ErrTooManyClientSecrets):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(lang, http.StatusConflict, presenter.ErrorCodeTooManyClientSecrets))
+	case errors.Is(err, usecase.ErrLastClientSecret):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(lang, http.StatusConflict, presenter.ErrorCodeLastClientSecret))
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(lang, http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
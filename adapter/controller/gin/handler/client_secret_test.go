@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/usecase"
+)
+
+type ClientSecretHandlerSuite struct {
+	suite.Suite
+	now                 time.Time
+	clientSecretUsecase *MockClientSecretUsecase
+	clientUsecase       *MockClientUsecase
+	handler             *ClientSecretHandler
+}
+
+func TestClientSecretHandlerSuite(t *testing.T) {
+	suite.Run(t, new(ClientSecretHandlerSuite))
+}
+
+func (suite *ClientSecretHandlerSuite) SetupTest() {
+	suite.now = time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	suite.clientSecretUsecase = NewMockClientSecretUsecase()
+	suite.clientUsecase = NewMockClientUsecase()
+	suite.clientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	suite.clientUsecase.On("Authenticate", "client-1", "wrong").Return(nil, usecase.ErrInvalidClient)
+	suite.handler = NewClientSecretHandler(suite.clientSecretUsecase, suite.clientUsecase, pkg.FixedClock{T: suite.now})
+}
+
+func (suite *ClientSecretHandlerSuite) newContext(method string, body string, secret string) (*gin.Context, *httptest.ResponseRecorder) {
+	request := httptest.NewRequest(method, "/api/v1/client/secrets", bytes.NewReader([]byte(body)))
+	request.SetBasicAuth("client-1", secret)
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	return ginContext, w
+}
+
+func (suite *ClientSecretHandlerSuite) TestCreateClientSecret() {
+	suite.clientSecretUsecase.On("Add", "client-1").Return(&entity.ClientSecret{ID: 2, ClientID: "client-1", CreatedAt: suite.now}, "new-secret", nil)
+
+	ginContext, w := suite.newContext(http.MethodPost, "", "secret-1")
+	suite.handler.CreateClientSecret(ginContext)
+
+	suite.Require().Equal(http.StatusCreated, w.Code)
+	var response presenter.ClientSecretResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(int64(2), response.Data.Id)
+	suite.Assert().True(response.Data.Active)
+	suite.Require().NotNil(response.Data.ClientSecret)
+	suite.Assert().Equal("new-secret", *response.Data.ClientSecret)
+}
+
+func (suite *ClientSecretHandlerSuite) TestCreateClientSecretTooMany() {
+	suite.clientSecretUsecase.On("Add", "client-1").Return(nil, "", usecase.ErrTooManyClientSecrets)
+
+	ginContext, w := suite.newContext(http.MethodPost, "", "secret-1")
+	suite.handler.CreateClientSecret(ginContext)
+
+	suite.Assert().Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *ClientSecretHandlerSuite) TestCreateClientSecretUnauthenticated() {
+	ginContext, w := suite.newContext(http.MethodPost, "", "wrong")
+	suite.handler.CreateClientSecret(ginContext)
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.clientSecretUsecase.AssertNotCalled(suite.T(), "Add", "client-1")
+}
+
+func (suite *ClientSecretHandlerSuite) TestListClientSecrets() {
+	notAfter := suite.now
+	suite.clientSecretUsecase.On("List", "client-1").Return([]entity.ClientSecret{
+		{ID: 1, ClientID: "client-1", SecretHash: "hash-1", CreatedAt: suite.now.Add(-time.Hour), NotAfter: &notAfter},
+		{ID: 2, ClientID: "client-1", SecretHash: "hash-2", CreatedAt: suite.now},
+	}, nil)
+
+	ginContext, w := suite.newContext(http.MethodGet, "", "secret-1")
+	suite.handler.ListClientSecrets(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	// ハッシュも返さない
+	suite.Assert().NotContains(w.Body.String(), "hash-1")
+	var response presenter.ClientSecretListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Data.Secrets, 2)
+	suite.Assert().False(response.Data.Secrets[0].Active)
+	suite.Assert().True(response.Data.Secrets[1].Active)
+	suite.Assert().Nil(response.Data.Secrets[1].ClientSecret)
+}
+
+func (suite *ClientSecretHandlerSuite) TestRetireClientSecret() {
+	notAfter := suite.now.Add(24 * time.Hour)
+	suite.clientSecretUsecase.On("Retire", "client-1", int64(1), notAfter).Return(&entity.ClientSecret{ID: 1, ClientID: "client-1", CreatedAt: suite.now, NotAfter: &notAfter}, nil)
+
+	ginContext, w := suite.newContext(http.MethodPost, `{"notAfter":"2025-12-22T00:00:00Z"}`, "secret-1")
+	suite.handler.RetireClientSecret(ginContext, 1)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.ClientSecretResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().NotNil(response.Data.NotAfter)
+	suite.Assert().True(notAfter.Equal(*response.Data.NotAfter))
+	suite.Assert().True(response.Data.Active)
+}
+
+func (suite *ClientSecretHandlerSuite) TestRetireClientSecretWithoutBody() {
+	suite.clientSecretUsecase.On("Retire", "client-1", int64(1), time.Time{}).Return(nil, usecase.ErrLastClientSecret)
+
+	ginContext, w := suite.newContext(http.MethodPost, "", "secret-1")
+	suite.handler.RetireClientSecret(ginContext, 1)
+
+	suite.Assert().Equal(http.StatusConflict, w.Code)
+}
+
+func (suite *ClientSecretHandlerSuite) TestRetireClientSecretErrors() {
+	suite.clientSecretUsecase.On("Retire", "client-1", int64(9), time.Time{}).Return(nil, usecase.ErrClientSecretNotFound)
+
+	ginContext, w := suite.newContext(http.MethodPost, "", "secret-1")
+	suite.handler.RetireClientSecret(ginContext, 9)
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext(http.MethodPost, `{"notAfter":`, "secret-1")
+	suite.handler.RetireClientSecret(ginContext, 1)
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/api"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// ConsentHandler は顧客が自分の同意を確認・取り消しするためのもの。顧客はアクセストークンの発行先から決める。
+type ConsentHandler struct {
+	consentUsecase usecase.ConsentUsecase
+	tokenUsecase   usecase.TokenUsecase
+	clock          pkg.Clock
+}
+
+func NewConsentHandler(consentUsecase usecase.ConsentUsecase, tokenUsecase usecase.TokenUsecase, clock pkg.Clock) *ConsentHandler {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &ConsentHandler{
+		consentUsecase: consentUsecase,
+		tokenUsecase:   tokenUsecase,
+		clock:          clock,
+	}
+}
+
+func (h *ConsentHandler) ListConsents(c *gin.Context) {
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	consents, err := h.consentUsecase.List(c.Request.Context(), validatedToken.CifNo)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	data := presenter.ConsentList{Consents: make([]presenter.Consent, 0, len(consents))}
+	for _, consent := range consents {
+		data.Consents = append(data.Consents, h.consentToResponse(consent))
+	}
+	c.JSON(http.StatusOK, &presenter.ConsentListResponse{ApiVersion: api.Version, Data: data})
+}
+
+func (h *ConsentHandler) RevokeConsent(c *gin.Context, consentId int64) {
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	if err := h.consentUsecase.Revoke(c.Request.Context(), validatedToken.CifNo, consentId); err != nil {
+		h.writeError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ConsentHandler) consentToResponse(consent usecase.ConsentDetail) presenter.Consent {
+	response := presenter.Consent{
+		Id:         consent.ID,
+		ClientId:   consent.ClientID,
+		ClientName: consent.ClientName,
+		Scopes:     consent.Scopes(),
+		Accounts:   make([]presenter.ConsentAccount, 0, len(consent.Accounts)),
+		Status:     presenter.ConsentStatus(consent.Status(h.clock.Now())),
+		CreatedAt:  consent.CreatedAt.UTC(),
+		ExpiresAt:  consent.ExpiresAt.UTC(),
+	}
+	if response.Scopes == nil {
+		response.Scopes = []string{}
+	}
+	for _, account := range consent.Accounts {
+		response.Accounts = append(response.Accounts, accountToConsentAccount(account))
+	}
+	if consent.RevokedAt != nil {
+		revokedAt := consent.RevokedAt.UTC()
+		response.RevokedAt = &revokedAt
+	}
+	return response
+}
+
+func accountToConsentAccount(account entity.Account) presenter.ConsentAccount {
+	return presenter.ConsentAccount{
+		BranchCode:    account.BranchCode,
+		AccountNumber: account.AccountNumber,
+		AccountType:   account.AccountType,
+	}
+}
+
+func (h *ConsentHandler) writeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrConsentNotFound):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/usecase"
+)
+
+type MockConsentUsecase struct {
+	mock.Mock
+}
+
+func NewMockConsentUsecase() *MockConsentUsecase {
+	return &MockConsentUsecase{}
+}
+
+func (m *MockConsentUsecase) List(_ context.Context, cifNo int) ([]usecase.ConsentDetail, error) {
+	args := m.Called(cifNo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]usecase.ConsentDetail), args.Error(1)
+}
+
+func (m *MockConsentUsecase) Revoke(_ context.Context, cifNo int, consentID int64) error {
+	args := m.Called(cifNo, consentID)
+	return args.Error(0)
+}
+
+type ConsentHandlerSuite struct {
+	suite.Suite
+	now            time.Time
+	consentUsecase *MockConsentUsecase
+	tokenUsecase   *MockTokenUsecase
+	consentHandler *ConsentHandler
+}
+
+func TestConsentHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentHandlerSuite))
+}
+
+func (suite *ConsentHandlerSuite) SetupTest() {
+	suite.now = time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	suite.consentUsecase = NewMockConsentUsecase()
+	suite.tokenUsecase = NewMockTokenUsecase()
+	suite.consentHandler = NewConsentHandler(suite.consentUsecase, suite.tokenUsecase, pkg.FixedClock{T: suite.now})
+}
+
+func (suite *ConsentHandlerSuite) newContext(method string, authorization string) (*gin.Context, *httptest.ResponseRecorder) {
+	request, _ := http.NewRequest(method, "/api/v1/consents", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	return ginContext, w
+}
+
+func (suite *ConsentHandlerSuite) TestList() {
+	revokedAt := suite.now.Add(-time.Hour)
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.consentUsecase.On("List", 1).Return([]usecase.ConsentDetail{
+		{
+			Consent: entity.Consent{
+				ID: 1, CifNo: 1, ClientID: "client-1", Scope: "read:account_and_transactions",
+				CreatedAt: suite.now.Add(-24 * time.Hour), ExpiresAt: suite.now.Add(entity.ConsentTTL),
+			},
+			ClientName: "Partner",
+			Accounts:   []entity.Account{{Id: 1, BranchCode: "123", AccountNumber: "1234567", AccountType: "1"}},
+		},
+		{
+			Consent:  entity.Consent{ID: 2, CifNo: 1, ClientID: "client-2", ExpiresAt: suite.now.Add(time.Hour), RevokedAt: &revokedAt},
+			Accounts: []entity.Account{},
+		},
+		{
+			Consent:  entity.Consent{ID: 3, CifNo: 1, ClientID: "client-3", ExpiresAt: suite.now},
+			Accounts: []entity.Account{},
+		},
+	}, nil)
+
+	ginContext, w := suite.newContext("GET", "Bearer app-token")
+	suite.consentHandler.ListConsents(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.ConsentListResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().Len(response.Data.Consents, 3)
+	first := response.Data.Consents[0]
+	suite.Assert().Equal("Partner", first.ClientName)
+	suite.Assert().Equal([]string{"read:account_and_transactions"}, first.Scopes)
+	suite.Assert().Equal([]presenter.ConsentAccount{{BranchCode: "123", AccountNumber: "1234567", AccountType: "1"}}, first.Accounts)
+	suite.Assert().Equal(presenter.ConsentStatusActive, first.Status)
+	suite.Assert().Nil(first.RevokedAt)
+	suite.Assert().Equal(presenter.ConsentStatusRevoked, response.Data.Consents[1].Status)
+	suite.Assert().Equal(revokedAt, *response.Data.Consents[1].RevokedAt)
+	suite.Assert().Equal([]string{}, response.Data.Consents[1].Scopes)
+	suite.Assert().Equal(presenter.ConsentStatusExpired, response.Data.Consents[2].Status)
+}
+
+func (suite *ConsentHandlerSuite) TestListWithoutScope() {
+	suite.tokenUsecase.On("Validate", "account-token", usecase.ConsentManageScope).Return(nil, usecase.ErrInvalidScope)
+
+	ginContext, w := suite.newContext("GET", "Bearer account-token")
+	suite.consentHandler.ListConsents(ginContext)
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.consentUsecase.AssertNotCalled(suite.T(), "List", mock.Anything)
+}
+
+func (suite *ConsentHandlerSuite) TestRevoke() {
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.consentUsecase.On("Revoke", 1, int64(1)).Return(nil)
+
+	ginContext, w := suite.newContext("DELETE", "Bearer app-token")
+	suite.consentHandler.RevokeConsent(ginContext, 1)
+	ginContext.Writer.WriteHeaderNow()
+
+	suite.Assert().Equal(http.StatusNoContent, w.Code)
+	suite.consentUsecase.AssertExpectations(suite.T())
+}
+
+func (suite *ConsentHandlerSuite) TestRevokeNotFound() {
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.consentUsecase.On("Revoke", 1, int64(9)).Return(usecase.ErrConsentNotFound)
+
+	ginContext, w := suite.newContext("DELETE", "Bearer app-token")
+	suite.consentHandler.RevokeConsent(ginContext, 9)
+
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *ConsentHandlerSuite) TestRevokeError() {
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.consentUsecase.On("Revoke", 1, int64(1)).Return(errors.New("db error"))
+
+	ginContext, w := suite.newContext("DELETE", "Bearer app-token")
+	suite.consentHandler.RevokeConsent(ginContext, 1)
+
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/api"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// DeviceAuthorizationHandler はデバイス認可グラント（RFC 8628）のエンドポイント。
+// /device_authorization はデバイスが呼び、レスポンスは RFC の形式に合わせて apiVersion / data で包まない。
+// /device-verifications は顧客が銀行のアプリで user_code を入力して承認・拒否するためのもので、顧客はアクセストークンの発行先から決める。
+// トークンとの交換は /token で行う（TokenHandler）。
+type DeviceAuthorizationHandler struct {
+	// deviceAuthorizationUsecase が nil の場合はデバイス認可グラントを受け付けない
+	deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase
+	clientUsecase              usecase.ClientUsecase
+	tokenUsecase               usecase.TokenUsecase
+	// verificationURI は顧客が user_code を入力する銀行のページ
+	verificationURI string
+	clock           pkg.Clock
+}
+
+func NewDeviceAuthorizationHandler(deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase, clientUsecase usecase.ClientUsecase, tokenUsecase usecase.TokenUsecase, verificationURI string, clock pkg.Clock) *DeviceAuthorizationHandler {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &DeviceAuthorizationHandler{
+		deviceAuthorizationUsecase: deviceAuthorizationUsecase,
+		clientUsecase:              clientUsecase,
+		tokenUsecase:               tokenUsecase,
+		verificationURI:            verificationURI,
+		clock:                      clock,
+	}
+}
+
+func (h *DeviceAuthorizationHandler) PostDeviceAuthorization(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	if h.deviceAuthorizationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	client, ok := authenticateClient(c, h.clientUsecase)
+	if !ok {
+		return
+	}
+
+	if err := c.Request.ParseForm(); err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		h.writeAuthorizationError(c, presenter.DeviceAuthorizationInvalidRequest, "request body must be form encoded")
+		return
+	}
+	form := c.Request.PostForm
+	for _, values := range form {
+		if len(values) > 1 {
+			h.writeAuthorizationError(c, presenter.DeviceAuthorizationInvalidRequest, "parameters must not be repeated")
+			return
+		}
+	}
+	if form.Has("client_id") && form.Get("client_id") != client.ClientID {
+		h.writeAuthorizationError(c, presenter.DeviceAuthorizationInvalidRequest, "client_id does not match the authenticated client")
+		return
+	}
+
+	authorization, err := h.deviceAuthorizationUsecase.Authorize(c.Request.Context(), client, form.Get("scope"))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrUnauthorizedClient):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			h.writeAuthorizationError(c, presenter.DeviceAuthorizationUnauthorizedClient, err.Error())
+		case errors.Is(err, usecase.ErrInvalidAuthorizationScope):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			h.writeAuthorizationError(c, presenter.DeviceAuthorizationInvalidScope, err.Error())
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, presenter.DeviceAuthorization{
+		DeviceCode:              authorization.DeviceCode,
+		UserCode:                authorization.FormattedUserCode(),
+		VerificationUri:         h.verificationURI,
+		VerificationUriComplete: h.verificationURIComplete(authorization.FormattedUserCode()),
+		ExpiresIn:               max(int(authorization.ExpiresAt.Sub(h.clock.Now()).Seconds()), 0),
+		Interval:                authorization.Interval,
+	})
+}
+
+// verificationURIComplete は QR コードなどで user_code を入力せずに開けるよう、verificationURI に user_code を付けて返す
+func (h *DeviceAuthorizationHandler) verificationURIComplete(userCode string) string {
+	verificationURI, err := url.Parse(h.verificationURI)
+	if err != nil {
+		return h.verificationURI
+	}
+	query := verificationURI.Query()
+	query.Set("user_code", userCode)
+	verificationURI.RawQuery = query.Encode()
+	return verificationURI.String()
+}
+
+func (h *DeviceAuthorizationHandler) writeAuthorizationError(c *gin.Context, code presenter.DeviceAuthorizationErrorError, description string) {
+	c.JSON(http.StatusBadRequest, presenter.DeviceAuthorizationError{Error: code, ErrorDescription: &description})
+}
+
+func (h *DeviceAuthorizationHandler) GetDeviceVerification(c *gin.Context, userCode string) {
+	if h.deviceAuthorizationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	if _, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope); !ok {
+		return
+	}
+
+	detail, err := h.deviceAuthorizationUsecase.Get(c.Request.Context(), userCode)
+	if err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	scopes := detail.Scopes()
+	if scopes == nil {
+		scopes = []string{}
+	}
+	c.JSON(http.StatusOK, &presenter.DeviceVerificationResponse{
+		ApiVersion: api.Version,
+		Data: presenter.DeviceVerification{
+			UserCode:   detail.FormattedUserCode(),
+			ClientId:   detail.ClientID,
+			ClientName: detail.ClientName,
+			Scopes:     scopes,
+			ExpiresAt:  detail.ExpiresAt.UTC(),
+		},
+	})
+}
+
+func (h *DeviceAuthorizationHandler) ApproveDeviceVerification(c *gin.Context, userCode string) {
+	if h.deviceAuthorizationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	if err := h.deviceAuthorizationUsecase.Approve(c.Request.Context(), validatedToken.CifNo, userCode); err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *DeviceAuthorizationHandler) DenyDeviceVerification(c *gin.Context, userCode string) {
+	if h.deviceAuthorizationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.ConsentManageScope)
+	if !ok {
+		return
+	}
+
+	if err := h.deviceAuthorizationUsecase.Deny(c.Request.Context(), validatedToken.CifNo, userCode); err != nil {
+		h.writeVerificationError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *DeviceAuthorizationHandler) writeVerificationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrUserCodeNotFound):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+	case errors.Is(err, usecase.ErrSandboxCustomerRequired):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusForbidden, presenter.ErrorCodeSandboxCustomerRequired))
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
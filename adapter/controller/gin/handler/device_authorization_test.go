@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/usecase"
+)
+
+type MockDeviceAuthorizationUsecase struct {
+	mock.Mock
+}
+
+func NewMockDeviceAuthorizationUsecase() *MockDeviceAuthorizationUsecase {
+	return &MockDeviceAuthorizationUsecase{}
+}
+
+func (m *MockDeviceAuthorizationUsecase) Authorize(_ context.Context, client *entity.Client, scope string) (*entity.DeviceAuthorization, error) {
+	args := m.Called(client, scope)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DeviceAuthorization), args.Error(1)
+}
+
+func (m *MockDeviceAuthorizationUsecase) Get(_ context.Context, userCode string) (*usecase.DeviceAuthorizationDetail, error) {
+	args := m.Called(userCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.DeviceAuthorizationDetail), args.Error(1)
+}
+
+func (m *MockDeviceAuthorizationUsecase) Approve(_ context.Context, cifNo int, userCode string) error {
+	args := m.Called(cifNo, userCode)
+	return args.Error(0)
+}
+
+func (m *MockDeviceAuthorizationUsecase) Deny(_ context.Context, cifNo int, userCode string) error {
+	args := m.Called(cifNo, userCode)
+	return args.Error(0)
+}
+
+func (m *MockDeviceAuthorizationUsecase) Exchange(_ context.Context, client *entity.Client, deviceCode string) (*entity.Token, error) {
+	args := m.Called(client.ClientID, deviceCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Token), args.Error(1)
+}
+
+type DeviceAuthorizationHandlerSuite struct {
+	suite.Suite
+	now                        time.Time
+	client                     *entity.Client
+	deviceAuthorizationUsecase *MockDeviceAuthorizationUsecase
+	clientUsecase              *MockClientUsecase
+	tokenUsecase               *MockTokenUsecase
+	handler                    *DeviceAuthorizationHandler
+}
+
+func TestDeviceAuthorizationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(DeviceAuthorizationHandlerSuite))
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) SetupTest() {
+	suite.now = time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	suite.client = &entity.Client{ClientID: "tv-app"}
+	suite.deviceAuthorizationUsecase = NewMockDeviceAuthorizationUsecase()
+	suite.clientUsecase = NewMockClientUsecase()
+	suite.clientUsecase.On("Authenticate", "tv-app", "secret-1").Return(suite.client, nil)
+	suite.tokenUsecase = NewMockTokenUsecase()
+	suite.tokenUsecase.On("Validate", "app-token", usecase.ConsentManageScope).Return(&entity.Token{ClientID: "bank-app", CifNo: 1}, nil)
+	suite.handler = NewDeviceAuthorizationHandler(suite.deviceAuthorizationUsecase, suite.clientUsecase, suite.tokenUsecase, "https://bank.example.com/device", pkg.FixedClock{T: suite.now})
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) authorize(body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest("POST", "/api/v1/device_authorization", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth("tv-app", "secret-1")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.handler.PostDeviceAuthorization(ginContext)
+	return w
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) newContext(method string, authorization string) (*gin.Context, *httptest.ResponseRecorder) {
+	request, _ := http.NewRequest(method, "/api/v1/device-verifications/BCDF-GHJK", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	return ginContext, w
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestAuthorize() {
+	suite.deviceAuthorizationUsecase.On("Authorize", suite.client, "openid").Return(&entity.DeviceAuthorization{
+		DeviceCode: "device-code-1",
+		UserCode:   "BCDFGHJK",
+		Interval:   5,
+		ExpiresAt:  suite.now.Add(10 * time.Minute),
+	}, nil)
+
+	w := suite.authorize("client_id=tv-app&scope=openid")
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().JSONEq(`{
+		"device_code": "device-code-1",
+		"user_code": "BCDF-GHJK",
+		"verification_uri": "https://bank.example.com/device",
+		"verification_uri_complete": "https://bank.example.com/device?user_code=BCDF-GHJK",
+		"expires_in": 600,
+		"interval": 5
+	}`, w.Body.String())
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestAuthorizeInvalidParameters() {
+	for _, body := range []string{"client_id=other", "scope=openid&scope=profile"} {
+		w := suite.authorize(body)
+		suite.Assert().Equal(http.StatusBadRequest, w.Code)
+		var response presenter.DeviceAuthorizationError
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		suite.Assert().Equal(presenter.DeviceAuthorizationInvalidRequest, response.Error)
+	}
+	suite.deviceAuthorizationUsecase.AssertNotCalled(suite.T(), "Authorize", mock.Anything, mock.Anything)
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestAuthorizeErrors() {
+	tests := []struct {
+		err  error
+		want presenter.DeviceAuthorizationErrorError
+	}{
+		{usecase.ErrUnauthorizedClient, presenter.DeviceAuthorizationUnauthorizedClient},
+		{fmt.Errorf("%w: read:audit_log", usecase.ErrInvalidAuthorizationScope), presenter.DeviceAuthorizationInvalidScope},
+	}
+	for _, test := range tests {
+		suite.Run(string(test.want), func() {
+			suite.SetupTest()
+			suite.deviceAuthorizationUsecase.On("Authorize", suite.client, mock.Anything).Return(nil, test.err)
+			w := suite.authorize("scope=read%3Aaudit_log")
+			suite.Assert().Equal(http.StatusBadRequest, w.Code)
+			var response presenter.DeviceAuthorizationError
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Assert().Equal(test.want, response.Error)
+		})
+	}
+
+	suite.SetupTest()
+	suite.deviceAuthorizationUsecase.On("Authorize", suite.client, mock.Anything).Return(nil, errors.New("db error"))
+	suite.Assert().Equal(http.StatusInternalServerError, suite.authorize("").Code)
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestGetVerification() {
+	suite.deviceAuthorizationUsecase.On("Get", "bcdf-ghjk").Return(&usecase.DeviceAuthorizationDetail{
+		DeviceAuthorization: entity.DeviceAuthorization{
+			UserCode:  "BCDFGHJK",
+			ClientID:  "tv-app",
+			Scope:     "openid read:account_and_transactions",
+			ExpiresAt: suite.now.Add(10 * time.Minute),
+		},
+		ClientName: "TV App",
+	}, nil)
+
+	ginContext, w := suite.newContext("GET", "Bearer app-token")
+	suite.handler.GetDeviceVerification(ginContext, "bcdf-ghjk")
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.DeviceVerificationResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.DeviceVerification{
+		UserCode:   "BCDF-GHJK",
+		ClientId:   "tv-app",
+		ClientName: "TV App",
+		Scopes:     []string{"openid", "read:account_and_transactions"},
+		ExpiresAt:  suite.now.Add(10 * time.Minute),
+	}, response.Data)
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestApproveAndDeny() {
+	suite.deviceAuthorizationUsecase.On("Approve", 1, "BCDF-GHJK").Return(nil)
+	suite.deviceAuthorizationUsecase.On("Deny", 1, "BCDF-GHJK").Return(nil)
+
+	ginContext, w := suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveDeviceVerification(ginContext, "BCDF-GHJK")
+	ginContext.Writer.WriteHeaderNow()
+	suite.Assert().Equal(http.StatusNoContent, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.DenyDeviceVerification(ginContext, "BCDF-GHJK")
+	ginContext.Writer.WriteHeaderNow()
+	suite.Assert().Equal(http.StatusNoContent, w.Code)
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestVerificationErrors() {
+	suite.deviceAuthorizationUsecase.On("Get", "ZZZZ-ZZZZ").Return(nil, usecase.ErrUserCodeNotFound)
+	suite.deviceAuthorizationUsecase.On("Approve", 1, "ZZZZ-ZZZZ").Return(usecase.ErrUserCodeNotFound)
+	suite.deviceAuthorizationUsecase.On("Deny", 1, "ZZZZ-ZZZZ").Return(errors.New("db error"))
+	suite.deviceAuthorizationUsecase.On("Approve", 1, "XXXX-XXXX").Return(usecase.ErrSandboxCustomerRequired)
+
+	ginContext, w := suite.newContext("GET", "Bearer app-token")
+	suite.handler.GetDeviceVerification(ginContext, "ZZZZ-ZZZZ")
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveDeviceVerification(ginContext, "ZZZZ-ZZZZ")
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.DenyDeviceVerification(ginContext, "ZZZZ-ZZZZ")
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+
+	ginContext, w = suite.newContext("POST", "Bearer app-token")
+	suite.handler.ApproveDeviceVerification(ginContext, "XXXX-XXXX")
+	suite.Assert().Equal(http.StatusForbidden, w.Code)
+	suite.Assert().Contains(w.Body.String(), presenter.Message(presenter.ErrorCodeSandboxCustomerRequired, presenter.DefaultLanguage))
+
+	ginContext, w = suite.newContext("POST", "")
+	suite.handler.ApproveDeviceVerification(ginContext, "ZZZZ-ZZZZ")
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+}
+
+func (suite *DeviceAuthorizationHandlerSuite) TestDisabled() {
+	suite.handler = NewDeviceAuthorizationHandler(nil, suite.clientUsecase, suite.tokenUsecase, "", nil)
+
+	suite.Assert().Equal(http.StatusNotFound, suite.authorize("").Code)
+	for _, handle := range []func(*gin.Context, string){suite.handler.GetDeviceVerification, suite.handler.ApproveDeviceVerification, suite.handler.DenyDeviceVerification} {
+		ginContext, w := suite.newContext("POST", "Bearer app-token")
+		handle(ginContext, "BCDF-GHJK")
+		suite.Assert().Equal(http.StatusNotFound, w.Code)
+	}
+	suite.clientUsecase.AssertNotCalled(suite.T(), "Authenticate", mock.Anything, mock.Anything)
+	suite.tokenUsecase.AssertNotCalled(suite.T(), "Validate", mock.Anything, mock.Anything)
+}
@@ -3,11 +3,27 @@ package handler
 type ServerHandler struct {
 	*AccountInfoHandler
 	*TokenHandler
+	*AuditHandler
+	*ClientSecretHandler
+	*ClientRegistrationHandler
+	*ConsentHandler
+	*OIDCHandler
+	*PushedAuthorizationHandler
+	*DeviceAuthorizationHandler
+	*AuthorizationHandler
 }
 
-func NewServerHandler(accountInfoHandler *AccountInfoHandler, tokenHandler *TokenHandler) *ServerHandler {
+func NewServerHandler(accountInfoHandler *AccountInfoHandler, tokenHandler *TokenHandler, auditHandler *AuditHandler, clientSecretHandler *ClientSecretHandler, clientRegistrationHandler *ClientRegistrationHandler, consentHandler *ConsentHandler, oidcHandler *OIDCHandler, pushedAuthorizationHandler *PushedAuthorizationHandler, deviceAuthorizationHandler *DeviceAuthorizationHandler, authorizationHandler *AuthorizationHandler) *ServerHandler {
 	return &ServerHandler{
-		AccountInfoHandler: accountInfoHandler,
-		TokenHandler:       tokenHandler,
+		AccountInfoHandler:         accountInfoHandler,
+		TokenHandler:               tokenHandler,
+		AuditHandler:               auditHandler,
+		ClientSecretHandler:        clientSecretHandler,
+		ClientRegistrationHandler:  clientRegistrationHandler,
+		ConsentHandler:             consentHandler,
+		OIDCHandler:                oidcHandler,
+		PushedAuthorizationHandler: pushedAuthorizationHandler,
+		DeviceAuthorizationHandler: deviceAuthorizationHandler,
+		AuthorizationHandler:       authorizationHandler,
 	}
 }
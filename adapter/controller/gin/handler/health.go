@@ -4,10 +4,31 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"go-banking-api/pkg/health"
 )
 
-func Health(c *gin.Context) {
+// Livez はプロセスが応答できることだけを返す。依存先が落ちていても再起動させないよう、DB などは確認しない。
+func Livez(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"status": "ok",
 	})
 }
+
+type ReadinessHandler struct {
+	readiness *health.Readiness
+}
+
+func NewReadinessHandler(readiness *health.Readiness) *ReadinessHandler {
+	return &ReadinessHandler{readiness: readiness}
+}
+
+// Readyz は依存先ごとの状態を返し、1 つでも使えない場合やシャットダウン中は 503 を返す
+func (r *ReadinessHandler) Readyz(c *gin.Context) {
+	report := r.readiness.Check(c.Request.Context())
+	status := http.StatusOK
+	if !report.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, report)
+}
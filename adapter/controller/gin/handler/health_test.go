@@ -1,22 +1,57 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/pkg/health"
 )
 
-func TestHealthHandler(t *testing.T) {
+func TestLivezHandler(t *testing.T) {
 	w := httptest.NewRecorder()
-	request, _ := http.NewRequest("GET", "/health", nil)
+	request, _ := http.NewRequest("GET", "/livez", nil)
 	gincontext, _ := gin.CreateTestContext(w)
 	gincontext.Request = request
 
-	Health(gincontext)
+	Livez(gincontext)
 
 	assert.Equal(t, 200, w.Code)
 	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
 }
+
+func TestReadyzHandler(t *testing.T) {
+	databaseErr := error(nil)
+	readiness := health.NewReadiness(time.Second)
+	readiness.Add("database", func(context.Context) error { return databaseErr })
+	readinessHandler := NewReadinessHandler(readiness)
+
+	readyz := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", "/readyz", nil)
+		gincontext, _ := gin.CreateTestContext(w)
+		gincontext.Request = request
+		readinessHandler.Readyz(gincontext)
+		return w
+	}
+
+	w := readyz()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok","components":{"database":{"status":"ok"}}}`, w.Body.String())
+
+	databaseErr = errors.New("connection refused")
+	w = readyz()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"status":"unavailable","components":{"database":{"status":"error"}}}`, w.Body.String())
+
+	readiness.ShutDown()
+	w = readyz()
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.JSONEq(t, `{"status":"shutting_down","components":{}}`, w.Body.String())
+}
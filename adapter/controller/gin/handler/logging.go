@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"go-banking-api/pkg/logger"
+)
+
+// withLogFields は以降のログ（アクセスログを含む）に項目を付与する
+func withLogFields(c *gin.Context, fields ...zap.Field) {
+	c.Request = c.Request.WithContext(logger.With(c.Request.Context(), fields...))
+}
+
+func clientIDField(clientID string) zap.Field {
+	return zap.String("client_id", clientID)
+}
+
+// subjectField は CIF 番号をそのまま出さずにハッシュ化して付与する
+func subjectField(cifNo int) zap.Field {
+	return zap.String("subject", logger.HashSubject(strconv.Itoa(cifNo)))
+}
@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"context"
+	"time"
+
+	"go-banking-api/adapter/gateway"
 	"go-banking-api/entity"
+	"go-banking-api/usecase"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -14,7 +19,7 @@ func NewMockTokenUsecase() *MockTokenUsecase {
 	return &MockTokenUsecase{}
 }
 
-func (m *MockTokenUsecase) Validate(accessTokenFromHeader string, requiredScope string) (*entity.Token, error) {
+func (m *MockTokenUsecase) Validate(_ context.Context, accessTokenFromHeader string, requiredScope string) (*entity.Token, error) {
 	args := m.Called(accessTokenFromHeader, requiredScope)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -22,8 +27,8 @@ func (m *MockTokenUsecase) Validate(accessTokenFromHeader string, requiredScope
 	return args.Get(0).(*entity.Token), args.Error(1)
 }
 
-func (m *MockTokenUsecase) Refresh(refreshToken string, clientID string) (*entity.Token, error) {
-	args := m.Called(refreshToken, clientID)
+func (m *MockTokenUsecase) Refresh(_ context.Context, refreshToken string, client *entity.Client) (*entity.Token, error) {
+	args := m.Called(refreshToken, client.ClientID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -38,10 +43,121 @@ func NewMockClientUsecase() *MockClientUsecase {
 	return &MockClientUsecase{}
 }
 
-func (m *MockClientUsecase) Authenticate(clientID string, clientSecret string) (*entity.Client, error) {
+func (m *MockClientUsecase) Authenticate(_ context.Context, clientID string, clientSecret string, _ string) (*entity.Client, error) {
 	args := m.Called(clientID, clientSecret)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*entity.Client), args.Error(1)
 }
+
+func (m *MockClientUsecase) UnlockClient(_ context.Context, clientID string) error {
+	args := m.Called(clientID)
+	return args.Error(0)
+}
+
+func (m *MockClientUsecase) UnlockSourceIP(_ context.Context, sourceIP string) error {
+	args := m.Called(sourceIP)
+	return args.Error(0)
+}
+
+type MockClientSecretUsecase struct {
+	mock.Mock
+}
+
+func NewMockClientSecretUsecase() *MockClientSecretUsecase {
+	return &MockClientSecretUsecase{}
+}
+
+func (m *MockClientSecretUsecase) List(_ context.Context, clientID string) ([]entity.ClientSecret, error) {
+	args := m.Called(clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ClientSecret), args.Error(1)
+}
+
+func (m *MockClientSecretUsecase) Add(_ context.Context, clientID string) (*entity.ClientSecret, string, error) {
+	args := m.Called(clientID)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*entity.ClientSecret), args.String(1), args.Error(2)
+}
+
+func (m *MockClientSecretUsecase) Retire(_ context.Context, clientID string, secretID int64, notAfter time.Time) (*entity.ClientSecret, error) {
+	args := m.Called(clientID, secretID, notAfter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ClientSecret), args.Error(1)
+}
+
+type MockClientRegistrationUsecase struct {
+	mock.Mock
+}
+
+func NewMockClientRegistrationUsecase() *MockClientRegistrationUsecase {
+	return &MockClientRegistrationUsecase{}
+}
+
+func (m *MockClientRegistrationUsecase) Register(_ context.Context, metadata usecase.ClientMetadata) (*entity.Client, *usecase.RegistrationCredentials, error) {
+	args := m.Called(metadata)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.Client), args.Get(1).(*usecase.RegistrationCredentials), args.Error(2)
+}
+
+func (m *MockClientRegistrationUsecase) Get(_ context.Context, clientID string, registrationAccessToken string) (*entity.Client, error) {
+	args := m.Called(clientID, registrationAccessToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Client), args.Error(1)
+}
+
+func (m *MockClientRegistrationUsecase) Update(_ context.Context, clientID string, registrationAccessToken string, metadata usecase.ClientMetadata) (*entity.Client, *usecase.RegistrationCredentials, error) {
+	args := m.Called(clientID, registrationAccessToken, metadata)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*entity.Client), args.Get(1).(*usecase.RegistrationCredentials), args.Error(2)
+}
+
+func (m *MockClientRegistrationUsecase) Delete(_ context.Context, clientID string, registrationAccessToken string) error {
+	args := m.Called(clientID, registrationAccessToken)
+	return args.Error(0)
+}
+
+type MockRateLimitUsecase struct {
+	mock.Mock
+}
+
+func NewMockRateLimitUsecase() *MockRateLimitUsecase {
+	return &MockRateLimitUsecase{}
+}
+
+func (m *MockRateLimitUsecase) Allow(_ context.Context, clientID string, cifNo int) (*gateway.RateLimitResult, error) {
+	args := m.Called(clientID, cifNo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gateway.RateLimitResult), args.Error(1)
+}
+
+func (m *MockRateLimitUsecase) AllowSource(_ context.Context, sourceIP string) (*gateway.RateLimitResult, error) {
+	args := m.Called(sourceIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gateway.RateLimitResult), args.Error(1)
+}
+
+func (m *MockRateLimitUsecase) AllowRegistration(_ context.Context, sourceIP string) (*gateway.RateLimitResult, error) {
+	args := m.Called(sourceIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*gateway.RateLimitResult), args.Error(1)
+}
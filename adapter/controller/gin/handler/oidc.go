@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/jwt"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// OIDCHandler は OpenID Connect の UserInfo・公開鍵・ディスカバリー。
+// レスポンスは仕様の形式に合わせ、apiVersion / data で包まない。
+type OIDCHandler struct {
+	// userInfoUsecase が nil の場合は OpenID Connect を無効とし、すべて 404 を返す
+	userInfoUsecase usecase.UserInfoUsecase
+	tokenUsecase    usecase.TokenUsecase
+	config          usecase.OIDCConfig
+	publicKeys      []jwt.JWK
+	// deviceAuthorization が true の場合はディスカバリーでデバイス認可グラントを公開する
+	deviceAuthorization bool
+	// authorizationCode が true の場合はディスカバリーで認可エンドポイントと PAR を公開する
+	authorizationCode bool
+}
+
+func NewOIDCHandler(userInfoUsecase usecase.UserInfoUsecase, tokenUsecase usecase.TokenUsecase, config usecase.OIDCConfig, publicKeys []jwt.JWK, deviceAuthorization bool, authorizationCode bool) *OIDCHandler {
+	return &OIDCHandler{
+		userInfoUsecase:     userInfoUsecase,
+		tokenUsecase:        tokenUsecase,
+		config:              config,
+		publicKeys:          publicKeys,
+		deviceAuthorization: deviceAuthorization,
+		authorizationCode:   authorizationCode,
+	}
+}
+
+// claimsSupported は UserInfo で返すクレーム
+var claimsSupported = []string{"sub", "name", "name#ja-Kana-JP", "birthdate", "email", "phone_number", "address"}
+
+func (h *OIDCHandler) GetUserInfo(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	validatedToken, ok := authorizeBearer(c, h.tokenUsecase, usecase.OpenIDScope)
+	if !ok {
+		return
+	}
+
+	userInfo, err := h.userInfoUsecase.Get(c.Request.Context(), validatedToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCustomerNotFound):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
+	}
+	// 個人情報のためキャッシュさせない
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusOK, userInfoToResponse(userInfo))
+}
+
+func (h *OIDCHandler) GetJwks(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	keys := make([]presenter.JWK, 0, len(h.publicKeys))
+	for _, key := range h.publicKeys {
+		keys = append(keys, presenter.JWK{Kty: key.KeyType, Use: key.Use, Alg: key.Algorithm, Kid: key.KeyID, N: key.N, E: key.E})
+	}
+	c.JSON(http.StatusOK, presenter.JWKSet{Keys: keys})
+}
+
+func (h *OIDCHandler) GetOpenIDConfiguration(c *gin.Context) {
+	if !h.enabled(c) {
+		return
+	}
+	configuration := presenter.OpenIDConfiguration{
+		Issuer:                            h.config.Issuer,
+		TokenEndpoint:                     h.config.Issuer + "/token",
+		UserinfoEndpoint:                  h.config.Issuer + "/userinfo",
+		JwksUri:                           h.config.Issuer + "/jwks",
+		ScopesSupported:                   usecase.OIDCScopes,
+		ResponseTypesSupported:            []string{},
+		GrantTypesSupported:               []string{entity.GrantTypeRefreshToken},
+		SubjectTypesSupported:             []string{"public"},
+		IdTokenSigningAlgValuesSupported:  []string{jwt.AlgorithmRS256},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic"},
+		ClaimsSupported:                   claimsSupported,
+	}
+	// 認可エンドポイントがない場合は、使えない PAR や PKCE を公開しない
+	if h.authorizationCode {
+		requirePushedAuthorizationRequests := true
+		configuration.AuthorizationEndpoint = optionalString(h.config.Issuer + "/authorize")
+		configuration.ResponseTypesSupported = []string{usecase.ResponseTypeCode}
+		configuration.GrantTypesSupported = append(configuration.GrantTypesSupported, entity.GrantTypeAuthorizationCode)
+		configuration.PushedAuthorizationRequestEndpoint = optionalString(h.config.Issuer + "/par")
+		configuration.RequirePushedAuthorizationRequests = &requirePushedAuthorizationRequests
+		configuration.RequestObjectSigningAlgValuesSupported = &usecase.RequestObjectSigningAlgorithms
+		configuration.CodeChallengeMethodsSupported = &[]string{usecase.CodeChallengeMethodS256}
+	}
+	if h.deviceAuthorization {
+		configuration.GrantTypesSupported = append(configuration.GrantTypesSupported, entity.GrantTypeDeviceCode)
+		configuration.DeviceAuthorizationEndpoint = optionalString(h.config.Issuer + "/device_authorization")
+	}
+	c.JSON(http.StatusOK, configuration)
+}
+
+func (h *OIDCHandler) enabled(c *gin.Context) bool {
+	if h.userInfoUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return false
+	}
+	return true
+}
+
+func userInfoToResponse(userInfo *usecase.UserInfo) presenter.UserInfo {
+	response := presenter.UserInfo{
+		Sub:          userInfo.Subject,
+		Name:         optionalString(userInfo.Name),
+		NameJaKanaJP: optionalString(userInfo.NameKana),
+		Birthdate:    optionalString(userInfo.Birthdate),
+		Email:        optionalString(userInfo.Email),
+		PhoneNumber:  optionalString(userInfo.PhoneNumber),
+	}
+	if address := userInfo.Address; address != nil {
+		response.Address = &presenter.UserInfoAddress{
+			Country:       optionalString(address.Country),
+			Region:        optionalString(address.Region),
+			Locality:      optionalString(address.Locality),
+			StreetAddress: optionalString(address.StreetAddress),
+		}
+	}
+	return response
+}
+
+// optionalString は空文字列を nil にし、レスポンスから省く
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/jwt"
+	"go-banking-api/usecase"
+)
+
+type MockUserInfoUsecase struct {
+	mock.Mock
+}
+
+func NewMockUserInfoUsecase() *MockUserInfoUsecase {
+	return &MockUserInfoUsecase{}
+}
+
+func (m *MockUserInfoUsecase) Get(_ context.Context, token *entity.Token) (*usecase.UserInfo, error) {
+	args := m.Called(token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecase.UserInfo), args.Error(1)
+}
+
+type OIDCHandlerSuite struct {
+	suite.Suite
+	userInfoUsecase *MockUserInfoUsecase
+	tokenUsecase    *MockTokenUsecase
+	oidcHandler     *OIDCHandler
+}
+
+func TestOIDCHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(OIDCHandlerSuite))
+}
+
+func (suite *OIDCHandlerSuite) SetupTest() {
+	suite.userInfoUsecase = NewMockUserInfoUsecase()
+	suite.tokenUsecase = NewMockTokenUsecase()
+	suite.oidcHandler = NewOIDCHandler(suite.userInfoUsecase, suite.tokenUsecase,
+		usecase.OIDCConfig{Issuer: "https://bank.example.com/api/v1"},
+		[]jwt.JWK{{KeyType: "RSA", Use: "sig", Algorithm: "RS256", KeyID: "kid-1", N: "n", E: "AQAB"}}, false, false)
+}
+
+func (suite *OIDCHandlerSuite) newContext(authorization string) (*gin.Context, *httptest.ResponseRecorder) {
+	request, _ := http.NewRequest("GET", "/api/v1/userinfo", nil)
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	return ginContext, w
+}
+
+func (suite *OIDCHandlerSuite) TestGetUserInfo() {
+	token := &entity.Token{ClientID: "client-1", CifNo: 1, Scopes: "openid profile address"}
+	suite.tokenUsecase.On("Validate", "openid-token", usecase.OpenIDScope).Return(token, nil)
+	suite.userInfoUsecase.On("Get", token).Return(&usecase.UserInfo{
+		Subject:   "subject-1",
+		Name:      "田中 太郎",
+		NameKana:  "Tanaka Taro",
+		Birthdate: "1990-01-01",
+		Address:   &usecase.UserInfoAddress{Country: "JP", Region: "Tokyo", Locality: "Chiyoda", StreetAddress: "Kanda 1-1-1"},
+	}, nil)
+
+	ginContext, w := suite.newContext("Bearer openid-token")
+	suite.oidcHandler.GetUserInfo(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	// 許可されていないクレームは含めない
+	suite.Assert().JSONEq(`{
+		"sub": "subject-1",
+		"name": "田中 太郎",
+		"name#ja-Kana-JP": "Tanaka Taro",
+		"birthdate": "1990-01-01",
+		"address": {"country": "JP", "region": "Tokyo", "locality": "Chiyoda", "street_address": "Kanda 1-1-1"}
+	}`, w.Body.String())
+}
+
+func (suite *OIDCHandlerSuite) TestGetUserInfoWithoutOpenIDScope() {
+	suite.tokenUsecase.On("Validate", "account-token", usecase.OpenIDScope).Return(nil, usecase.ErrInvalidScope)
+
+	ginContext, w := suite.newContext("Bearer account-token")
+	suite.oidcHandler.GetUserInfo(ginContext)
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.userInfoUsecase.AssertNotCalled(suite.T(), "Get", mock.Anything)
+}
+
+func (suite *OIDCHandlerSuite) TestGetUserInfoErrors() {
+	token := &entity.Token{ClientID: "client-1", CifNo: 1, Scopes: "openid"}
+	suite.tokenUsecase.On("Validate", "openid-token", usecase.OpenIDScope).Return(token, nil)
+	suite.userInfoUsecase.On("Get", token).Return(nil, usecase.ErrCustomerNotFound).Once()
+	suite.userInfoUsecase.On("Get", token).Return(nil, errors.New("db error")).Once()
+
+	ginContext, w := suite.newContext("Bearer openid-token")
+	suite.oidcHandler.GetUserInfo(ginContext)
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+
+	ginContext, w = suite.newContext("Bearer openid-token")
+	suite.oidcHandler.GetUserInfo(ginContext)
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *OIDCHandlerSuite) TestGetJwks() {
+	ginContext, w := suite.newContext("")
+	suite.oidcHandler.GetJwks(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().JSONEq(`{"keys":[{"kty":"RSA","use":"sig","alg":"RS256","kid":"kid-1","n":"n","e":"AQAB"}]}`, w.Body.String())
+}
+
+func (suite *OIDCHandlerSuite) TestGetOpenIDConfiguration() {
+	ginContext, w := suite.newContext("")
+	suite.oidcHandler.GetOpenIDConfiguration(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.OpenIDConfiguration
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("https://bank.example.com/api/v1", response.Issuer)
+	suite.Assert().Equal("https://bank.example.com/api/v1/token", response.TokenEndpoint)
+	suite.Assert().Equal("https://bank.example.com/api/v1/userinfo", response.UserinfoEndpoint)
+	suite.Assert().Equal("https://bank.example.com/api/v1/jwks", response.JwksUri)
+	suite.Assert().Equal([]string{"openid", "profile", "email", "phone", "address"}, response.ScopesSupported)
+	suite.Assert().Equal([]string{"refresh_token"}, response.GrantTypesSupported)
+	suite.Assert().Equal([]string{"RS256"}, response.IdTokenSigningAlgValuesSupported)
+	suite.Assert().Nil(response.DeviceAuthorizationEndpoint)
+	// 認可コードフローが無効の場合は認可エンドポイントも PAR も公開しない
+	suite.Assert().Equal([]string{}, response.ResponseTypesSupported)
+	suite.Assert().Nil(response.AuthorizationEndpoint)
+	suite.Assert().Nil(response.PushedAuthorizationRequestEndpoint)
+	suite.Assert().Nil(response.RequirePushedAuthorizationRequests)
+	suite.Assert().Nil(response.RequestObjectSigningAlgValuesSupported)
+	suite.Assert().Nil(response.CodeChallengeMethodsSupported)
+}
+
+func (suite *OIDCHandlerSuite) TestGetOpenIDConfigurationAuthorizationCode() {
+	suite.oidcHandler = NewOIDCHandler(suite.userInfoUsecase, suite.tokenUsecase, usecase.OIDCConfig{Issuer: "https://bank.example.com/api/v1"}, nil, false, true)
+	ginContext, w := suite.newContext("")
+	suite.oidcHandler.GetOpenIDConfiguration(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.OpenIDConfiguration
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Require().NotNil(response.AuthorizationEndpoint)
+	suite.Assert().Equal("https://bank.example.com/api/v1/authorize", *response.AuthorizationEndpoint)
+	suite.Assert().Equal([]string{"code"}, response.ResponseTypesSupported)
+	suite.Assert().Equal([]string{"refresh_token", "authorization_code"}, response.GrantTypesSupported)
+	suite.Require().NotNil(response.PushedAuthorizationRequestEndpoint)
+	suite.Assert().Equal("https://bank.example.com/api/v1/par", *response.PushedAuthorizationRequestEndpoint)
+	suite.Require().NotNil(response.RequirePushedAuthorizationRequests)
+	suite.Assert().True(*response.RequirePushedAuthorizationRequests)
+	suite.Require().NotNil(response.RequestObjectSigningAlgValuesSupported)
+	suite.Assert().Equal([]string{"PS256", "ES256"}, *response.RequestObjectSigningAlgValuesSupported)
+	suite.Require().NotNil(response.CodeChallengeMethodsSupported)
+	suite.Assert().Equal([]string{"S256"}, *response.CodeChallengeMethodsSupported)
+}
+
+func (suite *OIDCHandlerSuite) TestGetOpenIDConfigurationDeviceAuthorization() {
+	suite.oidcHandler = NewOIDCHandler(suite.userInfoUsecase, suite.tokenUsecase, usecase.OIDCConfig{Issuer: "https://bank.example.com/api/v1"}, nil, true, false)
+	ginContext, w := suite.newContext("")
+	suite.oidcHandler.GetOpenIDConfiguration(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.OpenIDConfiguration
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal([]string{"refresh_token", "urn:ietf:params:oauth:grant-type:device_code"}, response.GrantTypesSupported)
+	suite.Require().NotNil(response.DeviceAuthorizationEndpoint)
+	suite.Assert().Equal("https://bank.example.com/api/v1/device_authorization", *response.DeviceAuthorizationEndpoint)
+}
+
+func (suite *OIDCHandlerSuite) TestDisabled() {
+	oidcHandler := NewOIDCHandler(nil, suite.tokenUsecase, usecase.OIDCConfig{}, nil, false, false)
+
+	for _, handle := range []gin.HandlerFunc{oidcHandler.GetUserInfo, oidcHandler.GetJwks, oidcHandler.GetOpenIDConfiguration} {
+		ginContext, w := suite.newContext("Bearer openid-token")
+		handle(ginContext)
+		suite.Assert().Equal(http.StatusNotFound, w.Code)
+	}
+	suite.tokenUsecase.AssertNotCalled(suite.T(), "Validate", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+// PushedAuthorizationHandler は PAR（RFC 9126）のエンドポイント。/token と同じくクライアント認証が必要で、
+// レスポンスは RFC の形式に合わせて apiVersion / data で包まない。
+type PushedAuthorizationHandler struct {
+	// pushedAuthorizationUsecase が nil の場合は PAR を受け付けない
+	pushedAuthorizationUsecase usecase.PushedAuthorizationUsecase
+	clientUsecase              usecase.ClientUsecase
+	clock                      pkg.Clock
+}
+
+func NewPushedAuthorizationHandler(pushedAuthorizationUsecase usecase.PushedAuthorizationUsecase, clientUsecase usecase.ClientUsecase, clock pkg.Clock) *PushedAuthorizationHandler {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &PushedAuthorizationHandler{
+		pushedAuthorizationUsecase: pushedAuthorizationUsecase,
+		clientUsecase:              clientUsecase,
+		clock:                      clock,
+	}
+}
+
+func (h *PushedAuthorizationHandler) PushAuthorizationRequest(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	if h.pushedAuthorizationUsecase == nil {
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusNotFound, presenter.ErrorCodeNotFound))
+		return
+	}
+	client, ok := authenticateClient(c, h.clientUsecase)
+	if !ok {
+		return
+	}
+
+	if err := c.Request.ParseForm(); err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.InvalidRequest, "request body must be form encoded")
+		return
+	}
+	form := c.Request.PostForm
+	for _, values := range form {
+		if len(values) > 1 {
+			writeAuthorizationRequestError(c, presenter.InvalidRequest, "parameters must not be repeated")
+			return
+		}
+	}
+	// request_uri を入れ子にすることはできない（RFC 9126 2.1）
+	if form.Has("request_uri") {
+		writeAuthorizationRequestError(c, presenter.InvalidRequest, "request_uri must not be included")
+		return
+	}
+
+	pushed, err := h.pushedAuthorizationUsecase.Push(c.Request.Context(), client, usecase.AuthorizationRequest{
+		ClientID:            form.Get("client_id"),
+		ResponseType:        form.Get("response_type"),
+		RedirectURI:         form.Get("redirect_uri"),
+		Scope:               form.Get("scope"),
+		State:               form.Get("state"),
+		Nonce:               form.Get("nonce"),
+		CodeChallenge:       form.Get("code_challenge"),
+		CodeChallengeMethod: form.Get("code_challenge_method"),
+		Request:             form.Get("request"),
+	})
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, presenter.PushedAuthorization{
+		RequestUri: pushed.RequestURI,
+		ExpiresIn:  max(int(pushed.ExpiresAt.Sub(h.clock.Now()).Seconds()), 0),
+	})
+}
+
+func (h *PushedAuthorizationHandler) writeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidAuthorizationRequest):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.InvalidRequest, err.Error())
+	case errors.Is(err, usecase.ErrInvalidRequestObject):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.InvalidRequestObject, err.Error())
+	case errors.Is(err, usecase.ErrInvalidAuthorizationScope):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.InvalidScope, err.Error())
+	case errors.Is(err, usecase.ErrUnsupportedResponseType):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.UnsupportedResponseType, err.Error())
+	case errors.Is(err, usecase.ErrUnauthorizedClient):
+		logger.InfoContext(c.Request.Context(), err.Error())
+		writeAuthorizationRequestError(c, presenter.UnauthorizedClient, err.Error())
+	default:
+		logger.ErrorContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+	}
+}
+
+// writeAuthorizationRequestError は PAR と /authorize の認可リクエストのエラーを RFC 6749 の形式で返す
+func writeAuthorizationRequestError(c *gin.Context, code presenter.AuthorizationRequestErrorError, description string) {
+	c.JSON(http.StatusBadRequest, presenter.AuthorizationRequestError{Error: code, ErrorDescription: &description})
+}
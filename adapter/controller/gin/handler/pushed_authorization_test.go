@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/usecase"
+)
+
+type MockPushedAuthorizationUsecase struct {
+	mock.Mock
+}
+
+func NewMockPushedAuthorizationUsecase() *MockPushedAuthorizationUsecase {
+	return &MockPushedAuthorizationUsecase{}
+}
+
+func (m *MockPushedAuthorizationUsecase) Push(_ context.Context, client *entity.Client, request usecase.AuthorizationRequest) (*entity.PushedAuthorizationRequest, error) {
+	args := m.Called(client, request)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PushedAuthorizationRequest), args.Error(1)
+}
+
+func (m *MockPushedAuthorizationUsecase) Resolve(_ context.Context, clientID string, requestURI string) (*entity.PushedAuthorizationRequest, error) {
+	args := m.Called(clientID, requestURI)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PushedAuthorizationRequest), args.Error(1)
+}
+
+type PushedAuthorizationHandlerSuite struct {
+	suite.Suite
+	now                        time.Time
+	client                     *entity.Client
+	pushedAuthorizationUsecase *MockPushedAuthorizationUsecase
+	clientUsecase              *MockClientUsecase
+	handler                    *PushedAuthorizationHandler
+}
+
+func TestPushedAuthorizationHandlerSuite(t *testing.T) {
+	suite.Run(t, new(PushedAuthorizationHandlerSuite))
+}
+
+func (suite *PushedAuthorizationHandlerSuite) SetupTest() {
+	suite.now = time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	suite.client = &entity.Client{ClientID: "client-1"}
+	suite.pushedAuthorizationUsecase = NewMockPushedAuthorizationUsecase()
+	suite.clientUsecase = NewMockClientUsecase()
+	suite.clientUsecase.On("Authenticate", "client-1", "secret-1").Return(suite.client, nil)
+	suite.handler = NewPushedAuthorizationHandler(suite.pushedAuthorizationUsecase, suite.clientUsecase, pkg.FixedClock{T: suite.now})
+}
+
+func (suite *PushedAuthorizationHandlerSuite) push(body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest("POST", "/api/v1/par", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.SetBasicAuth("client-1", "secret-1")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.handler.PushAuthorizationRequest(ginContext)
+	return w
+}
+
+func (suite *PushedAuthorizationHandlerSuite) TestPush() {
+	form := url.Values{
+		"response_type":         {"code"},
+		"redirect_uri":          {"https://client.example.com/callback"},
+		"scope":                 {"openid"},
+		"state":                 {"state-1"},
+		"code_challenge":        {"challenge"},
+		"code_challenge_method": {"S256"},
+	}
+	suite.pushedAuthorizationUsecase.On("Push", suite.client, usecase.AuthorizationRequest{
+		ResponseType:        "code",
+		RedirectURI:         "https://client.example.com/callback",
+		Scope:               "openid",
+		State:               "state-1",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+	}).Return(&entity.PushedAuthorizationRequest{
+		RequestURI: "urn:ietf:params:oauth:request_uri:request-1",
+		ExpiresAt:  suite.now.Add(time.Minute),
+	}, nil)
+
+	w := suite.push(form.Encode())
+
+	suite.Require().Equal(http.StatusCreated, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().JSONEq(`{"request_uri":"urn:ietf:params:oauth:request_uri:request-1","expires_in":60}`, w.Body.String())
+}
+
+func (suite *PushedAuthorizationHandlerSuite) TestPushInvalidParameters() {
+	for _, body := range []string{
+		"request_uri=urn%3Aietf%3Aparams%3Aoauth%3Arequest_uri%3Aother&response_type=code",
+		"scope=openid&scope=profile",
+	} {
+		w := suite.push(body)
+		suite.Assert().Equal(http.StatusBadRequest, w.Code)
+		var response presenter.AuthorizationRequestError
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		suite.Assert().Equal(presenter.InvalidRequest, response.Error)
+	}
+	suite.pushedAuthorizationUsecase.AssertNotCalled(suite.T(), "Push", mock.Anything, mock.Anything)
+}
+
+func (suite *PushedAuthorizationHandlerSuite) TestPushErrors() {
+	tests := []struct {
+		err  error
+		code int
+		want presenter.AuthorizationRequestErrorError
+	}{
+		{fmt.Errorf("%w: redirect_uri", usecase.ErrInvalidAuthorizationRequest), http.StatusBadRequest, presenter.InvalidRequest},
+		{fmt.Errorf("%w: expired", usecase.ErrInvalidRequestObject), http.StatusBadRequest, presenter.InvalidRequestObject},
+		{fmt.Errorf("%w: scope", usecase.ErrInvalidAuthorizationScope), http.StatusBadRequest, presenter.InvalidScope},
+		{fmt.Errorf("%w: token", usecase.ErrUnsupportedResponseType), http.StatusBadRequest, presenter.UnsupportedResponseType},
+		{usecase.ErrUnauthorizedClient, http.StatusBadRequest, presenter.UnauthorizedClient},
+	}
+	for _, test := range tests {
+		suite.Run(string(test.want), func() {
+			suite.SetupTest()
+			suite.pushedAuthorizationUsecase.On("Push", suite.client, mock.Anything).Return(nil, test.err)
+			w := suite.push("request=signed")
+			suite.Assert().Equal(test.code, w.Code)
+			var response presenter.AuthorizationRequestError
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Assert().Equal(test.want, response.Error)
+			suite.Require().NotNil(response.ErrorDescription)
+			suite.Assert().Equal(test.err.Error(), *response.ErrorDescription)
+		})
+	}
+
+	suite.SetupTest()
+	suite.pushedAuthorizationUsecase.On("Push", suite.client, mock.Anything).Return(nil, errors.New("db error"))
+	suite.Assert().Equal(http.StatusInternalServerError, suite.push("request=signed").Code)
+}
+
+func (suite *PushedAuthorizationHandlerSuite) TestPushUnauthenticatedClient() {
+	request := httptest.NewRequest("POST", "/api/v1/par", strings.NewReader("response_type=code"))
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.handler.PushAuthorizationRequest(ginContext)
+
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.pushedAuthorizationUsecase.AssertNotCalled(suite.T(), "Push", mock.Anything, mock.Anything)
+}
+
+func (suite *PushedAuthorizationHandlerSuite) TestDisabled() {
+	suite.handler = NewPushedAuthorizationHandler(nil, suite.clientUsecase, nil)
+	w := suite.push("response_type=code")
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	suite.clientUsecase.AssertNotCalled(suite.T(), "Authenticate", mock.Anything, mock.Anything)
+}
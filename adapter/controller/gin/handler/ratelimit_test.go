@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/usecase"
+)
+
+type RateLimitHandlerSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitHandlerSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitHandlerSuite))
+}
+
+func (suite *RateLimitHandlerSuite) getAccountInformation(rateLimitUsecase usecase.RateLimitUsecase, accountInfoUsecase *MockAccountInfoUsecase) *httptest.ResponseRecorder {
+	tokenUsecase := NewMockTokenUsecase()
+	tokenUsecase.On("Validate", "access-token-1", "read:account_and_transactions").Return(&entity.Token{ClientID: "client-1", CifNo: 1}, nil)
+	accountInfoHandler := NewAccountInfoHandler(accountInfoUsecase, tokenUsecase, pkg.FixedClock{})
+
+	request, err := http.NewRequest("GET", "/api/v1/accounts", nil)
+	suite.Require().NoError(err)
+	request.Header.Set("Authorization", "Bearer access-token-1")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	middleware.RateLimit(rateLimitUsecase)(ginContext)
+
+	accountInfoHandler.GetAccountInformation(ginContext)
+	return w
+}
+
+func (suite *RateLimitHandlerSuite) TestAllowedSetsHeaders() {
+	rateLimitUsecase := NewMockRateLimitUsecase()
+	rateLimitUsecase.On("AllowSource", mock.Anything).Return(nil, nil)
+	rateLimitUsecase.On("Allow", "client-1", 1).Return(&gateway.RateLimitResult{Allowed: true, Limit: 100, Remaining: 99, Reset: 600 * time.Millisecond}, nil)
+	accountInfoUsecase := NewMockAccountInfoUsecase()
+	accountInfoUsecase.On("Get", "client-1", 1).Return(nil, usecase.ErrAccountNotFound)
+
+	w := suite.getAccountInformation(rateLimitUsecase, accountInfoUsecase)
+
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	suite.Assert().Equal("100", w.Header().Get(middleware.RateLimitLimitHeader))
+	suite.Assert().Equal("99", w.Header().Get(middleware.RateLimitRemainingHeader))
+	suite.Assert().Equal("1", w.Header().Get(middleware.RateLimitResetHeader))
+	suite.Assert().Empty(w.Header().Get(middleware.RetryAfterHeader))
+}
+
+func (suite *RateLimitHandlerSuite) TestRateLimited() {
+	rateLimitUsecase := NewMockRateLimitUsecase()
+	rateLimitUsecase.On("AllowSource", mock.Anything).Return(nil, nil)
+	rateLimitUsecase.On("Allow", "client-1", 1).Return(&gateway.RateLimitResult{Limit: 100, Remaining: 0, Reset: time.Minute, RetryAfter: 1500 * time.Millisecond}, usecase.ErrRateLimited)
+	accountInfoUsecase := NewMockAccountInfoUsecase()
+
+	w := suite.getAccountInformation(rateLimitUsecase, accountInfoUsecase)
+
+	suite.Assert().Equal(http.StatusTooManyRequests, w.Code)
+	suite.Assert().Equal("2", w.Header().Get(middleware.RetryAfterHeader))
+	suite.Assert().Equal("0", w.Header().Get(middleware.RateLimitRemainingHeader))
+	suite.Assert().Equal("60", w.Header().Get(middleware.RateLimitResetHeader))
+	var response presenter.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(http.StatusTooManyRequests, response.Error.Code)
+	accountInfoUsecase.AssertNotCalled(suite.T(), "Get")
+}
+
+func (suite *RateLimitHandlerSuite) TestStoreFailureFailsOpen() {
+	rateLimitUsecase := NewMockRateLimitUsecase()
+	rateLimitUsecase.On("AllowSource", mock.Anything).Return(nil, nil)
+	rateLimitUsecase.On("Allow", "client-1", 1).Return(nil, errors.New("store unavailable"))
+	accountInfoUsecase := NewMockAccountInfoUsecase()
+	accountInfoUsecase.On("Get", "client-1", 1).Return(nil, usecase.ErrAccountNotFound)
+
+	w := suite.getAccountInformation(rateLimitUsecase, accountInfoUsecase)
+
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	suite.Assert().Empty(w.Header().Get(middleware.RateLimitLimitHeader))
+}
+
+func (suite *RateLimitHandlerSuite) TestPostTokenRateLimited() {
+	tokenUsecase := NewMockTokenUsecase()
+	clientUsecase := NewMockClientUsecase()
+	clientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	rateLimitUsecase := NewMockRateLimitUsecase()
+	rateLimitUsecase.On("AllowSource", mock.Anything).Return(nil, nil)
+	rateLimitUsecase.On("Allow", "client-1", 0).Return(&gateway.RateLimitResult{Limit: 10, RetryAfter: time.Second}, usecase.ErrRateLimited)
+	tokenHandler := NewTokenHandler(tokenUsecase, nil, nil, clientUsecase, pkg.FixedClock{})
+
+	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader([]byte(`{"refreshToken":"refresh-token-1"}`)))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	middleware.RateLimit(rateLimitUsecase)(ginContext)
+
+	tokenHandler.PostToken(ginContext)
+
+	suite.Assert().Equal(http.StatusTooManyRequests, w.Code)
+	suite.Assert().Equal("1", w.Header().Get(middleware.RetryAfterHeader))
+	tokenUsecase.AssertNotCalled(suite.T(), "Refresh")
+}
@@ -1,73 +1,87 @@
 package handler
 
 import (
-	"encoding/base64"
 	"errors"
 	"net/http"
-	"strings"
+	"net/url"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 
 	"go-banking-api/adapter/controller/gin/presenter"
 	"go-banking-api/api"
 	"go-banking-api/entity"
-
 	"go-banking-api/pkg"
 	"go-banking-api/pkg/logger"
 	"go-banking-api/usecase"
 )
 
 type TokenHandler struct {
-	tokenUsecase  usecase.TokenUsecase
-	clientUsecase usecase.ClientUsecase
-	clock         pkg.Clock
+	tokenUsecase usecase.TokenUsecase
+	// authorizationCodeUsecase が nil の場合は認可コードをトークンと交換しない
+	authorizationCodeUsecase usecase.AuthorizationCodeUsecase
+	// deviceAuthorizationUsecase が nil の場合は device_code をトークンと交換しない
+	deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase
+	clientUsecase              usecase.ClientUsecase
+	clock                      pkg.Clock
 }
 
-func NewTokenHandler(tokenUsecase usecase.TokenUsecase, clientUsecase usecase.ClientUsecase, clock pkg.Clock) *TokenHandler {
+func NewTokenHandler(
+	tokenUsecase usecase.TokenUsecase,
+	authorizationCodeUsecase usecase.AuthorizationCodeUsecase,
+	deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase,
+	clientUsecase usecase.ClientUsecase,
+	clock pkg.Clock,
+) *TokenHandler {
 	if clock == nil {
 		clock = pkg.RealClock{}
 	}
 	return &TokenHandler{
-		tokenUsecase:  tokenUsecase,
-		clientUsecase: clientUsecase,
-		clock:         clock,
+		tokenUsecase:               tokenUsecase,
+		authorizationCodeUsecase:   authorizationCodeUsecase,
+		deviceAuthorizationUsecase: deviceAuthorizationUsecase,
+		clientUsecase:              clientUsecase,
+		clock:                      clock,
 	}
 }
 
 func (t *TokenHandler) PostToken(c *gin.Context) {
-	clientID, clientSecret, err := t.parseBasicAuth(c)
-	if err != nil {
-		logger.Info(err.Error())
-		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "client authentication is required"))
+	client, ok := authenticateClient(c, t.clientUsecase)
+	if !ok {
 		return
 	}
-
-	client, err := t.clientUsecase.Authenticate(clientID, clientSecret)
-	if err != nil {
-		logger.Info(err.Error())
-		c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "invalid client"))
+	// フォームで送られたものは grant_type で振り分け、RFC 6749 の形式で応答する
+	if c.ContentType() == binding.MIMEPOSTForm {
+		t.exchangeForm(c, client)
 		return
 	}
 
+	// JSON は従来の形式で、リフレッシュトークンによる再発行だけを受け付ける
 	var request presenter.TokenRequest
 	if err := c.ShouldBindJSON(&request); err != nil {
-		logger.Info(err.Error())
-		c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, "invalid request"))
+		logger.InfoContext(c.Request.Context(), err.Error())
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusBadRequest, presenter.ErrorCodeInvalidRequest))
 		return
 	}
 
-	token, err := t.tokenUsecase.Refresh(request.RefreshToken, client.ClientID)
+	token, err := t.tokenUsecase.Refresh(c.Request.Context(), request.RefreshToken, client)
 	if err != nil {
 		switch {
+		case errors.Is(err, usecase.ErrUnauthorizedClient):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusBadRequest, presenter.ErrorCodeUnauthorizedClient))
 		case errors.Is(err, usecase.ErrRefreshTokenRequired):
-			logger.Info(err.Error())
-			c.JSON(presenter.NewErrorResponse(http.StatusBadRequest, "refresh token is required"))
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusBadRequest, presenter.ErrorCodeRefreshTokenRequired))
 		case errors.Is(err, usecase.ErrInvalidRefreshToken):
-			logger.Info(err.Error())
-			c.JSON(presenter.NewErrorResponse(http.StatusUnauthorized, "invalid refresh token"))
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeInvalidRefreshToken))
+		case errors.Is(err, usecase.ErrConsentExpired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusUnauthorized, presenter.ErrorCodeConsentExpired))
 		default:
-			logger.Error(err.Error())
-			c.JSON(presenter.NewErrorResponse(http.StatusInternalServerError, "internal server error"))
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
 		}
 		return
 	}
@@ -88,30 +102,130 @@ func (t *TokenHandler) tokenToResponse(token *entity.Token) *presenter.TokenResp
 			RefreshToken: token.RefreshToken,
 			TokenType:    "Bearer",
 			ExpiresIn:    expiresIn,
+			IdToken:      optionalString(token.IDToken),
 		},
 	}
 }
 
-func (t *TokenHandler) parseBasicAuth(c *gin.Context) (string, string, error) {
-	authorization := c.GetHeader("Authorization")
-	if authorization == "" {
-		return "", "", errors.New("authorization header is required")
+func (t *TokenHandler) exchangeForm(c *gin.Context, client *entity.Client) {
+	c.Header("Cache-Control", "no-store")
+	if err := c.Request.ParseForm(); err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, "request body must be form encoded")
+		return
+	}
+	form := c.Request.PostForm
+	for _, values := range form {
+		if len(values) > 1 {
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, "parameters must not be repeated")
+			return
+		}
 	}
 
-	parts := strings.Fields(authorization)
-	if len(parts) != 2 || !strings.EqualFold(parts[0], "Basic") {
-		return "", "", errors.New("invalid authorization header")
+	switch grantType := form.Get("grant_type"); {
+	case grantType == entity.GrantTypeRefreshToken:
+		t.refreshForm(c, client, form)
+	case grantType == entity.GrantTypeAuthorizationCode && t.authorizationCodeUsecase != nil:
+		t.exchangeAuthorizationCode(c, client, form)
+	case grantType == entity.GrantTypeDeviceCode && t.deviceAuthorizationUsecase != nil:
+		t.exchangeDeviceCode(c, client, form)
+	case grantType == "":
+		t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, "grant_type is required")
+	default:
+		t.writeOAuthTokenError(c, presenter.OAuthTokenUnsupportedGrantType, "unsupported grant_type")
+	}
+}
+
+// refreshForm は JSON の再発行と同じ検証をし、RFC 6749 の形式で応答する
+func (t *TokenHandler) refreshForm(c *gin.Context, client *entity.Client, form url.Values) {
+	token, err := t.tokenUsecase.Refresh(c.Request.Context(), form.Get("refresh_token"), client)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrUnauthorizedClient):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenUnauthorizedClient, err.Error())
+		case errors.Is(err, usecase.ErrRefreshTokenRequired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, err.Error())
+		case errors.Is(err, usecase.ErrInvalidRefreshToken), errors.Is(err, usecase.ErrConsentExpired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidGrant, err.Error())
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
 	}
+	t.writeOAuthAccessToken(c, token)
+}
 
-	decoded, err := base64.StdEncoding.DecodeString(parts[1])
+func (t *TokenHandler) exchangeAuthorizationCode(c *gin.Context, client *entity.Client, form url.Values) {
+	token, err := t.authorizationCodeUsecase.Exchange(c.Request.Context(), client, form.Get("code"), form.Get("redirect_uri"), form.Get("code_verifier"))
 	if err != nil {
-		return "", "", errors.New("invalid basic auth")
+		switch {
+		case errors.Is(err, usecase.ErrUnauthorizedClient):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenUnauthorizedClient, err.Error())
+		case errors.Is(err, usecase.ErrAuthorizationCodeRequired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, err.Error())
+		case errors.Is(err, usecase.ErrInvalidAuthorizationCode):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidGrant, err.Error())
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
 	}
+	t.writeOAuthAccessToken(c, token)
+}
 
-	credentials := strings.SplitN(string(decoded), ":", 2)
-	if len(credentials) != 2 {
-		return "", "", errors.New("invalid basic auth")
+func (t *TokenHandler) exchangeDeviceCode(c *gin.Context, client *entity.Client, form url.Values) {
+	token, err := t.deviceAuthorizationUsecase.Exchange(c.Request.Context(), client, form.Get("device_code"))
+	if err != nil {
+		switch {
+		// デバイスは承認されるまでポーリングを続けるため、ログには残さない
+		case errors.Is(err, usecase.ErrAuthorizationPending):
+			t.writeOAuthTokenError(c, presenter.OAuthTokenAuthorizationPending, err.Error())
+		case errors.Is(err, usecase.ErrSlowDown):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenSlowDown, err.Error())
+		case errors.Is(err, usecase.ErrDeviceCodeExpired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenExpiredToken, err.Error())
+		case errors.Is(err, usecase.ErrDeviceAccessDenied):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenAccessDenied, err.Error())
+		case errors.Is(err, usecase.ErrDeviceCodeRequired):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidRequest, err.Error())
+		case errors.Is(err, usecase.ErrInvalidDeviceCode):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenInvalidGrant, err.Error())
+		case errors.Is(err, usecase.ErrUnauthorizedClient):
+			logger.InfoContext(c.Request.Context(), err.Error())
+			t.writeOAuthTokenError(c, presenter.OAuthTokenUnauthorizedClient, err.Error())
+		default:
+			logger.ErrorContext(c.Request.Context(), err.Error())
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusInternalServerError, presenter.ErrorCodeInternalServerError))
+		}
+		return
 	}
+	t.writeOAuthAccessToken(c, token)
+}
+
+func (t *TokenHandler) writeOAuthAccessToken(c *gin.Context, token *entity.Token) {
+	c.JSON(http.StatusOK, presenter.OAuthAccessToken{
+		AccessToken:  token.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    max(int(token.ExpiresAt.Sub(t.clock.Now()).Seconds()), 0),
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scopes,
+		IdToken:      optionalString(token.IDToken),
+	})
+}
 
-	return credentials[0], credentials[1], nil
+func (t *TokenHandler) writeOAuthTokenError(c *gin.Context, code presenter.OAuthTokenErrorError, description string) {
+	c.JSON(http.StatusBadRequest, presenter.OAuthTokenError{Error: code, ErrorDescription: &description})
 }
@@ -2,18 +2,23 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
 	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway/inmemory"
 	"go-banking-api/api"
 	"go-banking-api/entity"
 	"go-banking-api/pkg"
@@ -42,7 +47,7 @@ func (suite *TokenHandlerSuite) TestPostTokenSuccess() {
 	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
 	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(expectedToken, nil)
 
-	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, mockClientUsecase, clock)
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, clock)
 
 	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
 	suite.Assert().Nil(err)
@@ -67,6 +72,39 @@ func (suite *TokenHandlerSuite) TestPostTokenSuccess() {
 	suite.Assert().Equal(expectedToken.RefreshToken, tokenResponse.Data.RefreshToken)
 	suite.Assert().Equal("Bearer", tokenResponse.Data.TokenType)
 	suite.Assert().Equal(3600, tokenResponse.Data.ExpiresIn)
+	suite.Assert().Nil(tokenResponse.Data.IdToken)
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenIDToken() {
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockClientUsecase := NewMockClientUsecase()
+	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(&entity.Token{
+		AccessToken:  "access-token-1",
+		RefreshToken: "refresh-token-2",
+		ExpiresAt:    fixedNow.Add(1 * time.Hour),
+		IDToken:      "id-token-1",
+	}, nil)
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: fixedNow})
+
+	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
+	suite.Require().NoError(err)
+	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var tokenResponse presenter.TokenResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &tokenResponse))
+	suite.Require().NotNil(tokenResponse.Data.IdToken)
+	suite.Assert().Equal("id-token-1", *tokenResponse.Data.IdToken)
 }
 
 func (suite *TokenHandlerSuite) TestPostTokenMissingRefreshToken() {
@@ -75,7 +113,7 @@ func (suite *TokenHandlerSuite) TestPostTokenMissingRefreshToken() {
 	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
 	mockTokenUsecase.On("Refresh", "", "client-1").Return(nil, usecase.ErrRefreshTokenRequired)
 
-	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
 
 	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader([]byte(`{}`)))
 	suite.Assert().Nil(err)
@@ -102,7 +140,7 @@ func (suite *TokenHandlerSuite) TestPostTokenInvalidRefreshToken() {
 	mockClientUsecase := NewMockClientUsecase()
 	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
 	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, usecase.ErrInvalidRefreshToken)
-	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
 
 	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
 	suite.Assert().Nil(err)
@@ -126,12 +164,62 @@ func (suite *TokenHandlerSuite) TestPostTokenInvalidRefreshToken() {
 	suite.Assert().Equal("invalid refresh token", errorResponse.Error.Message)
 }
 
+func (suite *TokenHandlerSuite) TestPostTokenConsentExpired() {
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, usecase.ErrConsentExpired)
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+
+	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
+	suite.Require().NoError(err)
+	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+
+	var errorResponse presenter.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errorResponse))
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.Assert().Equal(presenter.Message(presenter.ErrorCodeConsentExpired, presenter.LanguageEnglish), errorResponse.Error.Message)
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenUnauthorizedClient() {
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, usecase.ErrUnauthorizedClient)
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+
+	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
+	suite.Require().NoError(err)
+	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+
+	var errorResponse presenter.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errorResponse))
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	suite.Assert().Equal(presenter.Message(presenter.ErrorCodeUnauthorizedClient, presenter.LanguageEnglish), errorResponse.Error.Message)
+}
+
 func (suite *TokenHandlerSuite) TestPostTokenUsecaseError() {
 	mockTokenUsecase := NewMockTokenUsecase()
 	mockClientUsecase := NewMockClientUsecase()
 	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
 	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, errors.New("db error"))
-	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
 
 	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
 	suite.Assert().Nil(err)
@@ -154,3 +242,303 @@ func (suite *TokenHandlerSuite) TestPostTokenUsecaseError() {
 	suite.Assert().Equal(http.StatusInternalServerError, errorResponse.Error.Code)
 	suite.Assert().Equal("internal server error", errorResponse.Error.Message)
 }
+
+func (suite *TokenHandlerSuite) TestPostTokenClientLocked() {
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(nil, usecase.ErrClientLocked)
+	suite.tokenHandler = NewTokenHandler(mockTokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Now()})
+
+	body, err := json.Marshal(presenter.TokenRequest{RefreshToken: "refresh-token-1"})
+	suite.Assert().Nil(err)
+	request, err := http.NewRequest("POST", "/api/v1/token", bytes.NewReader(body))
+	suite.Assert().Nil(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+
+	// ロック中であることは明かさず、シークレット誤りと同じ応答にする
+	var errorResponse presenter.ErrorResponse
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &errorResponse))
+	suite.Assert().Equal(http.StatusUnauthorized, w.Code)
+	suite.Assert().Equal("invalid client", errorResponse.Error.Message)
+	mockTokenUsecase.AssertNotCalled(suite.T(), "Refresh")
+}
+
+func (suite *TokenHandlerSuite) postDeviceCode(deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase, now time.Time, body string) *httptest.ResponseRecorder {
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "tv-app", "secret-1").Return(&entity.Client{ClientID: "tv-app"}, nil)
+	suite.tokenHandler = NewTokenHandler(NewMockTokenUsecase(), nil, deviceAuthorizationUsecase, mockClientUsecase, pkg.FixedClock{T: now})
+
+	request, err := http.NewRequest("POST", "/api/v1/token", strings.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("tv-app", "secret-1")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+	return w
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenDeviceCode() {
+	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	deviceAuthorizationUsecase := NewMockDeviceAuthorizationUsecase()
+	deviceAuthorizationUsecase.On("Exchange", "tv-app", "device-code-1").Return(&entity.Token{
+		AccessToken:  "access-token-1",
+		RefreshToken: "refresh-token-1",
+		Scopes:       "openid read:account_and_transactions",
+		IDToken:      "id-token-1",
+		ExpiresAt:    fixedNow.Add(time.Hour),
+	}, nil)
+
+	w := suite.postDeviceCode(deviceAuthorizationUsecase, fixedNow, "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=device-code-1")
+
+	// デバイス認可グラントは RFC 6749 の形式で応答する
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().JSONEq(`{
+		"access_token": "access-token-1",
+		"token_type": "Bearer",
+		"expires_in": 3600,
+		"refresh_token": "refresh-token-1",
+		"scope": "openid read:account_and_transactions",
+		"id_token": "id-token-1"
+	}`, w.Body.String())
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenDeviceCodeErrors() {
+	tests := []struct {
+		err  error
+		want presenter.OAuthTokenErrorError
+	}{
+		{usecase.ErrAuthorizationPending, presenter.OAuthTokenAuthorizationPending},
+		{usecase.ErrSlowDown, presenter.OAuthTokenSlowDown},
+		{usecase.ErrDeviceCodeExpired, presenter.OAuthTokenExpiredToken},
+		{usecase.ErrDeviceAccessDenied, presenter.OAuthTokenAccessDenied},
+		{usecase.ErrDeviceCodeRequired, presenter.OAuthTokenInvalidRequest},
+		{usecase.ErrInvalidDeviceCode, presenter.OAuthTokenInvalidGrant},
+		{usecase.ErrUnauthorizedClient, presenter.OAuthTokenUnauthorizedClient},
+	}
+	for _, test := range tests {
+		suite.Run(string(test.want), func() {
+			deviceAuthorizationUsecase := NewMockDeviceAuthorizationUsecase()
+			deviceAuthorizationUsecase.On("Exchange", "tv-app", "device-code-1").Return(nil, test.err)
+			w := suite.postDeviceCode(deviceAuthorizationUsecase, time.Now(), "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=device-code-1")
+
+			suite.Assert().Equal(http.StatusBadRequest, w.Code)
+			var response presenter.OAuthTokenError
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Assert().Equal(test.want, response.Error)
+		})
+	}
+
+	deviceAuthorizationUsecase := NewMockDeviceAuthorizationUsecase()
+	deviceAuthorizationUsecase.On("Exchange", "tv-app", "device-code-1").Return(nil, errors.New("db error"))
+	w := suite.postDeviceCode(deviceAuthorizationUsecase, time.Now(), "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=device-code-1")
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenUnsupportedGrantType() {
+	deviceAuthorizationUsecase := NewMockDeviceAuthorizationUsecase()
+	for _, test := range []struct {
+		deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase
+		body                       string
+	}{
+		{deviceAuthorizationUsecase, "grant_type=client_credentials"},
+		// 認可コードグラントが無効の場合
+		{deviceAuthorizationUsecase, "grant_type=authorization_code&code=code-1"},
+		// デバイス認可グラントが無効の場合
+		{nil, "grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Adevice_code&device_code=device-code-1"},
+	} {
+		w := suite.postDeviceCode(test.deviceAuthorizationUsecase, time.Now(), test.body)
+		suite.Assert().Equal(http.StatusBadRequest, w.Code)
+		var response presenter.OAuthTokenError
+		suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+		suite.Assert().Equal(presenter.OAuthTokenUnsupportedGrantType, response.Error)
+	}
+	deviceAuthorizationUsecase.AssertNotCalled(suite.T(), "Exchange", mock.Anything, mock.Anything)
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenMissingGrantType() {
+	deviceAuthorizationUsecase := NewMockDeviceAuthorizationUsecase()
+	w := suite.postDeviceCode(deviceAuthorizationUsecase, time.Now(), "device_code=device-code-1")
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	var response presenter.OAuthTokenError
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(presenter.OAuthTokenInvalidRequest, response.Error)
+	deviceAuthorizationUsecase.AssertNotCalled(suite.T(), "Exchange", mock.Anything, mock.Anything)
+}
+
+func (suite *TokenHandlerSuite) postAuthorizationCode(authorizationCodeUsecase usecase.AuthorizationCodeUsecase, client *entity.Client, body string) *httptest.ResponseRecorder {
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "web-app", "secret-1").Return(client, nil)
+	suite.tokenHandler = NewTokenHandler(NewMockTokenUsecase(), authorizationCodeUsecase, nil, mockClientUsecase, pkg.FixedClock{T: time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)})
+
+	request, err := http.NewRequest("POST", "/api/v1/token", strings.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("web-app", "secret-1")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+	return w
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenAuthorizationCode() {
+	client := &entity.Client{ClientID: "web-app"}
+	authorizationCodeUsecase := NewMockAuthorizationCodeUsecase()
+	authorizationCodeUsecase.On("Exchange", client, "code-1", "https://app.example.com/callback", "verifier-1").Return(&entity.Token{
+		AccessToken:  "access-token-1",
+		RefreshToken: "refresh-token-1",
+		Scopes:       "openid read:account_and_transactions",
+		IDToken:      "id-token-1",
+		ExpiresAt:    time.Date(2025, 12, 21, 1, 0, 0, 0, time.UTC),
+	}, nil)
+
+	w := suite.postAuthorizationCode(authorizationCodeUsecase, client, "grant_type=authorization_code&code=code-1&redirect_uri=https%3A%2F%2Fapp.example.com%2Fcallback&code_verifier=verifier-1")
+
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().JSONEq(`{
+		"access_token": "access-token-1",
+		"token_type": "Bearer",
+		"expires_in": 3600,
+		"refresh_token": "refresh-token-1",
+		"scope": "openid read:account_and_transactions",
+		"id_token": "id-token-1"
+	}`, w.Body.String())
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenAuthorizationCodeErrors() {
+	client := &entity.Client{ClientID: "web-app"}
+	tests := []struct {
+		err  error
+		want presenter.OAuthTokenErrorError
+	}{
+		{usecase.ErrUnauthorizedClient, presenter.OAuthTokenUnauthorizedClient},
+		{usecase.ErrAuthorizationCodeRequired, presenter.OAuthTokenInvalidRequest},
+		{fmt.Errorf("%w: redirect_uri mismatch", usecase.ErrInvalidAuthorizationCode), presenter.OAuthTokenInvalidGrant},
+	}
+	for _, test := range tests {
+		suite.Run(string(test.want), func() {
+			authorizationCodeUsecase := NewMockAuthorizationCodeUsecase()
+			authorizationCodeUsecase.On("Exchange", client, "code-1", "", "").Return(nil, test.err)
+			w := suite.postAuthorizationCode(authorizationCodeUsecase, client, "grant_type=authorization_code&code=code-1")
+
+			suite.Assert().Equal(http.StatusBadRequest, w.Code)
+			var response presenter.OAuthTokenError
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Assert().Equal(test.want, response.Error)
+		})
+	}
+
+	authorizationCodeUsecase := NewMockAuthorizationCodeUsecase()
+	authorizationCodeUsecase.On("Exchange", client, "code-1", "", "").Return(nil, errors.New("db error"))
+	w := suite.postAuthorizationCode(authorizationCodeUsecase, client, "grant_type=authorization_code&code=code-1")
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
+
+func (suite *TokenHandlerSuite) postRefreshTokenForm(tokenUsecase usecase.TokenUsecase, body string) *httptest.ResponseRecorder {
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", "client-1", "secret-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	suite.tokenHandler = NewTokenHandler(tokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)})
+
+	request, err := http.NewRequest("POST", "/api/v1/token", strings.NewReader(body))
+	suite.Require().NoError(err)
+	request.SetBasicAuth("client-1", "secret-1")
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+
+	suite.tokenHandler.PostToken(ginContext)
+	return w
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenRefreshTokenForm() {
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(&entity.Token{
+		AccessToken:  "access-token-1",
+		RefreshToken: "refresh-token-2",
+		Scopes:       "read:account_and_transactions",
+		ExpiresAt:    time.Date(2025, 12, 21, 1, 0, 0, 0, time.UTC),
+	}, nil)
+
+	w := suite.postRefreshTokenForm(mockTokenUsecase, "grant_type=refresh_token&refresh_token=refresh-token-1")
+
+	// フォームで送られた再発行は RFC 6749 の形式で応答する
+	suite.Require().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("no-store", w.Header().Get("Cache-Control"))
+	suite.Assert().JSONEq(`{
+		"access_token": "access-token-1",
+		"token_type": "Bearer",
+		"expires_in": 3600,
+		"refresh_token": "refresh-token-2",
+		"scope": "read:account_and_transactions"
+	}`, w.Body.String())
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenRefreshTokenFormKeepsScope() {
+	now := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	store := inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(store, pkg.FixedClock{T: now}))
+	client, err := store.Client().Get(context.Background(), inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	mockClientUsecase := NewMockClientUsecase()
+	mockClientUsecase.On("Authenticate", inmemory.SeedClientID, inmemory.SeedClientSecret).Return(client, nil)
+	tokenUsecase := usecase.NewTokenUsecase(store.Token(), store.Audit(), inmemory.NewTransactionManager(store), nil, pkg.FixedClock{T: now})
+	suite.tokenHandler = NewTokenHandler(tokenUsecase, nil, nil, mockClientUsecase, pkg.FixedClock{T: now})
+
+	request, err := http.NewRequest("POST", "/api/v1/token", strings.NewReader("grant_type=refresh_token&refresh_token="+inmemory.SeedRefreshToken))
+	suite.Require().NoError(err)
+	request.SetBasicAuth(inmemory.SeedClientID, inmemory.SeedClientSecret)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.tokenHandler.PostToken(ginContext)
+
+	// 再発行したトークンのスコープは元のトークンのもの
+	suite.Require().Equal(http.StatusOK, w.Code)
+	var response presenter.OAuthAccessToken
+	suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("read:account_and_transactions", response.Scope)
+}
+
+func (suite *TokenHandlerSuite) TestPostTokenRefreshTokenFormErrors() {
+	tests := []struct {
+		err  error
+		want presenter.OAuthTokenErrorError
+	}{
+		{usecase.ErrUnauthorizedClient, presenter.OAuthTokenUnauthorizedClient},
+		{usecase.ErrRefreshTokenRequired, presenter.OAuthTokenInvalidRequest},
+		{usecase.ErrInvalidRefreshToken, presenter.OAuthTokenInvalidGrant},
+		{usecase.ErrConsentExpired, presenter.OAuthTokenInvalidGrant},
+	}
+	for _, test := range tests {
+		suite.Run(test.err.Error(), func() {
+			mockTokenUsecase := NewMockTokenUsecase()
+			mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, test.err)
+			w := suite.postRefreshTokenForm(mockTokenUsecase, "grant_type=refresh_token&refresh_token=refresh-token-1")
+
+			suite.Assert().Equal(http.StatusBadRequest, w.Code)
+			var response presenter.OAuthTokenError
+			suite.Require().NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			suite.Assert().Equal(test.want, response.Error)
+		})
+	}
+
+	mockTokenUsecase := NewMockTokenUsecase()
+	mockTokenUsecase.On("Refresh", "refresh-token-1", "client-1").Return(nil, errors.New("db error"))
+	w := suite.postRefreshTokenForm(mockTokenUsecase, "grant_type=refresh_token&refresh_token=refresh-token-1")
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
@@ -8,5 +8,7 @@ import (
 func CorsMiddleware(allowOrigins []string) gin.HandlerFunc {
 	config := cors.DefaultConfig()
 	config.AllowOrigins = allowOrigins
+	config.AddAllowHeaders(RequestIDHeader)
+	config.AddExposeHeaders(RequestIDHeader, "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After")
 	return cors.New(config)
 }
@@ -5,12 +5,20 @@ import (
 
 	ginzap "github.com/gin-contrib/zap"
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap/zapcore"
 
 	"go-banking-api/pkg/logger"
 )
 
 func GinZap() gin.HandlerFunc {
-	return ginzap.Ginzap(logger.ZapLogger, time.RFC3339, true)
+	return ginzap.GinzapWithConfig(logger.ZapLogger, &ginzap.Config{
+		TimeFormat:   time.RFC3339,
+		UTC:          true,
+		DefaultLevel: zapcore.InfoLevel,
+		Context: func(c *gin.Context) []zapcore.Field {
+			return logger.Fields(c.Request.Context())
+		},
+	})
 }
 
 func RecoveryWithZap() gin.HandlerFunc {
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/pkg/metrics"
+)
+
+const unknownOperation = "unknown"
+
+// Metrics はリクエスト数とレイテンシを OpenAPI の operationId ごとに記録する。
+// operationIDs のキーは "METHOD /gin/route"（例: "GET /api/v1/accounts"）。
+func Metrics(operationIDs map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		operation, ok := operationIDs[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			operation = unknownOperation
+		}
+		metrics.ObserveHTTPRequest(operation, c.Request.Method, strconv.Itoa(c.Writer.Status()), time.Since(start))
+	}
+}
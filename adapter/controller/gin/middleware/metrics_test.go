@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/pkg/metrics"
+)
+
+func TestMetrics(t *testing.T) {
+	router := gin.New()
+	router.Use(Metrics(map[string]string{"GET /api/v1/accounts": "getAccountInformation"}))
+	router.GET("/api/v1/accounts", func(c *gin.Context) {
+		c.Status(http.StatusTeapot)
+	})
+	router.GET("/api/v1/other", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/api/v1/accounts", "/api/v1/other"} {
+		w := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", path, nil)
+		router.ServeHTTP(w, request)
+	}
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	metrics.Handler().ServeHTTP(w, request)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `banking_http_requests_total{method="GET",operation="getAccountInformation",status="418"} 1`)
+	assert.Contains(t, w.Body.String(), `banking_http_requests_total{method="GET",operation="unknown",status="200"} 1`)
+	assert.Contains(t, w.Body.String(), `banking_http_request_duration_seconds_count{method="GET",operation="getAccountInformation",status="418"} 1`)
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+const (
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RetryAfterHeader         = "Retry-After"
+
+	rateLimitUsecaseKey = "rateLimitUsecase"
+)
+
+// RateLimit はリクエストにレート制限を設定する。rateLimitUsecase が nil の場合は制限しない。
+// 認証に失敗するリクエストも制限するよう、接続元 IP ごとの制限はここで認証より前に確認する。
+// 制限の単位になるクライアントと顧客は認証するまで分からないため、そちらは認証した箇所で AllowRequest を呼んで確認する。
+func RateLimit(rateLimitUsecase usecase.RateLimitUsecase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if rateLimitUsecase == nil {
+			c.Next()
+			return
+		}
+		result, err := rateLimitUsecase.AllowSource(c.Request.Context(), c.ClientIP())
+		if !writeRateLimitResult(c, result, err) {
+			c.Abort()
+			return
+		}
+		c.Set(rateLimitUsecaseKey, rateLimitUsecase)
+		c.Next()
+	}
+}
+
+// AllowRequest は認証済みのクライアント（と顧客）のレート制限を確認し、RateLimit-* ヘッダーを付与する。
+// 超過した場合は 429 を書き込んで false を返す。RateLimit で制限を設定していない場合は制限しない。
+func AllowRequest(c *gin.Context, clientID string, cifNo int) bool {
+	rateLimitUsecase, ok := rateLimitUsecaseFrom(c)
+	if !ok {
+		return true
+	}
+	result, err := rateLimitUsecase.Allow(c.Request.Context(), clientID, cifNo)
+	return writeRateLimitResult(c, result, err)
+}
+
+// AllowRegistration は認証なしで受け付ける動的クライアント登録のレート制限を接続元 IP ごとに確認する。
+// 応答は AllowRequest と同じ。
+func AllowRegistration(c *gin.Context) bool {
+	rateLimitUsecase, ok := rateLimitUsecaseFrom(c)
+	if !ok {
+		return true
+	}
+	result, err := rateLimitUsecase.AllowRegistration(c.Request.Context(), c.ClientIP())
+	return writeRateLimitResult(c, result, err)
+}
+
+func rateLimitUsecaseFrom(c *gin.Context) (usecase.RateLimitUsecase, bool) {
+	value, _ := c.Get(rateLimitUsecaseKey)
+	rateLimitUsecase, ok := value.(usecase.RateLimitUsecase)
+	return rateLimitUsecase, ok
+}
+
+func writeRateLimitResult(c *gin.Context, result *gateway.RateLimitResult, err error) bool {
+	if err != nil && !errors.Is(err, usecase.ErrRateLimited) {
+		// 制限のストアに障害があってもサービスは止めない
+		logger.ErrorContext(c.Request.Context(), "rate limiter is unavailable", "error", err)
+		return true
+	}
+	if result != nil {
+		c.Header(RateLimitLimitHeader, strconv.Itoa(result.Limit))
+		c.Header(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+		c.Header(RateLimitResetHeader, ceilSeconds(result.Reset))
+	}
+	if err != nil {
+		logger.InfoContext(c.Request.Context(), err.Error())
+		if result != nil {
+			c.Header(RetryAfterHeader, ceilSeconds(result.RetryAfter))
+		}
+		c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusTooManyRequests, presenter.ErrorCodeRateLimitExceeded))
+		return false
+	}
+	return true
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/requestid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+// ログへの混入を防ぐため、受け取った ID は英数字と一部の記号のみ許可する
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID は X-Request-ID を引き継ぐか新たに採番し、レスポンスヘッダーとログに付与する
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		fields := []zap.Field{zap.String("request_id", requestID)}
+		if route := c.FullPath(); route != "" {
+			fields = append(fields, zap.String("route", route))
+		}
+		ctx := logger.With(requestid.With(c.Request.Context(), requestID), fields...)
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", requestID))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/requestid"
+)
+
+func serveRequestID(t *testing.T, requestID string) (*httptest.ResponseRecorder, []zap.Field) {
+	t.Helper()
+	var fields []zap.Field
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/api/v1/accounts", func(c *gin.Context) {
+		fields = logger.Fields(c.Request.Context())
+		assert.Equal(t, c.Writer.Header().Get(RequestIDHeader), requestid.FromContext(c.Request.Context()))
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
+	if requestID != "" {
+		request.Header.Set(RequestIDHeader, requestID)
+	}
+	router.ServeHTTP(w, request)
+	return w, fields
+}
+
+func TestRequestIDPropagated(t *testing.T) {
+	w, fields := serveRequestID(t, "req-123")
+
+	assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+	assert.Contains(t, fields, zap.String("request_id", "req-123"))
+	assert.Contains(t, fields, zap.String("route", "/api/v1/accounts"))
+}
+
+func TestRequestIDGenerated(t *testing.T) {
+	w, fields := serveRequestID(t, "")
+
+	requestID := w.Header().Get(RequestIDHeader)
+	assert.NoError(t, uuid.Validate(requestID))
+	assert.Contains(t, fields, zap.String("request_id", requestID))
+}
+
+func TestRequestIDInvalidReplaced(t *testing.T) {
+	for _, requestID := range []string{"bad id\nforged=1", strings.Repeat("a", 129)} {
+		w, _ := serveRequestID(t, requestID)
+		assert.NotEqual(t, requestID, w.Header().Get(RequestIDHeader))
+		assert.NoError(t, uuid.Validate(w.Header().Get(RequestIDHeader)))
+	}
+}
@@ -14,7 +14,7 @@ func TimeoutMiddleware(duration time.Duration) gin.HandlerFunc {
 	return timeout.New(
 		timeout.WithTimeout(duration),
 		timeout.WithResponse(func(c *gin.Context) {
-			c.JSON(presenter.NewErrorResponse(http.StatusRequestTimeout, "timeout"))
+			c.JSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), http.StatusRequestTimeout, presenter.ErrorCodeTimeout))
 			c.Abort()
 		}),
 	)
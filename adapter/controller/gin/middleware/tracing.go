@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/tracing"
+)
+
+// Tracing はリクエストごとにサーバースパンを開始し、c.Request のコンテキストに入れる。
+// 受け取った W3C traceparent があればその子スパンになる。
+// スパン名は OpenAPI の operationId、定義外のルートは "METHOD /gin/route"。
+func Tracing(operationIDs map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		route := c.FullPath()
+		name, ok := operationIDs[c.Request.Method+" "+route]
+		if !ok {
+			name = c.Request.Method + " " + route
+		}
+		attributes := []trace.SpanStartOption{
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				// ユーザーコードなどをパスに含むルートがあるため、ログと同じく伏せる
+				semconv.URLPath(logger.Redact(c.Request.URL.Path)),
+			),
+		}
+		if route != "" {
+			attributes = append(attributes, trace.WithAttributes(semconv.HTTPRoute(route)))
+		}
+		ctx, span := tracing.Start(ctx, name, attributes...)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+		if status >= 500 {
+			span.SetStatus(codes.Error, "")
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-banking-api/pkg/tester"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tester.NewSpanRecorder()
+
+	var handlerSpan trace.SpanContext
+	router := gin.New()
+	router.Use(Tracing(map[string]string{"GET /api/v1/accounts": "getAccountInformation"}))
+	router.GET("/api/v1/accounts", func(c *gin.Context) {
+		handlerSpan = trace.SpanContextFromContext(c.Request.Context())
+		c.Status(http.StatusInternalServerError)
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/accounts", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(w, request)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "getAccountInformation", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+	assert.Contains(t, span.Attributes(), semconv.HTTPRoute("/api/v1/accounts"))
+	assert.Contains(t, span.Attributes(), semconv.HTTPResponseStatusCode(http.StatusInternalServerError))
+	assert.Equal(t, "Error", span.Status().Code.String())
+}
+
+func TestTracingUnknownOperation(t *testing.T) {
+	recorder := tester.NewSpanRecorder()
+
+	router := gin.New()
+	router.Use(Tracing(nil))
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/health", nil)
+	router.ServeHTTP(w, request)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /health", spans[0].Name())
+	assert.False(t, spans[0].Parent().IsValid())
+}
+
+func TestTracingRedactsPath(t *testing.T) {
+	recorder := tester.NewSpanRecorder()
+
+	router := gin.New()
+	router.Use(Tracing(nil))
+	router.POST("/api/v1/device-verifications/:userCode/approve", func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/api/v1/device-verifications/BCDF-GHJK/approve", nil)
+	router.ServeHTTP(w, request)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Contains(t, spans[0].Attributes(), semconv.URLPath("/api/v1/device-verifications/[REDACTED]/approve"))
+	assert.Contains(t, spans[0].Attributes(), semconv.HTTPRoute("/api/v1/device-verifications/:userCode/approve"))
+}
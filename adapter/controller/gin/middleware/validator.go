@@ -0,0 +1,19 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-banking-api/adapter/controller/gin/presenter"
+	"go-banking-api/pkg/logger"
+)
+
+func ValidationErrorHandler(c *gin.Context, message string, statusCode int) {
+	logger.InfoContext(c.Request.Context(), message)
+	errorCode := presenter.ErrorCodeInvalidRequest
+	if statusCode == http.StatusNotFound {
+		errorCode = presenter.ErrorCodeNotFound
+	}
+	c.AbortWithStatusJSON(presenter.NewErrorResponse(c.GetHeader("Accept-Language"), statusCode, errorCode))
+}
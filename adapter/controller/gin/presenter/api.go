@@ -15,9 +15,11 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
+	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -28,10 +30,80 @@ const (
 
 // Defines values for AccountStatus.
 const (
-	Active  AccountStatus = "active"
-	Closed  AccountStatus = "closed"
-	Dormant AccountStatus = "dormant"
-	Frozen  AccountStatus = "frozen"
+	AccountStatusActive  AccountStatus = "active"
+	AccountStatusClosed  AccountStatus = "closed"
+	AccountStatusDormant AccountStatus = "dormant"
+	AccountStatusFrozen  AccountStatus = "frozen"
+)
+
+// Defines values for AuditEventOutcome.
+const (
+	Failure AuditEventOutcome = "failure"
+	Success AuditEventOutcome = "success"
+)
+
+// Defines values for AuditOperation.
+const (
+	AccountRead        AuditOperation = "account.read"
+	AuditRead          AuditOperation = "audit.read"
+	ClientAuthenticate AuditOperation = "client.authenticate"
+	ClientCreate       AuditOperation = "client.create"
+	ClientDelete       AuditOperation = "client.delete"
+	ClientDisable      AuditOperation = "client.disable"
+	ClientEnable       AuditOperation = "client.enable"
+	ClientLockout      AuditOperation = "client.lockout"
+	ClientRegister     AuditOperation = "client.register"
+	ClientSecretAdd    AuditOperation = "client.secret.add"
+	ClientSecretRetire AuditOperation = "client.secret.retire"
+	ClientUnlock       AuditOperation = "client.unlock"
+	ClientUpdate       AuditOperation = "client.update"
+	ConsentGrant       AuditOperation = "consent.grant"
+	ConsentRevoke      AuditOperation = "consent.revoke"
+	TokenIssue         AuditOperation = "token.issue"
+	TokenRefresh       AuditOperation = "token.refresh"
+	UserinfoRead       AuditOperation = "userinfo.read"
+)
+
+// Defines values for AuthorizationRequestErrorError.
+const (
+	InvalidRequest          AuthorizationRequestErrorError = "invalid_request"
+	InvalidRequestObject    AuthorizationRequestErrorError = "invalid_request_object"
+	InvalidRequestUri       AuthorizationRequestErrorError = "invalid_request_uri"
+	InvalidScope            AuthorizationRequestErrorError = "invalid_scope"
+	UnauthorizedClient      AuthorizationRequestErrorError = "unauthorized_client"
+	UnsupportedResponseType AuthorizationRequestErrorError = "unsupported_response_type"
+)
+
+// Defines values for ConsentStatus.
+const (
+	ConsentStatusActive  ConsentStatus = "active"
+	ConsentStatusExpired ConsentStatus = "expired"
+	ConsentStatusRevoked ConsentStatus = "revoked"
+)
+
+// Defines values for DeviceAuthorizationErrorError.
+const (
+	DeviceAuthorizationInvalidRequest     DeviceAuthorizationErrorError = "invalid_request"
+	DeviceAuthorizationInvalidScope       DeviceAuthorizationErrorError = "invalid_scope"
+	DeviceAuthorizationUnauthorizedClient DeviceAuthorizationErrorError = "unauthorized_client"
+)
+
+// Defines values for OAuthTokenErrorError.
+const (
+	OAuthTokenAccessDenied         OAuthTokenErrorError = "access_denied"
+	OAuthTokenAuthorizationPending OAuthTokenErrorError = "authorization_pending"
+	OAuthTokenExpiredToken         OAuthTokenErrorError = "expired_token"
+	OAuthTokenInvalidGrant         OAuthTokenErrorError = "invalid_grant"
+	OAuthTokenInvalidRequest       OAuthTokenErrorError = "invalid_request"
+	OAuthTokenSlowDown             OAuthTokenErrorError = "slow_down"
+	OAuthTokenUnauthorizedClient   OAuthTokenErrorError = "unauthorized_client"
+	OAuthTokenUnsupportedGrantType OAuthTokenErrorError = "unsupported_grant_type"
+)
+
+// Defines values for RegistrationErrorError.
+const (
+	InvalidClientMetadata RegistrationErrorError = "invalid_client_metadata"
+	InvalidRedirectUri    RegistrationErrorError = "invalid_redirect_uri"
 )
 
 // Account defines model for Account.
@@ -53,21 +125,328 @@ type AccountStatus string
 // ApiVersion defines model for ApiVersion.
 type ApiVersion = string
 
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	CifNo      *int              `json:"cifNo,omitempty"`
+	ClientId   string            `json:"clientId"`
+	Hash       string            `json:"hash"`
+	Id         int64             `json:"id"`
+	OccurredAt time.Time         `json:"occurredAt"`
+	Operation  AuditOperation    `json:"operation"`
+	Outcome    AuditEventOutcome `json:"outcome"`
+	PrevHash   string            `json:"prevHash"`
+	Reason     *string           `json:"reason,omitempty"`
+	RequestId  *string           `json:"requestId,omitempty"`
+}
+
+// AuditEventOutcome defines model for AuditEvent.Outcome.
+type AuditEventOutcome string
+
+// AuditEventList defines model for AuditEventList.
+type AuditEventList struct {
+	Events []AuditEvent `json:"events"`
+
+	// NextCursor Pass as the after parameter to fetch the next page. Omitted on the last page.
+	NextCursor *int64 `json:"nextCursor,omitempty"`
+}
+
+// AuditOperation defines model for AuditOperation.
+type AuditOperation string
+
+// AuthorizationRedirect defines model for AuthorizationRedirect.
+type AuthorizationRedirect struct {
+	// RedirectUri The client's redirect_uri with code (or error) and state, and iss (RFC 9207) when OpenID Connect is enabled.
+	RedirectUri string `json:"redirectUri"`
+}
+
+// AuthorizationRequestError defines model for AuthorizationRequestError.
+type AuthorizationRequestError struct {
+	Error            AuthorizationRequestErrorError `json:"error"`
+	ErrorDescription *string                        `json:"error_description,omitempty"`
+}
+
+// AuthorizationRequestErrorError defines model for AuthorizationRequestError.Error.
+type AuthorizationRequestErrorError string
+
+// AuthorizationVerification defines model for AuthorizationVerification.
+type AuthorizationVerification struct {
+	ClientId   string    `json:"clientId"`
+	ClientName string    `json:"clientName"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Scopes     []string  `json:"scopes"`
+}
+
 // BaseDate defines model for BaseDate.
 type BaseDate = openapi_types.Date
 
+// ClientInformation defines model for ClientInformation.
+type ClientInformation struct {
+	ClientId         string `json:"client_id"`
+	ClientIdIssuedAt int64  `json:"client_id_issued_at"`
+	ClientName       string `json:"client_name"`
+
+	// ClientSecret Returned only on registration.
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// ClientSecretExpiresAt Always 0 (does not expire).
+	ClientSecretExpiresAt int64    `json:"client_secret_expires_at"`
+	GrantTypes            []string `json:"grant_types"`
+	RedirectUris          []string `json:"redirect_uris"`
+
+	// RegistrationAccessToken Returned on registration and update.
+	RegistrationAccessToken *string `json:"registration_access_token,omitempty"`
+	RegistrationClientUri   string  `json:"registration_client_uri"`
+	Scope                   string  `json:"scope"`
+	TokenEndpointAuthMethod string  `json:"token_endpoint_auth_method"`
+}
+
+// ClientMetadata Client metadata (RFC 7591). Omitted fields use the defaults.
+type ClientMetadata struct {
+	// ClientId Must match the path when updating.
+	ClientId   *string `json:"client_id,omitempty"`
+	ClientName string  `json:"client_name"`
+
+	// GrantTypes refresh_token and urn:ietf:params:oauth:grant-type:device_code are supported. Defaults to refresh_token.
+	GrantTypes   *[]string `json:"grant_types,omitempty"`
+	RedirectUris *[]string `json:"redirect_uris,omitempty"`
+
+	// Scope Space separated. Defaults to all scopes allowed for registered clients.
+	Scope *string `json:"scope,omitempty"`
+
+	// TokenEndpointAuthMethod Only client_secret_basic is supported.
+	TokenEndpointAuthMethod *string `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// ClientSecret defines model for ClientSecret.
+type ClientSecret struct {
+	Active bool `json:"active"`
+
+	// ClientSecret Returned only when the secret is created.
+	ClientSecret *string    `json:"clientSecret,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	Id           int64      `json:"id"`
+	NotAfter     *time.Time `json:"notAfter,omitempty"`
+}
+
+// ClientSecretList defines model for ClientSecretList.
+type ClientSecretList struct {
+	Secrets []ClientSecret `json:"secrets"`
+}
+
+// Consent defines model for Consent.
+type Consent struct {
+	Accounts   []ConsentAccount `json:"accounts"`
+	ClientId   string           `json:"clientId"`
+	ClientName string           `json:"clientName"`
+	CreatedAt  time.Time        `json:"createdAt"`
+
+	// ExpiresAt Tokens cannot be refreshed after this time until the customer consents again.
+	ExpiresAt time.Time     `json:"expiresAt"`
+	Id        int64         `json:"id"`
+	RevokedAt *time.Time    `json:"revokedAt,omitempty"`
+	Scopes    []string      `json:"scopes"`
+	Status    ConsentStatus `json:"status"`
+}
+
+// ConsentStatus defines model for Consent.Status.
+type ConsentStatus string
+
+// ConsentAccount defines model for ConsentAccount.
+type ConsentAccount struct {
+	AccountNumber string `json:"accountNumber"`
+	AccountType   string `json:"accountType"`
+	BranchCode    string `json:"branchCode"`
+}
+
+// ConsentList defines model for ConsentList.
+type ConsentList struct {
+	Consents []Consent `json:"consents"`
+}
+
+// DeviceAuthorization defines model for DeviceAuthorization.
+type DeviceAuthorization struct {
+	DeviceCode string `json:"device_code"`
+	ExpiresIn  int    `json:"expires_in"`
+
+	// Interval Minimum number of seconds between polls
+	Interval        int    `json:"interval"`
+	UserCode        string `json:"user_code"`
+	VerificationUri string `json:"verification_uri"`
+
+	// VerificationUriComplete Verification URI with the user code, for showing as a QR code
+	VerificationUriComplete string `json:"verification_uri_complete"`
+}
+
+// DeviceAuthorizationError defines model for DeviceAuthorizationError.
+type DeviceAuthorizationError struct {
+	Error            DeviceAuthorizationErrorError `json:"error"`
+	ErrorDescription *string                       `json:"error_description,omitempty"`
+}
+
+// DeviceAuthorizationErrorError defines model for DeviceAuthorizationError.Error.
+type DeviceAuthorizationErrorError string
+
+// DeviceAuthorizationRequest Properties are nullable because omitted form parameters are decoded as null.
+type DeviceAuthorizationRequest struct {
+	ClientId *string `json:"client_id"`
+	Scope    *string `json:"scope"`
+}
+
+// DeviceVerification defines model for DeviceVerification.
+type DeviceVerification struct {
+	ClientId   string    `json:"clientId"`
+	ClientName string    `json:"clientName"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Scopes     []string  `json:"scopes"`
+	UserCode   string    `json:"userCode"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 }
 
+// ErrorEnvelope defines model for ErrorEnvelope.
+type ErrorEnvelope struct {
+	Error Error `json:"error"`
+}
+
+// JWK defines model for JWK.
+type JWK struct {
+	Alg string `json:"alg"`
+	E   string `json:"e"`
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	Use string `json:"use"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// OAuthAccessToken Access token response in the RFC 6749 format (5.1)
+type OAuthAccessToken struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+
+	// IdToken Only returned when the scope includes openid and OpenID Connect is enabled.
+	IdToken      *string `json:"id_token,omitempty"`
+	RefreshToken string  `json:"refresh_token"`
+	Scope        string  `json:"scope"`
+	TokenType    string  `json:"token_type"`
+}
+
+// OAuthTokenError Error response in the RFC 6749 format (5.2). For the device_code grant type, the device keeps polling while authorization_pending is returned, and adds 5 seconds to the interval for slow_down.
+type OAuthTokenError struct {
+	Error            OAuthTokenErrorError `json:"error"`
+	ErrorDescription *string              `json:"error_description,omitempty"`
+}
+
+// OAuthTokenErrorError defines model for OAuthTokenError.Error.
+type OAuthTokenErrorError string
+
+// OAuthTokenRequest Access token request in the RFC 6749 format (4.1.3, 6) or the RFC 8628 format (3.4). Properties are nullable because omitted form parameters are decoded as null.
+type OAuthTokenRequest struct {
+	Code         *string `json:"code"`
+	CodeVerifier *string `json:"code_verifier"`
+	DeviceCode   *string `json:"device_code"`
+	GrantType    *string `json:"grant_type"`
+	RedirectUri  *string `json:"redirect_uri"`
+	RefreshToken *string `json:"refresh_token"`
+}
+
+// OpenIDConfiguration defines model for OpenIDConfiguration.
+type OpenIDConfiguration struct {
+	// AuthorizationEndpoint Only present when the authorization code flow is configured
+	AuthorizationEndpoint *string  `json:"authorization_endpoint,omitempty"`
+	ClaimsSupported       []string `json:"claims_supported"`
+
+	// CodeChallengeMethodsSupported Only present when the authorization code flow is configured
+	CodeChallengeMethodsSupported *[]string `json:"code_challenge_methods_supported,omitempty"`
+
+	// DeviceAuthorizationEndpoint Only present when the device authorization grant is configured
+	DeviceAuthorizationEndpoint      *string  `json:"device_authorization_endpoint,omitempty"`
+	GrantTypesSupported              []string `json:"grant_types_supported"`
+	IdTokenSigningAlgValuesSupported []string `json:"id_token_signing_alg_values_supported"`
+	Issuer                           string   `json:"issuer"`
+	JwksUri                          string   `json:"jwks_uri"`
+
+	// PushedAuthorizationRequestEndpoint Only present when the authorization code flow is configured
+	PushedAuthorizationRequestEndpoint *string `json:"pushed_authorization_request_endpoint,omitempty"`
+
+	// RequestObjectSigningAlgValuesSupported Only present when the authorization code flow is configured
+	RequestObjectSigningAlgValuesSupported *[]string `json:"request_object_signing_alg_values_supported,omitempty"`
+
+	// RequirePushedAuthorizationRequests Only present when the authorization code flow is configured
+	RequirePushedAuthorizationRequests *bool `json:"require_pushed_authorization_requests,omitempty"`
+
+	// ResponseTypesSupported Empty unless the authorization code flow is configured
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+}
+
+// PushedAuthorization defines model for PushedAuthorization.
+type PushedAuthorization struct {
+	ExpiresIn  int    `json:"expires_in"`
+	RequestUri string `json:"request_uri"`
+}
+
+// PushedAuthorizationRequest Authorization request parameters. Only the authorization code flow with PKCE (S256) is accepted. Properties are nullable because omitted form parameters are decoded as null.
+type PushedAuthorizationRequest struct {
+	ClientId            *string `json:"client_id"`
+	CodeChallenge       *string `json:"code_challenge"`
+	CodeChallengeMethod *string `json:"code_challenge_method"`
+	Nonce               *string `json:"nonce"`
+	RedirectUri         *string `json:"redirect_uri"`
+
+	// Request Request object signed with PS256 or ES256 (RFC 9101)
+	Request      *string `json:"request"`
+	ResponseType *string `json:"response_type"`
+	Scope        *string `json:"scope"`
+	State        *string `json:"state"`
+}
+
+// RegistrationError defines model for RegistrationError.
+type RegistrationError struct {
+	Error            RegistrationErrorError `json:"error"`
+	ErrorDescription *string                `json:"error_description,omitempty"`
+}
+
+// RegistrationErrorError defines model for RegistrationError.Error.
+type RegistrationErrorError string
+
+// RetireClientSecretRequest defines model for RetireClientSecretRequest.
+type RetireClientSecretRequest struct {
+	// NotAfter Keep accepting the secret until this time. Defaults to now.
+	NotAfter *time.Time `json:"notAfter,omitempty"`
+}
+
 // TokenData defines model for TokenData.
 type TokenData struct {
-	AccessToken  string `json:"accessToken"`
-	ExpiresIn    int    `json:"expiresIn"`
-	RefreshToken string `json:"refreshToken"`
-	TokenType    string `json:"tokenType"`
+	AccessToken string `json:"accessToken"`
+	ExpiresIn   int    `json:"expiresIn"`
+
+	// IdToken Signed ID token (RS256). Only returned when the token has the openid scope and OpenID Connect is enabled.
+	IdToken      *string `json:"idToken,omitempty"`
+	RefreshToken string  `json:"refreshToken"`
+	TokenType    string  `json:"tokenType"`
+}
+
+// TokenEndpointError ErrorEnvelope for JSON requests, OAuthTokenError for form requests
+type TokenEndpointError struct {
+	union json.RawMessage
+}
+
+// TokenEndpointResponse TokenResponse for JSON requests, OAuthAccessToken for form requests
+type TokenEndpointResponse struct {
+	union json.RawMessage
 }
 
 // TokenRequest defines model for TokenRequest.
@@ -75,6 +454,12 @@ type TokenRequest struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	ApiVersion ApiVersion `json:"apiVersion"`
+	Data       TokenData  `json:"data"`
+}
+
 // Transaction defines model for Transaction.
 type Transaction struct {
 	Amount             string `json:"amount"`
@@ -90,6 +475,25 @@ type TransactionList struct {
 	Transactions []Transaction `json:"transactions"`
 }
 
+// UserInfo Standard claims (OpenID Connect Core 5.1). Claims not allowed by the scopes or not registered are omitted.
+type UserInfo struct {
+	Address      *UserInfoAddress `json:"address,omitempty"`
+	Birthdate    *string          `json:"birthdate,omitempty"`
+	Email        *string          `json:"email,omitempty"`
+	Name         *string          `json:"name,omitempty"`
+	NameJaKanaJP *string          `json:"name#ja-Kana-JP,omitempty"`
+	PhoneNumber  *string          `json:"phone_number,omitempty"`
+	Sub          string           `json:"sub"`
+}
+
+// UserInfoAddress defines model for UserInfoAddress.
+type UserInfoAddress struct {
+	Country       *string `json:"country,omitempty"`
+	Locality      *string `json:"locality,omitempty"`
+	Region        *string `json:"region,omitempty"`
+	StreetAddress *string `json:"street_address,omitempty"`
+}
+
 // AccountResponse defines model for AccountResponse.
 type AccountResponse struct {
 	ApiVersion ApiVersion `json:"apiVersion"`
@@ -97,15 +501,78 @@ type AccountResponse struct {
 	Data       Account    `json:"data"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error Error `json:"error"`
+// AuditEventListResponse defines model for AuditEventListResponse.
+type AuditEventListResponse struct {
+	ApiVersion ApiVersion     `json:"apiVersion"`
+	Data       AuditEventList `json:"data"`
 }
 
-// TokenResponse defines model for TokenResponse.
-type TokenResponse struct {
-	ApiVersion ApiVersion `json:"apiVersion"`
-	Data       TokenData  `json:"data"`
+// AuthorizationRedirectResponse defines model for AuthorizationRedirectResponse.
+type AuthorizationRedirectResponse struct {
+	ApiVersion ApiVersion            `json:"apiVersion"`
+	Data       AuthorizationRedirect `json:"data"`
+}
+
+// AuthorizationRequestErrorResponse defines model for AuthorizationRequestErrorResponse.
+type AuthorizationRequestErrorResponse = AuthorizationRequestError
+
+// AuthorizationVerificationResponse defines model for AuthorizationVerificationResponse.
+type AuthorizationVerificationResponse struct {
+	ApiVersion ApiVersion                `json:"apiVersion"`
+	Data       AuthorizationVerification `json:"data"`
+}
+
+// ClientInformationResponse defines model for ClientInformationResponse.
+type ClientInformationResponse = ClientInformation
+
+// ClientSecretListResponse defines model for ClientSecretListResponse.
+type ClientSecretListResponse struct {
+	ApiVersion ApiVersion       `json:"apiVersion"`
+	Data       ClientSecretList `json:"data"`
+}
+
+// ClientSecretResponse defines model for ClientSecretResponse.
+type ClientSecretResponse struct {
+	ApiVersion ApiVersion   `json:"apiVersion"`
+	Data       ClientSecret `json:"data"`
+}
+
+// ConsentListResponse defines model for ConsentListResponse.
+type ConsentListResponse struct {
+	ApiVersion ApiVersion  `json:"apiVersion"`
+	Data       ConsentList `json:"data"`
+}
+
+// DeviceAuthorizationErrorResponse defines model for DeviceAuthorizationErrorResponse.
+type DeviceAuthorizationErrorResponse = DeviceAuthorizationError
+
+// DeviceAuthorizationResponse defines model for DeviceAuthorizationResponse.
+type DeviceAuthorizationResponse = DeviceAuthorization
+
+// DeviceVerificationResponse defines model for DeviceVerificationResponse.
+type DeviceVerificationResponse struct {
+	ApiVersion ApiVersion         `json:"apiVersion"`
+	Data       DeviceVerification `json:"data"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse = ErrorEnvelope
+
+// JWKSetResponse defines model for JWKSetResponse.
+type JWKSetResponse = JWKSet
+
+// OpenIDConfigurationResponse defines model for OpenIDConfigurationResponse.
+type OpenIDConfigurationResponse = OpenIDConfiguration
+
+// PushedAuthorizationResponse defines model for PushedAuthorizationResponse.
+type PushedAuthorizationResponse = PushedAuthorization
+
+// RegistrationErrorResponse defines model for RegistrationErrorResponse.
+type RegistrationErrorResponse = RegistrationError
+
+// TooManyRequestsResponse defines model for TooManyRequestsResponse.
+type TooManyRequestsResponse struct {
+	Error Error `json:"error"`
 }
 
 // TransactionListResponse defines model for TransactionListResponse.
@@ -114,9 +581,177 @@ type TransactionListResponse struct {
 	Data       TransactionList `json:"data"`
 }
 
+// UserInfoResponse Standard claims (OpenID Connect Core 5.1). Claims not allowed by the scopes or not registered are omitted.
+type UserInfoResponse = UserInfo
+
+// ListAuditEventsParams defines parameters for ListAuditEvents.
+type ListAuditEventsParams struct {
+	ClientId  *string         `form:"clientId,omitempty" json:"clientId,omitempty"`
+	CifNo     *int            `form:"cifNo,omitempty" json:"cifNo,omitempty"`
+	Operation *AuditOperation `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// From Inclusive lower bound of occurredAt
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Exclusive upper bound of occurredAt
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// After Return events whose id is greater than this value (nextCursor of the previous page)
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// AuthorizeParams defines parameters for Authorize.
+type AuthorizeParams struct {
+	ClientId   string `form:"client_id" json:"client_id"`
+	RequestUri string `form:"request_uri" json:"request_uri"`
+}
+
+// RetireClientSecretJSONRequestBody defines body for RetireClientSecret for application/json ContentType.
+type RetireClientSecretJSONRequestBody = RetireClientSecretRequest
+
+// PostDeviceAuthorizationFormdataRequestBody defines body for PostDeviceAuthorization for application/x-www-form-urlencoded ContentType.
+type PostDeviceAuthorizationFormdataRequestBody = DeviceAuthorizationRequest
+
+// PushAuthorizationRequestFormdataRequestBody defines body for PushAuthorizationRequest for application/x-www-form-urlencoded ContentType.
+type PushAuthorizationRequestFormdataRequestBody = PushedAuthorizationRequest
+
+// RegisterClientJSONRequestBody defines body for RegisterClient for application/json ContentType.
+type RegisterClientJSONRequestBody = ClientMetadata
+
+// UpdateClientConfigurationJSONRequestBody defines body for UpdateClientConfiguration for application/json ContentType.
+type UpdateClientConfigurationJSONRequestBody = ClientMetadata
+
 // PostTokenJSONRequestBody defines body for PostToken for application/json ContentType.
 type PostTokenJSONRequestBody = TokenRequest
 
+// PostTokenFormdataRequestBody defines body for PostToken for application/x-www-form-urlencoded ContentType.
+type PostTokenFormdataRequestBody = OAuthTokenRequest
+
+// AsErrorEnvelope returns the union data inside the TokenEndpointError as a ErrorEnvelope
+func (t TokenEndpointError) AsErrorEnvelope() (ErrorEnvelope, error) {
+	var body ErrorEnvelope
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromErrorEnvelope overwrites any union data inside the TokenEndpointError as the provided ErrorEnvelope
+func (t *TokenEndpointError) FromErrorEnvelope(v ErrorEnvelope) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeErrorEnvelope performs a merge with any union data inside the TokenEndpointError, using the provided ErrorEnvelope
+func (t *TokenEndpointError) MergeErrorEnvelope(v ErrorEnvelope) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOAuthTokenError returns the union data inside the TokenEndpointError as a OAuthTokenError
+func (t TokenEndpointError) AsOAuthTokenError() (OAuthTokenError, error) {
+	var body OAuthTokenError
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOAuthTokenError overwrites any union data inside the TokenEndpointError as the provided OAuthTokenError
+func (t *TokenEndpointError) FromOAuthTokenError(v OAuthTokenError) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOAuthTokenError performs a merge with any union data inside the TokenEndpointError, using the provided OAuthTokenError
+func (t *TokenEndpointError) MergeOAuthTokenError(v OAuthTokenError) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t TokenEndpointError) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *TokenEndpointError) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// AsTokenResponse returns the union data inside the TokenEndpointResponse as a TokenResponse
+func (t TokenEndpointResponse) AsTokenResponse() (TokenResponse, error) {
+	var body TokenResponse
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTokenResponse overwrites any union data inside the TokenEndpointResponse as the provided TokenResponse
+func (t *TokenEndpointResponse) FromTokenResponse(v TokenResponse) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTokenResponse performs a merge with any union data inside the TokenEndpointResponse, using the provided TokenResponse
+func (t *TokenEndpointResponse) MergeTokenResponse(v TokenResponse) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsOAuthAccessToken returns the union data inside the TokenEndpointResponse as a OAuthAccessToken
+func (t TokenEndpointResponse) AsOAuthAccessToken() (OAuthAccessToken, error) {
+	var body OAuthAccessToken
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromOAuthAccessToken overwrites any union data inside the TokenEndpointResponse as the provided OAuthAccessToken
+func (t *TokenEndpointResponse) FromOAuthAccessToken(v OAuthAccessToken) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeOAuthAccessToken performs a merge with any union data inside the TokenEndpointResponse, using the provided OAuthAccessToken
+func (t *TokenEndpointResponse) MergeOAuthAccessToken(v OAuthAccessToken) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t TokenEndpointResponse) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *TokenEndpointResponse) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -190,20 +825,98 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetOpenIDConfiguration request
+	GetOpenIDConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAccountInformation request
 	GetAccountInformation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListAuditEvents request
+	ListAuditEvents(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthorizationVerification request
+	GetAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveAuthorizationVerification request
+	ApproveAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DenyAuthorizationVerification request
+	DenyAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Authorize request
+	Authorize(ctx context.Context, params *AuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClientSecrets request
+	ListClientSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClientSecret request
+	CreateClientSecret(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RetireClientSecretWithBody request with any body
+	RetireClientSecretWithBody(ctx context.Context, secretId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RetireClientSecret(ctx context.Context, secretId int64, body RetireClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListConsents request
+	ListConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeConsent request
+	RevokeConsent(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDeviceVerification request
+	GetDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApproveDeviceVerification request
+	ApproveDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DenyDeviceVerification request
+	DenyDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostDeviceAuthorizationWithBody request with any body
+	PostDeviceAuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostDeviceAuthorizationWithFormdataBody(ctx context.Context, body PostDeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJwks request
+	GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PushAuthorizationRequestWithBody request with any body
+	PushAuthorizationRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PushAuthorizationRequestWithFormdataBody(ctx context.Context, body PushAuthorizationRequestFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterClientWithBody request with any body
+	RegisterClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterClient(ctx context.Context, body RegisterClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClientConfiguration request
+	DeleteClientConfiguration(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientConfiguration request
+	GetClientConfiguration(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClientConfigurationWithBody request with any body
+	UpdateClientConfigurationWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClientConfiguration(ctx context.Context, clientId string, body UpdateClientConfigurationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostTokenWithBody request with any body
 	PostTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostToken(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	PostTokenWithFormdataBody(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTransactionList request
 	GetTransactionList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserInfo request
+	GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetAccountInformation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAccountInformationRequest(c.Server)
+func (c *Client) GetOpenIDConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOpenIDConfigurationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +927,8 @@ func (c *Client) GetAccountInformation(ctx context.Context, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTokenRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAccountInformation(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountInformationRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -226,8 +939,8 @@ func (c *Client) PostTokenWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostToken(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostTokenRequest(c.Server, body)
+func (c *Client) ListAuditEvents(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -238,8 +951,8 @@ func (c *Client) PostToken(ctx context.Context, body PostTokenJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTransactionList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTransactionListRequest(c.Server)
+func (c *Client) GetAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthorizationVerificationRequest(c.Server, authorizationId)
 	if err != nil {
 		return nil, err
 	}
@@ -250,414 +963,4263 @@ func (c *Client) GetTransactionList(ctx context.Context, reqEditors ...RequestEd
 	return c.Client.Do(req)
 }
 
-// NewGetAccountInformationRequest generates requests for GetAccountInformation
-func NewGetAccountInformationRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ApproveAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveAuthorizationVerificationRequest(c.Server, authorizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/accounts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DenyAuthorizationVerification(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDenyAuthorizationVerificationRequest(c.Server, authorizationId)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostTokenRequest calls the generic PostToken builder with application/json body
-func NewPostTokenRequest(server string, body PostTokenJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) Authorize(ctx context.Context, params *AuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthorizeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostTokenRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostTokenRequestWithBody generates requests for PostToken with any type of body
-func NewPostTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListClientSecrets(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClientSecretsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/token")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateClientSecret(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClientSecretRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) RetireClientSecretWithBody(ctx context.Context, secretId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetireClientSecretRequestWithBody(c.Server, secretId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetTransactionListRequest generates requests for GetTransactionList
-func NewGetTransactionListRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RetireClientSecret(ctx context.Context, secretId int64, body RetireClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRetireClientSecretRequest(c.Server, secretId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/transactions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListConsentsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) RevokeConsent(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeConsentRequest(c.Server, consentId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) GetDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeviceVerificationRequest(c.Server, userCode)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) ApproveDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApproveDeviceVerificationRequest(c.Server, userCode)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) DenyDeviceVerification(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDenyDeviceVerificationRequest(c.Server, userCode)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) PostDeviceAuthorizationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeviceAuthorizationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAccountInformationWithResponse request
-	GetAccountInformationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountInformationResponse, error)
-
-	// PostTokenWithBodyWithResponse request with any body
-	PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+func (c *Client) PostDeviceAuthorizationWithFormdataBody(ctx context.Context, body PostDeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostDeviceAuthorizationRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	PostTokenWithResponse(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+func (c *Client) GetJwks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJwksRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	// GetTransactionListWithResponse request
-	GetTransactionListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionListResponse, error)
+func (c *Client) PushAuthorizationRequestWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPushAuthorizationRequestRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetAccountInformationResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AccountResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+func (c *Client) PushAuthorizationRequestWithFormdataBody(ctx context.Context, body PushAuthorizationRequestFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPushAuthorizationRequestRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetAccountInformationResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) RegisterClientWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClientRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetAccountInformationResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) RegisterClient(ctx context.Context, body RegisterClientJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClientRequest(c.Server, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type PostTokenResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TokenResponse
-	JSON400      *ErrorResponse
-	JSON401      *ErrorResponse
-	JSON500      *ErrorResponse
+func (c *Client) DeleteClientConfiguration(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClientConfigurationRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostTokenResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetClientConfiguration(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientConfigurationRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostTokenResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) UpdateClientConfigurationWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientConfigurationRequestWithBody(c.Server, clientId, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetTransactionListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TransactionListResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+func (c *Client) UpdateClientConfiguration(ctx context.Context, clientId string, body UpdateClientConfigurationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClientConfigurationRequest(c.Server, clientId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetTransactionListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) PostTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostToken(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTokenWithFormdataBody(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransactionList(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransactionListRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserInfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetOpenIDConfigurationRequest generates requests for GetOpenIDConfiguration
+func NewGetOpenIDConfigurationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountInformationRequest generates requests for GetAccountInformation
+func NewGetAccountInformationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/accounts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListAuditEventsRequest generates requests for ListAuditEvents
+func NewListAuditEventsRequest(server string, params *ListAuditEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit-events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClientId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clientId", runtime.ParamLocationQuery, *params.ClientId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CifNo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cifNo", runtime.ParamLocationQuery, *params.CifNo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Operation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthorizationVerificationRequest generates requests for GetAuthorizationVerification
+func NewGetAuthorizationVerificationRequest(server string, authorizationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "authorizationId", runtime.ParamLocationPath, authorizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authorization-verifications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveAuthorizationVerificationRequest generates requests for ApproveAuthorizationVerification
+func NewApproveAuthorizationVerificationRequest(server string, authorizationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "authorizationId", runtime.ParamLocationPath, authorizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authorization-verifications/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDenyAuthorizationVerificationRequest generates requests for DenyAuthorizationVerification
+func NewDenyAuthorizationVerificationRequest(server string, authorizationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "authorizationId", runtime.ParamLocationPath, authorizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authorization-verifications/%s/deny", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthorizeRequest generates requests for Authorize
+func NewAuthorizeRequest(server string, params *AuthorizeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, params.ClientId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "request_uri", runtime.ParamLocationQuery, params.RequestUri); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClientSecretsRequest generates requests for ListClientSecrets
+func NewListClientSecretsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/client/secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClientSecretRequest generates requests for CreateClientSecret
+func NewCreateClientSecretRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/client/secrets")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRetireClientSecretRequest calls the generic RetireClientSecret builder with application/json body
+func NewRetireClientSecretRequest(server string, secretId int64, body RetireClientSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRetireClientSecretRequestWithBody(server, secretId, "application/json", bodyReader)
+}
+
+// NewRetireClientSecretRequestWithBody generates requests for RetireClientSecret with any type of body
+func NewRetireClientSecretRequestWithBody(server string, secretId int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "secretId", runtime.ParamLocationPath, secretId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/client/secrets/%s/retire", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListConsentsRequest generates requests for ListConsents
+func NewListConsentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/consents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRevokeConsentRequest generates requests for RevokeConsent
+func NewRevokeConsentRequest(server string, consentId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "consentId", runtime.ParamLocationPath, consentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/consents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDeviceVerificationRequest generates requests for GetDeviceVerification
+func NewGetDeviceVerificationRequest(server string, userCode string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userCode", runtime.ParamLocationPath, userCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/device-verifications/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApproveDeviceVerificationRequest generates requests for ApproveDeviceVerification
+func NewApproveDeviceVerificationRequest(server string, userCode string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userCode", runtime.ParamLocationPath, userCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/device-verifications/%s/approve", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDenyDeviceVerificationRequest generates requests for DenyDeviceVerification
+func NewDenyDeviceVerificationRequest(server string, userCode string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userCode", runtime.ParamLocationPath, userCode)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/device-verifications/%s/deny", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostDeviceAuthorizationRequestWithFormdataBody calls the generic PostDeviceAuthorization builder with application/x-www-form-urlencoded body
+func NewPostDeviceAuthorizationRequestWithFormdataBody(server string, body PostDeviceAuthorizationFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostDeviceAuthorizationRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostDeviceAuthorizationRequestWithBody generates requests for PostDeviceAuthorization with any type of body
+func NewPostDeviceAuthorizationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/device_authorization")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetJwksRequest generates requests for GetJwks
+func NewGetJwksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jwks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPushAuthorizationRequestRequestWithFormdataBody calls the generic PushAuthorizationRequest builder with application/x-www-form-urlencoded body
+func NewPushAuthorizationRequestRequestWithFormdataBody(server string, body PushAuthorizationRequestFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPushAuthorizationRequestRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPushAuthorizationRequestRequestWithBody generates requests for PushAuthorizationRequest with any type of body
+func NewPushAuthorizationRequestRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/par")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRegisterClientRequest calls the generic RegisterClient builder with application/json body
+func NewRegisterClientRequest(server string, body RegisterClientJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterClientRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewRegisterClientRequestWithBody generates requests for RegisterClient with any type of body
+func NewRegisterClientRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClientConfigurationRequest generates requests for DeleteClientConfiguration
+func NewDeleteClientConfigurationRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientId", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientConfigurationRequest generates requests for GetClientConfiguration
+func NewGetClientConfigurationRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientId", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClientConfigurationRequest calls the generic UpdateClientConfiguration builder with application/json body
+func NewUpdateClientConfigurationRequest(server string, clientId string, body UpdateClientConfigurationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClientConfigurationRequestWithBody(server, clientId, "application/json", bodyReader)
+}
+
+// NewUpdateClientConfigurationRequestWithBody generates requests for UpdateClientConfiguration with any type of body
+func NewUpdateClientConfigurationRequestWithBody(server string, clientId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clientId", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTokenRequest calls the generic PostToken builder with application/json body
+func NewPostTokenRequest(server string, body PostTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTokenRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTokenRequestWithFormdataBody calls the generic PostToken builder with application/x-www-form-urlencoded body
+func NewPostTokenRequestWithFormdataBody(server string, body PostTokenFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostTokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostTokenRequestWithBody generates requests for PostToken with any type of body
+func NewPostTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransactionListRequest generates requests for GetTransactionList
+func NewGetTransactionListRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transactions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserInfoRequest generates requests for GetUserInfo
+func NewGetUserInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/userinfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetOpenIDConfigurationWithResponse request
+	GetOpenIDConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenIDConfigurationResponse, error)
+
+	// GetAccountInformationWithResponse request
+	GetAccountInformationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountInformationResponse, error)
+
+	// ListAuditEventsWithResponse request
+	ListAuditEventsWithResponse(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error)
+
+	// GetAuthorizationVerificationWithResponse request
+	GetAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*GetAuthorizationVerificationResponse, error)
+
+	// ApproveAuthorizationVerificationWithResponse request
+	ApproveAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*ApproveAuthorizationVerificationResponse, error)
+
+	// DenyAuthorizationVerificationWithResponse request
+	DenyAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*DenyAuthorizationVerificationResponse, error)
+
+	// AuthorizeWithResponse request
+	AuthorizeWithResponse(ctx context.Context, params *AuthorizeParams, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error)
+
+	// ListClientSecretsWithResponse request
+	ListClientSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClientSecretsResponse, error)
+
+	// CreateClientSecretWithResponse request
+	CreateClientSecretWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateClientSecretResponse, error)
+
+	// RetireClientSecretWithBodyWithResponse request with any body
+	RetireClientSecretWithBodyWithResponse(ctx context.Context, secretId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetireClientSecretResponse, error)
+
+	RetireClientSecretWithResponse(ctx context.Context, secretId int64, body RetireClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*RetireClientSecretResponse, error)
+
+	// ListConsentsWithResponse request
+	ListConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConsentsResponse, error)
+
+	// RevokeConsentWithResponse request
+	RevokeConsentWithResponse(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*RevokeConsentResponse, error)
+
+	// GetDeviceVerificationWithResponse request
+	GetDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*GetDeviceVerificationResponse, error)
+
+	// ApproveDeviceVerificationWithResponse request
+	ApproveDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*ApproveDeviceVerificationResponse, error)
+
+	// DenyDeviceVerificationWithResponse request
+	DenyDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*DenyDeviceVerificationResponse, error)
+
+	// PostDeviceAuthorizationWithBodyWithResponse request with any body
+	PostDeviceAuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeviceAuthorizationResponse, error)
+
+	PostDeviceAuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostDeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostDeviceAuthorizationResponse, error)
+
+	// GetJwksWithResponse request
+	GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error)
+
+	// PushAuthorizationRequestWithBodyWithResponse request with any body
+	PushAuthorizationRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PushAuthorizationRequestResponse, error)
+
+	PushAuthorizationRequestWithFormdataBodyWithResponse(ctx context.Context, body PushAuthorizationRequestFormdataRequestBody, reqEditors ...RequestEditorFn) (*PushAuthorizationRequestResponse, error)
+
+	// RegisterClientWithBodyWithResponse request with any body
+	RegisterClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClientResponse, error)
+
+	RegisterClientWithResponse(ctx context.Context, body RegisterClientJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClientResponse, error)
+
+	// DeleteClientConfigurationWithResponse request
+	DeleteClientConfigurationWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteClientConfigurationResponse, error)
+
+	// GetClientConfigurationWithResponse request
+	GetClientConfigurationWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetClientConfigurationResponse, error)
+
+	// UpdateClientConfigurationWithBodyWithResponse request with any body
+	UpdateClientConfigurationWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientConfigurationResponse, error)
+
+	UpdateClientConfigurationWithResponse(ctx context.Context, clientId string, body UpdateClientConfigurationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientConfigurationResponse, error)
+
+	// PostTokenWithBodyWithResponse request with any body
+	PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+
+	PostTokenWithResponse(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+
+	PostTokenWithFormdataBodyWithResponse(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+
+	// GetTransactionListWithResponse request
+	GetTransactionListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionListResponse, error)
+
+	// GetUserInfoWithResponse request
+	GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error)
+}
+
+type GetOpenIDConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OpenIDConfigurationResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOpenIDConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOpenIDConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountInformationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccountResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountInformationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountInformationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditEventListResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthorizationVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizationVerificationResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthorizationVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthorizationVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveAuthorizationVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizationRedirectResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveAuthorizationVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveAuthorizationVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DenyAuthorizationVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorizationRedirectResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DenyAuthorizationVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DenyAuthorizationVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *AuthorizationRequestErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClientSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSecretListResponse
+	JSON401      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClientSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClientSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClientSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientSecretResponse
+	JSON401      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClientSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClientSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RetireClientSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientSecretResponse
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RetireClientSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RetireClientSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListConsentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ConsentListResponse
+	JSON401      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListConsentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListConsentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeConsentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeConsentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeConsentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeviceVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceVerificationResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeviceVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeviceVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApproveDeviceVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ApproveDeviceVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApproveDeviceVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DenyDeviceVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DenyDeviceVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DenyDeviceVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostDeviceAuthorizationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceAuthorizationResponse
+	JSON400      *DeviceAuthorizationErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostDeviceAuthorizationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostDeviceAuthorizationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJwksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSetResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJwksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJwksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PushAuthorizationRequestResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PushedAuthorizationResponse
+	JSON400      *AuthorizationRequestErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PushAuthorizationRequestResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PushAuthorizationRequestResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterClientResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientInformationResponse
+	JSON400      *RegistrationErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterClientResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterClientResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClientConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClientConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClientConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformationResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClientConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformationResponse
+	JSON400      *RegistrationErrorResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClientConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClientConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenEndpointResponse
+	JSON400      *TokenEndpointError
+	JSON401      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransactionListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionListResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransactionListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransactionListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserInfoResponse
+	JSON401      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequestsResponse
+	JSON500      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetOpenIDConfigurationWithResponse request returning *GetOpenIDConfigurationResponse
+func (c *ClientWithResponses) GetOpenIDConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOpenIDConfigurationResponse, error) {
+	rsp, err := c.GetOpenIDConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOpenIDConfigurationResponse(rsp)
+}
+
+// GetAccountInformationWithResponse request returning *GetAccountInformationResponse
+func (c *ClientWithResponses) GetAccountInformationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountInformationResponse, error) {
+	rsp, err := c.GetAccountInformation(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountInformationResponse(rsp)
+}
+
+// ListAuditEventsWithResponse request returning *ListAuditEventsResponse
+func (c *ClientWithResponses) ListAuditEventsWithResponse(ctx context.Context, params *ListAuditEventsParams, reqEditors ...RequestEditorFn) (*ListAuditEventsResponse, error) {
+	rsp, err := c.ListAuditEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEventsResponse(rsp)
+}
+
+// GetAuthorizationVerificationWithResponse request returning *GetAuthorizationVerificationResponse
+func (c *ClientWithResponses) GetAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*GetAuthorizationVerificationResponse, error) {
+	rsp, err := c.GetAuthorizationVerification(ctx, authorizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthorizationVerificationResponse(rsp)
+}
+
+// ApproveAuthorizationVerificationWithResponse request returning *ApproveAuthorizationVerificationResponse
+func (c *ClientWithResponses) ApproveAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*ApproveAuthorizationVerificationResponse, error) {
+	rsp, err := c.ApproveAuthorizationVerification(ctx, authorizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveAuthorizationVerificationResponse(rsp)
+}
+
+// DenyAuthorizationVerificationWithResponse request returning *DenyAuthorizationVerificationResponse
+func (c *ClientWithResponses) DenyAuthorizationVerificationWithResponse(ctx context.Context, authorizationId string, reqEditors ...RequestEditorFn) (*DenyAuthorizationVerificationResponse, error) {
+	rsp, err := c.DenyAuthorizationVerification(ctx, authorizationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDenyAuthorizationVerificationResponse(rsp)
+}
+
+// AuthorizeWithResponse request returning *AuthorizeResponse
+func (c *ClientWithResponses) AuthorizeWithResponse(ctx context.Context, params *AuthorizeParams, reqEditors ...RequestEditorFn) (*AuthorizeResponse, error) {
+	rsp, err := c.Authorize(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthorizeResponse(rsp)
+}
+
+// ListClientSecretsWithResponse request returning *ListClientSecretsResponse
+func (c *ClientWithResponses) ListClientSecretsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClientSecretsResponse, error) {
+	rsp, err := c.ListClientSecrets(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClientSecretsResponse(rsp)
+}
+
+// CreateClientSecretWithResponse request returning *CreateClientSecretResponse
+func (c *ClientWithResponses) CreateClientSecretWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateClientSecretResponse, error) {
+	rsp, err := c.CreateClientSecret(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClientSecretResponse(rsp)
+}
+
+// RetireClientSecretWithBodyWithResponse request with arbitrary body returning *RetireClientSecretResponse
+func (c *ClientWithResponses) RetireClientSecretWithBodyWithResponse(ctx context.Context, secretId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RetireClientSecretResponse, error) {
+	rsp, err := c.RetireClientSecretWithBody(ctx, secretId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetireClientSecretResponse(rsp)
+}
+
+func (c *ClientWithResponses) RetireClientSecretWithResponse(ctx context.Context, secretId int64, body RetireClientSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*RetireClientSecretResponse, error) {
+	rsp, err := c.RetireClientSecret(ctx, secretId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRetireClientSecretResponse(rsp)
+}
+
+// ListConsentsWithResponse request returning *ListConsentsResponse
+func (c *ClientWithResponses) ListConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListConsentsResponse, error) {
+	rsp, err := c.ListConsents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListConsentsResponse(rsp)
+}
+
+// RevokeConsentWithResponse request returning *RevokeConsentResponse
+func (c *ClientWithResponses) RevokeConsentWithResponse(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*RevokeConsentResponse, error) {
+	rsp, err := c.RevokeConsent(ctx, consentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeConsentResponse(rsp)
+}
+
+// GetDeviceVerificationWithResponse request returning *GetDeviceVerificationResponse
+func (c *ClientWithResponses) GetDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*GetDeviceVerificationResponse, error) {
+	rsp, err := c.GetDeviceVerification(ctx, userCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeviceVerificationResponse(rsp)
+}
+
+// ApproveDeviceVerificationWithResponse request returning *ApproveDeviceVerificationResponse
+func (c *ClientWithResponses) ApproveDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*ApproveDeviceVerificationResponse, error) {
+	rsp, err := c.ApproveDeviceVerification(ctx, userCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApproveDeviceVerificationResponse(rsp)
+}
+
+// DenyDeviceVerificationWithResponse request returning *DenyDeviceVerificationResponse
+func (c *ClientWithResponses) DenyDeviceVerificationWithResponse(ctx context.Context, userCode string, reqEditors ...RequestEditorFn) (*DenyDeviceVerificationResponse, error) {
+	rsp, err := c.DenyDeviceVerification(ctx, userCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDenyDeviceVerificationResponse(rsp)
+}
+
+// PostDeviceAuthorizationWithBodyWithResponse request with arbitrary body returning *PostDeviceAuthorizationResponse
+func (c *ClientWithResponses) PostDeviceAuthorizationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostDeviceAuthorizationResponse, error) {
+	rsp, err := c.PostDeviceAuthorizationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeviceAuthorizationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostDeviceAuthorizationWithFormdataBodyWithResponse(ctx context.Context, body PostDeviceAuthorizationFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostDeviceAuthorizationResponse, error) {
+	rsp, err := c.PostDeviceAuthorizationWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostDeviceAuthorizationResponse(rsp)
+}
+
+// GetJwksWithResponse request returning *GetJwksResponse
+func (c *ClientWithResponses) GetJwksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJwksResponse, error) {
+	rsp, err := c.GetJwks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJwksResponse(rsp)
+}
+
+// PushAuthorizationRequestWithBodyWithResponse request with arbitrary body returning *PushAuthorizationRequestResponse
+func (c *ClientWithResponses) PushAuthorizationRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PushAuthorizationRequestResponse, error) {
+	rsp, err := c.PushAuthorizationRequestWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePushAuthorizationRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) PushAuthorizationRequestWithFormdataBodyWithResponse(ctx context.Context, body PushAuthorizationRequestFormdataRequestBody, reqEditors ...RequestEditorFn) (*PushAuthorizationRequestResponse, error) {
+	rsp, err := c.PushAuthorizationRequestWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePushAuthorizationRequestResponse(rsp)
+}
+
+// RegisterClientWithBodyWithResponse request with arbitrary body returning *RegisterClientResponse
+func (c *ClientWithResponses) RegisterClientWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClientResponse, error) {
+	rsp, err := c.RegisterClientWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClientResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterClientWithResponse(ctx context.Context, body RegisterClientJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClientResponse, error) {
+	rsp, err := c.RegisterClient(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClientResponse(rsp)
+}
+
+// DeleteClientConfigurationWithResponse request returning *DeleteClientConfigurationResponse
+func (c *ClientWithResponses) DeleteClientConfigurationWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteClientConfigurationResponse, error) {
+	rsp, err := c.DeleteClientConfiguration(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClientConfigurationResponse(rsp)
+}
+
+// GetClientConfigurationWithResponse request returning *GetClientConfigurationResponse
+func (c *ClientWithResponses) GetClientConfigurationWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetClientConfigurationResponse, error) {
+	rsp, err := c.GetClientConfiguration(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientConfigurationResponse(rsp)
+}
+
+// UpdateClientConfigurationWithBodyWithResponse request with arbitrary body returning *UpdateClientConfigurationResponse
+func (c *ClientWithResponses) UpdateClientConfigurationWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClientConfigurationResponse, error) {
+	rsp, err := c.UpdateClientConfigurationWithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientConfigurationResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClientConfigurationWithResponse(ctx context.Context, clientId string, body UpdateClientConfigurationJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClientConfigurationResponse, error) {
+	rsp, err := c.UpdateClientConfiguration(ctx, clientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClientConfigurationResponse(rsp)
+}
+
+// PostTokenWithBodyWithResponse request with arbitrary body returning *PostTokenResponse
+func (c *ClientWithResponses) PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
+	rsp, err := c.PostTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTokenWithResponse(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
+	rsp, err := c.PostToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTokenWithFormdataBodyWithResponse(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
+	rsp, err := c.PostTokenWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenResponse(rsp)
+}
+
+// GetTransactionListWithResponse request returning *GetTransactionListResponse
+func (c *ClientWithResponses) GetTransactionListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionListResponse, error) {
+	rsp, err := c.GetTransactionList(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransactionListResponse(rsp)
+}
+
+// GetUserInfoWithResponse request returning *GetUserInfoResponse
+func (c *ClientWithResponses) GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error) {
+	rsp, err := c.GetUserInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserInfoResponse(rsp)
+}
+
+// ParseGetOpenIDConfigurationResponse parses an HTTP response from a GetOpenIDConfigurationWithResponse call
+func ParseGetOpenIDConfigurationResponse(rsp *http.Response) (*GetOpenIDConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOpenIDConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OpenIDConfigurationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountInformationResponse parses an HTTP response from a GetAccountInformationWithResponse call
+func ParseGetAccountInformationResponse(rsp *http.Response) (*GetAccountInformationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountInformationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAuditEventsResponse parses an HTTP response from a ListAuditEventsWithResponse call
+func ParseListAuditEventsResponse(rsp *http.Response) (*ListAuditEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditEventListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthorizationVerificationResponse parses an HTTP response from a GetAuthorizationVerificationWithResponse call
+func ParseGetAuthorizationVerificationResponse(rsp *http.Response) (*GetAuthorizationVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthorizationVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationVerificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveAuthorizationVerificationResponse parses an HTTP response from a ApproveAuthorizationVerificationWithResponse call
+func ParseApproveAuthorizationVerificationResponse(rsp *http.Response) (*ApproveAuthorizationVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveAuthorizationVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationRedirectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDenyAuthorizationVerificationResponse parses an HTTP response from a DenyAuthorizationVerificationWithResponse call
+func ParseDenyAuthorizationVerificationResponse(rsp *http.Response) (*DenyAuthorizationVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DenyAuthorizationVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationRedirectResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAuthorizeResponse parses an HTTP response from a AuthorizeWithResponse call
+func ParseAuthorizeResponse(rsp *http.Response) (*AuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest AuthorizationRequestErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClientSecretsResponse parses an HTTP response from a ListClientSecretsWithResponse call
+func ParseListClientSecretsResponse(rsp *http.Response) (*ListClientSecretsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClientSecretsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSecretListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClientSecretResponse parses an HTTP response from a CreateClientSecretWithResponse call
+func ParseCreateClientSecretResponse(rsp *http.Response) (*CreateClientSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClientSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRetireClientSecretResponse parses an HTTP response from a RetireClientSecretWithResponse call
+func ParseRetireClientSecretResponse(rsp *http.Response) (*RetireClientSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RetireClientSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientSecretResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListConsentsResponse parses an HTTP response from a ListConsentsWithResponse call
+func ParseListConsentsResponse(rsp *http.Response) (*ListConsentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListConsentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ConsentListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeConsentResponse parses an HTTP response from a RevokeConsentWithResponse call
+func ParseRevokeConsentResponse(rsp *http.Response) (*RevokeConsentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeConsentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeviceVerificationResponse parses an HTTP response from a GetDeviceVerificationWithResponse call
+func ParseGetDeviceVerificationResponse(rsp *http.Response) (*GetDeviceVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeviceVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceVerificationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApproveDeviceVerificationResponse parses an HTTP response from a ApproveDeviceVerificationWithResponse call
+func ParseApproveDeviceVerificationResponse(rsp *http.Response) (*ApproveDeviceVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApproveDeviceVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDenyDeviceVerificationResponse parses an HTTP response from a DenyDeviceVerificationWithResponse call
+func ParseDenyDeviceVerificationResponse(rsp *http.Response) (*DenyDeviceVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DenyDeviceVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostDeviceAuthorizationResponse parses an HTTP response from a PostDeviceAuthorizationWithResponse call
+func ParsePostDeviceAuthorizationResponse(rsp *http.Response) (*PostDeviceAuthorizationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostDeviceAuthorizationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceAuthorizationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest DeviceAuthorizationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJwksResponse parses an HTTP response from a GetJwksWithResponse call
+func ParseGetJwksResponse(rsp *http.Response) (*GetJwksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJwksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePushAuthorizationRequestResponse parses an HTTP response from a PushAuthorizationRequestWithResponse call
+func ParsePushAuthorizationRequestResponse(rsp *http.Response) (*PushAuthorizationRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PushAuthorizationRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PushedAuthorizationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest AuthorizationRequestErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterClientResponse parses an HTTP response from a RegisterClientWithResponse call
+func ParseRegisterClientResponse(rsp *http.Response) (*RegisterClientResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterClientResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientInformationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest RegistrationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClientConfigurationResponse parses an HTTP response from a DeleteClientConfigurationWithResponse call
+func ParseDeleteClientConfigurationResponse(rsp *http.Response) (*DeleteClientConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClientConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientConfigurationResponse parses an HTTP response from a GetClientConfigurationWithResponse call
+func ParseGetClientConfigurationResponse(rsp *http.Response) (*GetClientConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientInformationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClientConfigurationResponse parses an HTTP response from a UpdateClientConfigurationWithResponse call
+func ParseUpdateClientConfigurationResponse(rsp *http.Response) (*UpdateClientConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClientConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientInformationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest RegistrationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTokenResponse parses an HTTP response from a PostTokenWithResponse call
+func ParsePostTokenResponse(rsp *http.Response) (*PostTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenEndpointResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest TokenEndpointError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTransactionListResponse parses an HTTP response from a GetTransactionListWithResponse call
+func ParseGetTransactionListResponse(rsp *http.Response) (*GetTransactionListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTransactionListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserInfoResponse parses an HTTP response from a GetUserInfoWithResponse call
+func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequestsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// OpenID Connect discovery document
+	// (GET /.well-known/openid-configuration)
+	GetOpenIDConfiguration(c *gin.Context)
+	// Lookup account information
+	// (GET /accounts)
+	GetAccountInformation(c *gin.Context)
+	// Search audit events
+	// (GET /audit-events)
+	ListAuditEvents(c *gin.Context, params ListAuditEventsParams)
+	// Get a pending authorization
+	// (GET /authorization-verifications/{authorizationId})
+	GetAuthorizationVerification(c *gin.Context, authorizationId string)
+	// Approve an authorization
+	// (POST /authorization-verifications/{authorizationId}/approve)
+	ApproveAuthorizationVerification(c *gin.Context, authorizationId string)
+	// Deny an authorization
+	// (POST /authorization-verifications/{authorizationId}/deny)
+	DenyAuthorizationVerification(c *gin.Context, authorizationId string)
+	// Start an authorization code flow (RFC 6749 4.1) from a pushed authorization request
+	// (GET /authorize)
+	Authorize(c *gin.Context, params AuthorizeParams)
+	// List the authenticated client's secrets
+	// (GET /client/secrets)
+	ListClientSecrets(c *gin.Context)
+	// Add a client secret for rotation
+	// (POST /client/secrets)
+	CreateClientSecret(c *gin.Context)
+	// Retire a client secret
+	// (POST /client/secrets/{secretId}/retire)
+	RetireClientSecret(c *gin.Context, secretId int64)
+	// List the customer's consents
+	// (GET /consents)
+	ListConsents(c *gin.Context)
+	// Revoke a consent
+	// (DELETE /consents/{consentId})
+	RevokeConsent(c *gin.Context, consentId int64)
+	// Get a pending device authorization
+	// (GET /device-verifications/{userCode})
+	GetDeviceVerification(c *gin.Context, userCode string)
+	// Approve a device authorization
+	// (POST /device-verifications/{userCode}/approve)
+	ApproveDeviceVerification(c *gin.Context, userCode string)
+	// Deny a device authorization
+	// (POST /device-verifications/{userCode}/deny)
+	DenyDeviceVerification(c *gin.Context, userCode string)
+	// Start a device authorization (RFC 8628)
+	// (POST /device_authorization)
+	PostDeviceAuthorization(c *gin.Context)
+	// Public keys for verifying ID tokens (RFC 7517)
+	// (GET /jwks)
+	GetJwks(c *gin.Context)
+	// Push an authorization request (RFC 9126)
+	// (POST /par)
+	PushAuthorizationRequest(c *gin.Context)
+	// Register a client (RFC 7591)
+	// (POST /register)
+	RegisterClient(c *gin.Context)
+	// Delete the client (RFC 7592)
+	// (DELETE /register/{clientId})
+	DeleteClientConfiguration(c *gin.Context, clientId string)
+	// Read the client configuration (RFC 7592)
+	// (GET /register/{clientId})
+	GetClientConfiguration(c *gin.Context, clientId string)
+	// Replace the client metadata (RFC 7592)
+	// (PUT /register/{clientId})
+	UpdateClientConfiguration(c *gin.Context, clientId string)
+	// Refresh access token or exchange an authorization code or a device code
+	// (POST /token)
+	PostToken(c *gin.Context)
+	// Lookup transaction list
+	// (GET /transactions)
+	GetTransactionList(c *gin.Context)
+	// Return claims about the customer (OpenID Connect UserInfo)
+	// (GET /userinfo)
+	GetUserInfo(c *gin.Context)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetOpenIDConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetOpenIDConfiguration(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOpenIDConfiguration(c)
+}
+
+// GetAccountInformation operation middleware
+func (siw *ServerInterfaceWrapper) GetAccountInformation(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAccountInformation(c)
+}
+
+// ListAuditEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEvents(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEventsParams
+
+	// ------------- Optional query parameter "clientId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clientId", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cifNo" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cifNo", c.Request.URL.Query(), &params.CifNo)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cifNo: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", c.Request.URL.Query(), &params.Operation)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", c.Request.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAuditEvents(c, params)
+}
+
+// GetAuthorizationVerification operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthorizationVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "authorizationId" -------------
+	var authorizationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "authorizationId", c.Param("authorizationId"), &authorizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorizationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuthorizationVerification(c, authorizationId)
+}
+
+// ApproveAuthorizationVerification operation middleware
+func (siw *ServerInterfaceWrapper) ApproveAuthorizationVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "authorizationId" -------------
+	var authorizationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "authorizationId", c.Param("authorizationId"), &authorizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorizationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ApproveAuthorizationVerification(c, authorizationId)
+}
+
+// DenyAuthorizationVerification operation middleware
+func (siw *ServerInterfaceWrapper) DenyAuthorizationVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "authorizationId" -------------
+	var authorizationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "authorizationId", c.Param("authorizationId"), &authorizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter authorizationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DenyAuthorizationVerification(c, authorizationId)
+}
+
+// Authorize operation middleware
+func (siw *ServerInterfaceWrapper) Authorize(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthorizeParams
+
+	// ------------- Required query parameter "client_id" -------------
+
+	if paramValue := c.Query("client_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument client_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "client_id", c.Request.URL.Query(), &params.ClientId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter client_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "request_uri" -------------
+
+	if paramValue := c.Query("request_uri"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument request_uri is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "request_uri", c.Request.URL.Query(), &params.RequestUri)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter request_uri: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Authorize(c, params)
+}
+
+// ListClientSecrets operation middleware
+func (siw *ServerInterfaceWrapper) ListClientSecrets(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListClientSecrets(c)
+}
+
+// CreateClientSecret operation middleware
+func (siw *ServerInterfaceWrapper) CreateClientSecret(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateClientSecret(c)
+}
+
+// RetireClientSecret operation middleware
+func (siw *ServerInterfaceWrapper) RetireClientSecret(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "secretId" -------------
+	var secretId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "secretId", c.Param("secretId"), &secretId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter secretId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BasicAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RetireClientSecret(c, secretId)
+}
+
+// ListConsents operation middleware
+func (siw *ServerInterfaceWrapper) ListConsents(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListConsents(c)
+}
+
+// RevokeConsent operation middleware
+func (siw *ServerInterfaceWrapper) RevokeConsent(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "consentId" -------------
+	var consentId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "consentId", c.Param("consentId"), &consentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter consentId: %w", err), http.StatusBadRequest)
+		return
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetTransactionListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return 0
+
+	siw.Handler.RevokeConsent(c, consentId)
 }
 
-// GetAccountInformationWithResponse request returning *GetAccountInformationResponse
-func (c *ClientWithResponses) GetAccountInformationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountInformationResponse, error) {
-	rsp, err := c.GetAccountInformation(ctx, reqEditors...)
+// GetDeviceVerification operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userCode" -------------
+	var userCode string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userCode", c.Param("userCode"), &userCode, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userCode: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParseGetAccountInformationResponse(rsp)
-}
 
-// PostTokenWithBodyWithResponse request with arbitrary body returning *PostTokenResponse
-func (c *ClientWithResponses) PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
-	rsp, err := c.PostTokenWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParsePostTokenResponse(rsp)
+
+	siw.Handler.GetDeviceVerification(c, userCode)
 }
 
-func (c *ClientWithResponses) PostTokenWithResponse(ctx context.Context, body PostTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
-	rsp, err := c.PostToken(ctx, body, reqEditors...)
+// ApproveDeviceVerification operation middleware
+func (siw *ServerInterfaceWrapper) ApproveDeviceVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userCode" -------------
+	var userCode string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userCode", c.Param("userCode"), &userCode, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userCode: %w", err), http.StatusBadRequest)
+		return
 	}
-	return ParsePostTokenResponse(rsp)
-}
 
-// GetTransactionListWithResponse request returning *GetTransactionListResponse
-func (c *ClientWithResponses) GetTransactionListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTransactionListResponse, error) {
-	rsp, err := c.GetTransactionList(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
-	return ParseGetTransactionListResponse(rsp)
+
+	siw.Handler.ApproveDeviceVerification(c, userCode)
 }
 
-// ParseGetAccountInformationResponse parses an HTTP response from a GetAccountInformationWithResponse call
-func ParseGetAccountInformationResponse(rsp *http.Response) (*GetAccountInformationResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DenyDeviceVerification operation middleware
+func (siw *ServerInterfaceWrapper) DenyDeviceVerification(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userCode" -------------
+	var userCode string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userCode", c.Param("userCode"), &userCode, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userCode: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetAccountInformationResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccountResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.DenyDeviceVerification(c, userCode)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// PostDeviceAuthorization operation middleware
+func (siw *ServerInterfaceWrapper) PostDeviceAuthorization(c *gin.Context) {
+
+	c.Set(BasicAuthScopes, []string{})
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.PostDeviceAuthorization(c)
 }
 
-// ParsePostTokenResponse parses an HTTP response from a PostTokenWithResponse call
-func ParsePostTokenResponse(rsp *http.Response) (*PostTokenResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetJwks operation middleware
+func (siw *ServerInterfaceWrapper) GetJwks(c *gin.Context) {
 
-	response := &PostTokenResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TokenResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	siw.Handler.GetJwks(c)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// PushAuthorizationRequest operation middleware
+func (siw *ServerInterfaceWrapper) PushAuthorizationRequest(c *gin.Context) {
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON401 = &dest
+	c.Set(BasicAuthScopes, []string{})
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON500 = &dest
+	}
 
+	siw.Handler.PushAuthorizationRequest(c)
+}
+
+// RegisterClient operation middleware
+func (siw *ServerInterfaceWrapper) RegisterClient(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.RegisterClient(c)
 }
 
-// ParseGetTransactionListResponse parses an HTTP response from a GetTransactionListWithResponse call
-func ParseGetTransactionListResponse(rsp *http.Response) (*GetTransactionListResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteClientConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClientConfiguration(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "clientId" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", c.Param("clientId"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetTransactionListResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionListResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON200 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	siw.Handler.DeleteClientConfiguration(c, clientId)
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+// GetClientConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) GetClientConfiguration(c *gin.Context) {
 
+	var err error
+
+	// ------------- Path parameter "clientId" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", c.Param("clientId"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	c.Set(BearerAuthScopes, []string{})
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Lookup account information
-	// (GET /accounts)
-	GetAccountInformation(c *gin.Context)
-	// Refresh access token
-	// (POST /token)
-	PostToken(c *gin.Context)
-	// Lookup transaction list
-	// (GET /transactions)
-	GetTransactionList(c *gin.Context)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	siw.Handler.GetClientConfiguration(c, clientId)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// UpdateClientConfiguration operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClientConfiguration(c *gin.Context) {
 
-// GetAccountInformation operation middleware
-func (siw *ServerInterfaceWrapper) GetAccountInformation(c *gin.Context) {
+	var err error
+
+	// ------------- Path parameter "clientId" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "clientId", c.Param("clientId"), &clientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter clientId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -668,7 +5230,7 @@ func (siw *ServerInterfaceWrapper) GetAccountInformation(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAccountInformation(c)
+	siw.Handler.UpdateClientConfiguration(c, clientId)
 }
 
 // PostToken operation middleware
@@ -701,6 +5263,21 @@ func (siw *ServerInterfaceWrapper) GetTransactionList(c *gin.Context) {
 	siw.Handler.GetTransactionList(c)
 }
 
+// GetUserInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetUserInfo(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserInfo(c)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -728,30 +5305,144 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/.well-known/openid-configuration", wrapper.GetOpenIDConfiguration)
 	router.GET(options.BaseURL+"/accounts", wrapper.GetAccountInformation)
+	router.GET(options.BaseURL+"/audit-events", wrapper.ListAuditEvents)
+	router.GET(options.BaseURL+"/authorization-verifications/:authorizationId", wrapper.GetAuthorizationVerification)
+	router.POST(options.BaseURL+"/authorization-verifications/:authorizationId/approve", wrapper.ApproveAuthorizationVerification)
+	router.POST(options.BaseURL+"/authorization-verifications/:authorizationId/deny", wrapper.DenyAuthorizationVerification)
+	router.GET(options.BaseURL+"/authorize", wrapper.Authorize)
+	router.GET(options.BaseURL+"/client/secrets", wrapper.ListClientSecrets)
+	router.POST(options.BaseURL+"/client/secrets", wrapper.CreateClientSecret)
+	router.POST(options.BaseURL+"/client/secrets/:secretId/retire", wrapper.RetireClientSecret)
+	router.GET(options.BaseURL+"/consents", wrapper.ListConsents)
+	router.DELETE(options.BaseURL+"/consents/:consentId", wrapper.RevokeConsent)
+	router.GET(options.BaseURL+"/device-verifications/:userCode", wrapper.GetDeviceVerification)
+	router.POST(options.BaseURL+"/device-verifications/:userCode/approve", wrapper.ApproveDeviceVerification)
+	router.POST(options.BaseURL+"/device-verifications/:userCode/deny", wrapper.DenyDeviceVerification)
+	router.POST(options.BaseURL+"/device_authorization", wrapper.PostDeviceAuthorization)
+	router.GET(options.BaseURL+"/jwks", wrapper.GetJwks)
+	router.POST(options.BaseURL+"/par", wrapper.PushAuthorizationRequest)
+	router.POST(options.BaseURL+"/register", wrapper.RegisterClient)
+	router.DELETE(options.BaseURL+"/register/:clientId", wrapper.DeleteClientConfiguration)
+	router.GET(options.BaseURL+"/register/:clientId", wrapper.GetClientConfiguration)
+	router.PUT(options.BaseURL+"/register/:clientId", wrapper.UpdateClientConfiguration)
 	router.POST(options.BaseURL+"/token", wrapper.PostToken)
 	router.GET(options.BaseURL+"/transactions", wrapper.GetTransactionList)
+	router.GET(options.BaseURL+"/userinfo", wrapper.GetUserInfo)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xW0W7bNhT9FYPbI2HJTddmenPWYghWtEUW7CXwA01d20wkkiWpYF6gfx9IShEpU1GS",
-	"FVjhF4s8vJfnXPLwPiAqaik4cKNR8YAUaCm4BvexplQ03Fx1Y3aICm6AG/uXSFkxSgwTPLvVgtsxTQ9Q",
-	"E/tPKiFBGeYjEcn+AqWZR/2sYIcK9FM25M78Sp2tB2SL0ZZo+EAMzK266HEtRiUxZDaLZ4baFiMF3xqm",
-	"oETFTbjPIHkXc4OROUpABRLbW6B2tU0HmiomjeOGiA+86HW0G/qolFDfQUSwceaYuWQnvPzS5xBwyGj7",
-	"1+IO+P94Bp5TT7fHDxb4ZEWfXUZj48UqKMI1oXb+E9PmR9cj3u33UmWIuqiYDk95izueoXEkOPuJz029",
-	"BXeUu5zaKMb3ll2HuHbjifktqQinU3P87jdRuskSdqSpDCrQr+fv3yGcQCvC6aHHn0zTRing9Jic5KSG",
-	"PwgnT03esuSsNsQ0/j7zpnbFoIbdW5ehldBQIox2SvwDrjRC1YSboDp9oFFBH6lHvB6zxbriUR0CsoPA",
-	"AcmQ0uk5wWgdneRB+ftVSveLwNN3lp+Flt5nT8Afe8+LzxGNq8a4gT0ou6AGrck+VdKRYj0Q+2ApXoOr",
-	"pA4yaO0AySrD35Ip0JexImfv8hwPpPtt4wQPBTsF+jCdwVlUf00GzS+AqDDkBPtw/6NkYeiQyKRCV/Ct",
-	"AZ247TMcRluK0Mlkg/0kClL3jvMiy4gMLqXykPOzmEN8USWoediEt43kiDMn85xGxb0O4T0OOc7o6h6L",
-	"E22DLO6bGaj1C54gx99nJUqR41NcdeolwkgDbRQzxz9tdL+rLdGMrhtzeHxk7Ro3Ohz/gzHSnQF3LU7R",
-	"o9vi4TYh4ztfSGYqO/P7l8X66+XiGmpZea+67y0PrZb5MrdZhAROJEMFOlvmyzOEkSTm4HabdZbrPvbg",
-	"VLYau17hsrQZwHTv5iX3FuGf56glf5PnU8I/4rJx395i9PY56+JG1a16++JVQbFQcfMQKX+zaTcY6aau",
-	"iTqiAn0S4q6Ri75pZhFvQ/a6syov3MbGzkzvJlLohIpfhTaDrTljuhDl8UV92myn2TteGx9koxpoX1Ow",
-	"uMX+D+VavWLVL6/IdVLk4S6Oa3zlbX3hX5yF6d+Yrrr+uyvtyGemrsnYsF4l+UQ//6PflXEPHkoZuWjr",
-	"oytrUy54o6rO4Yosy5fuV5zn53lGJMvuV6jFI1AlKKkOQpunYas37120VQzbtP8GAAD//xQW/T1dEAAA",
+	"H4sIAAAAAAAC/+x9fW/cNtL4VyF0P+BiQF6vHSdtDPz+cJ20l7bX5LGdOzw4BHu0NLvLWkuqJOXNXuHv",
+	"/oBvEilRu9r1Oq1zQYHCWVHkcDjvMxz9nmRsUTIKVIrk7PeEgygZFaD/cZ5lrKLy0v6mfsoYlUCl+hOX",
+	"ZUEyLAmjR78KRtVvIpvDAqu/Ss5K4JKYmXBJ/gFcEDPq/3GYJmfJX46atY/Mm+LovBl5nyY3WMBrLGHT",
+	"W9+5cfdpkmOJN65idpbc36cJh98qwiFPzv7lw+ktbuf8mCZyVUJylrCbXyFTb6vlQGSclFLvLcFmYuTw",
+	"qAA6r3Ii39wBlT8T8UdicxBmAmDXI2g4VtSkCNSsqCCijR45Z5z8R+/9EnLCIfvzYykC856Qxe10aMo4",
+	"knNAWSUkWwBHeCrV/ynC/vKICISpWIJatYvP3yoQ8g3njO+E0y2w0KwUpwEfZm5GI1DD0bPL7y/Qy29O",
+	"X6EjpP58dXzy8qCzl38AJ1ML6VOiDx/uvTGUj8w7b4GAtS4KAlS+pVPGF7ujbd1GOyvEoM30IESaUTWU",
+	"5ui/efHq+CBJkzngHLg+nQuczeHwglHJWRHCY7EjJCd0ptard3oFGYenIGXb4O6JLCyihZ63K2n9VZ8Q",
+	"gh4FOQFe1B9PQjt7kO4LK2bGLrG8hjuSQSDIHkeL9C0UgzbXY1v6z+iQUKJ8+/LkW/R8dHLQs5XPsYvB",
+	"G+ii/Qmpui7Ae6JMi6pe5fY41KhnfUPvoGAlxMAKqU0B8uM/f7oCuXdIzLQxEH78509IgHS68/gbTeXv",
+	"SqBvX18wOiWzij8OlUfWiAFohqH3nN2RHDhagMT63O/T5H0l5pA/LjNG1oiBWephPcyIngWW6CXMiJD8",
+	"EeVgZ4U1Sox7Y30b2hhS92lyzdjfMV1Zo1zsQYboVQZxT4f/zauDfB8sARVkQSSCTxlADnloFl5iCT+r",
+	"54f6/+qncIbvquwWJMpwiTMiV4hNtQ9l5pRzLNESC6Q3DvkoSbt2JaESZmB20Sx3CQtMqDI4O0s6JCPu",
+	"xiBC9ao3GpitVhEQ2dQVZIzmAlVUksKbWbl+06ooEJ5hQjevA5KvDs+VFzlkDQqfZO2oKSczy6CUG7Gm",
+	"VrrmmAqcqamfgFXVgnZP+ks2s3atqw8CuPKa9i5F3MQxiCoBXDlhnoyzkvqCUQqZPNBbt1N5EcDIcZgH",
+	"v1SLG0NMLacsdSOu9e+R5ze4wDTre0ZvL1gOhkqnuCpkcpa8+vabl0kaGc0xzeZufOdxVnEONFtFH1K8",
+	"gJ8wxese/kqiT4XEsjKikVYLTSeZJHeQpElWMKEl15Sz/4CmGuX5UukRTu2+hrRWbz3YV71aiNe0dQ7e",
+	"ZhsEe5v0t9Ql4TQ5D5iswfzdcQzvTaiwSx8Zmf7CYuIhtSrsbR5F6hyLefQB0eNNAMHM9/I0SSPTs0zj",
+	"ID+XwQs5lnAoyQJiO1GQGythSHT0XT1avVrJjC3AJwNRZRkIdVZTTIqKQ+TU06TkcPe3vt1ywJbrI4+0",
+	"QI7ir0VLJE8CfPgbbSD3TsSDyh5FlErCEHHn7HWcV/9FJCzE8Ihzcl+vhjnHK/VvpYQuKi5YRGW9x0Ig",
+	"LLSyMrHREnO8APWXZGgKMps3mqzEMxihdwsiJeSIGQ1dYGGfJGlDLH3U1cKv3Wgvjt75ZOWoQ7JboCMO",
+	"Uw5iXiN/pIxQoFIJ/eZIRgXLblklmx8qqn5q/p1xCF4whiHw5peqzIMRORH4pvB+ABr+O4cC/BdMwGSE",
+	"87zzGwdJuBpqNkWEqDyhNOKA1TtO7bh/62yA+4eNP4xmHFPp/ZvDHbuNc048+N4hQxdH/8BJl3SuVVxd",
+	"7+WvArmRk4oTtCRyjjKWA3rGuLGtDxCmOVISGFL9JxHCugcn428O0HIOFIWaVNlLBrHaXFrPpz6kcWLq",
+	"i7P3W+mO3Ai9wwXJJ1ZsJGn7l4ldp3kgMqZ1S0VFVZaMS8gnzmKYyJV95rwmyCcGkZGpq2BDzQlqKCfB",
+	"iWySZX0+xJocQUQrrVM95uEveBE3I+BTSTiIbdSKRmQoCDtjQnnX2rQnmD3o6ol9oGKY+c7LngYQx4Dt",
+	"RvJ70Dch6/A3IflEy4F8guVAlW3fpH2ot8+N0In5X7LiVMv0YoW0C994x6Mk3TDhxCJxgiNznxdLvBJo",
+	"jJ7lDASiTCIz/GCQwkgTLdc012xFCGniC6WtX232P8HaGJloCb0Wd2FQQYk5ozqiGAyWsOiseI+hrOVJ",
+	"FHAF1ARoXjJC5USJlMkC5JwNMG4aYoyT3ppT7gc/pMX2IYSnuRZ8t+sYWxpO+7uLiXXOxDyvg2ZeZKcx",
+	"X6YECuWsC9BmjDXVxShJ1/FsuM7fKyHRAjsjqcRyblSZPndCZ+t4xzHrAn/6GehMzpOzkxcvIuNbDNDO",
+	"dWszyFCnoTlOzwjI6Zk25cQZU1g905McqknOTFh4ojU05oBqHTVCry0WlPEXzKw28lk4ryb1VmylxBkg",
+	"AWpPHUhxUSD9nlB/sqU6XR1hNpYc5NZQEdHjWM9CrbCsko8hV9xgQTJlrDRo3Giu+BTQT99XtbRuRw60",
+	"k9xg84axAjBtaOtqkJzXlKro1uxE7cEYw3mcbM2zbdT3YJeTMlkH1YZMHXPTGvhSh6JNuI17XwYdw92v",
+	"MNO6wSBxk0dBM5Z7b6xoC5DMTHWRVpfPHmLI7UAJge3XciQU/wmUYUqZRDfgBI/KK2iXVM6JQGpiL7Ja",
+	"V/RYb0c0Adw9k6Zxo/LHtVrXxsIM7vKkhiX5OIwhNtm+NVl5wTGfi9bbxi0ae4wA57qwZDvq54f62jE9",
+	"f6E1W4mLA0dh2zLfZt/ETRwDKZYN74DmafJ1HteE0HggUf3J73ARsWwIJYtqgahGocoBCZvduAG5BKCo",
+	"ZEUhohxTCeA1TPAJL8pCjfju4vX3hz/87cefYizj56h7zeD2oIlCvg61dOD3vVj04fKtCUooyaGg09GJ",
+	"VBsJYs6WKt+kUlrofy71k436xse7v9/IPtZBHRyQdxoD6eHhAYwmTtGNRXRkTJp8OlRTHt5hriwXoeaO",
+	"gPXWTH5Zr9Y/5squHxnxwQPpwkK077hHtKrFQN2NlNY41jYzrYpCxabQDWRY+RDMuRWML5ogqhmcg6KO",
+	"XNGYem+Di+GmTs4kryBd4w1uGHnfu+UnHuMxLHexjYRp0UT9fvrQCFEPE7ZEsicdFyAEng3QaG5gaibr",
+	"XbwutfkcBQe6Vqe7Ei5mcXKI/nrbE/+6lT0pxuivlRiARDWlGZpqIM3iakoFXM/+rmJe1y2shpsACkmb",
+	"1L+eMAbBOyWRznXQ6ToeczIPkfH561S0LZmoK8EN+6FnL0a6NLhjnQVBre1th7wvIqadZO5czcbLVAyF",
+	"CM2KKgeBWAmU5DpisU3gPw0jHjuFy+SqbEsOwFxbiuupKUBaMFtLlYcwrgtl6cPWx1xLkhCbb8LazP5D",
+	"PjkYoe/thQc/xKMjP0gtnHrP0C1AKbQVp8yf5ZwUraLKSQk0V8+IqE/TJG9wngv0orYIJdPzOvPFmFUF",
+	"W05ytqRdZbeFfeJSWn4epYmG9SZRottQp+CASlJ3lDlQAnl9fI6mhxk/zdl1bJ7Oox/sVpoHH5pN6Ye2",
+	"HsEf0DGB/MeB3fK+3mQz4Kpgy9dmt95bet+v3bY98jMIuDb737ep1azTa2G1hJotmuoh99PR8eh5il4e",
+	"IMbrEbpk2Y14Pjo9GKHHNduskt9osamBE+MHAB/0Rsuz2zje44pAsIWsYJ2UjbP58dtBy3dk8g6maawy",
+	"tmtoBPtxQdseHVRy0JX5tQoK3jYp6mnBljryadfVXBGxejFZiEnNsNtZq/r4szkuCqAzsMHl1mz7BH84",
+	"ZJbMHobVaEW+0TobEdvQ7c7YdYbIRJCZKh2d4GI2ucNF9YAphah6Yla/Lm9Fb4DClEO30OlS+Z+NWMOy",
+	"hI1o+WMoz6qNyTqUiUdClZcvCaoy1iLmzaKUK1TRQiupR8GJcTl3JVpRmfN+EDOFybAtU847r+rqm9Yt",
+	"3I5wGxbtQBybzOPaCJLXEEGffOpH9lBxNBSNEdUTM69i1zW64YANHp1fc7QR//7gwPkZCF6/GRi95dyY",
+	"ZiOkZcA6DtSx3vc/XbxBz65OXrw8CErv0Z8okhcaBju84iWqN75JmS0Wfwzzr+cw7SkjQwpIsQTk9nzU",
+	"0Sjr/Y3+w94VGutQxYAFPY7da9DUpMJ2Da92byBtE573sN74wJai6gtgj14NeAmScAhvOtfHG+7FT9uH",
+	"B/8TQGl5ToUQvCoDl8O1Od2wkoOy5dAUbgz92sF8bUuCYvGu603hrrdh3f7zl+NxWKSmhWUajYf1BOuu",
+	"DNG/fW1d22eXWihZOdaNk5lRc1uVbcNkJni2c7Csf996NZeBrfe9XUDs2ka5gsX8qX30fuw7tzdWFa6L",
+	"g7lYt44x/Xj17henIESKWpE0PUTLcDckSRNG4d00OfvXVjdY0w03OsN1k/uP7R35V5QitQ/uce+mvFjw",
+	"A3YVrDVsV97C3rZ6xcEGYutYEN7oXqrwcfcZ77PVgmSXm2zB7b0I4AtXMLHVla714j31b8v9wjaNeMdz",
+	"4JuH9ZRmtHASrhxdpztr6vDg37Py97gBr/FiDW+V4dka/7Q2ZW2CBWIg1tcHu4pAYppjniNj0revDqIL",
+	"xgGpZM0IXZgRlMm6ovFm1WRRhLKb1EOvxBHz2nTtmqU4zzkIMfTq47kdriiScDnPsWzFFY9fvRofjo8P",
+	"x9F7bbDApOi9ENj74C+/4kN1y+7wx/fRMeWcUZjQ/oIiUd1splY1aN3BnTe4aod7Kyp5PEVZsAwXpCd/",
+	"qU6ph2mF5ABy4p3PRjNHvQVZxYlcXalzM8DpMlQls+uLrjrSoX5tTmguZakPVWv37uiW0jfD73WhkCFo",
+	"SaQ+/h/eofP3b9E1LMrC3Ia4c6I4OR6NR2N7J5DikiRnyfPRePRcESWWcw3t0WgJRXF4S9mSHhkT5zBr",
+	"R35nsRLWX1jL7XMetLKEyuqmILpysHbmnK8Y1knX0Umv9tkUxwp0Oj7tu49kb35pBqsvAqpCieQHkLH4",
+	"dRq2AzwZj/tYsB53tK4LxX2anI5PN88R9lfQJFMtFlgRb9LaVk5Exu6Ar1DOsmphskwSz3SGyxxN8lHN",
+	"cORXodqz6SDBVgP6l2B2wUG7b6Le93jrfe+GrTQ5PXm1+a2+BhE+g2qjzOe2f328/+gfxs+M3VbaU2JV",
+	"2ODLO4Ua8fYc1O2/w+aO6Ax6PG/CQehmd35mrS6E44DzMz3XpGAzo1hG6I0mBQGYZ3NF8kQKKKaIQ8a4",
+	"jXyoGesLiKYnYZchlHpu7qUKzfwulKKxQhSUv1XAV4lTC34tTn+zsrTnXX1f2n9xYWoYk7Pj2D3U+Cz+",
+	"9d6hLfzCC833afso3qqSB0HuAClNztENq2iuSiqDi8UxaKacLQJAhjnGHdfpkwOgKsutAJBsD8sbwWoI",
+	"RaDlnAlARF0BRTMO2FR4Y2pCAjpQip41d5Zd+xF1rZqwSuirxgc94Op68TjErsC7JorxcKLQrU+CaWtH",
+	"+VjFBxb4kyW08XjsLRGju487icN4/9MHSMXjzy4V0+TFDrBuI0uvjNDyWqUKX4iqn2sJ6lkQh36Brjj6",
+	"PXj4Nr/vFbEfRGOWuyZwijqRZLqsOLyosJyTbI5cI0eBsLhVkbEpc4+MZT9CmyX3AlM8g7P66oMV3R0D",
+	"phsjJwJVVFtdqb2FmStXAhdKlK/qJqhR+6b/lvBuJL2pL+nudDo+/QKp+weQCCNXkxUcq0flzZ2C+47G",
+	"XdMAdUJyVGKhCNpWcwUEfbNCNc/o26xaSipr3pO9IdskvtNl4uf9Kv3j1kx5hMuSM3MLLmZY7BG2NCmZ",
+	"2MnC6uHTcyQwzW/YJycNMkyNo3IDyO5LCxbcDHRS5Nnp+Dlicg58SQQcjNC1L2Osrgyg0a6OqM/TtUS2",
+	"4UPIa7lz3T51l5AQgSD7q0A3nC2F6RBiprIhbK8LQ+rkHeacQF/CvCtnzs32H1HWdPpjP0DOPP8qnax0",
+	"sufWaa0dF03bs3sOdPUUef1RmUrn8P5/UMna5ajXQFdPhJ2+RMZQ6N+BK6DX6tRZQ4y8+ouGVpSeLjH3",
+	"6x2aJpzo9MCUb2v1gfCGOgsdUCYzypQ9qOnYX1KprBt9mS5HjGYQKiKPnEnTHKgxLlSLtr+KkDE0J7WN",
+	"EgNwxx6xSlKHwTXdC0SkmWGdXBkhfZBmayZ83obMamQXPjSc6PU2IsJoam1DI+WfI4x0ot5HzyBb3A9c",
+	"KmCaorGIWqzJYos4iunsMVz69Xi/YaVP/3RNciDegcKb5+wliGxyfHx+cfHiZpmNj08LmR2fwv+enGSR",
+	"iELbZ34+PomJaHNMMQM27ID6M2tuvq3viX+6gziMfC3iyQm3xqWWmMvu9zKaUqvmuxOno+MDxxA9bYHd",
+	"5Qgn++xdCy34DMkeeb0XZj19XDlIEyayfAx3wGsRqDlPu7V2Jj2mINrSdcqacOQqV+KBS7/4ReykI3u/",
+	"pvC0oy9NkqkTyCZC1kLOteHLmw5xokZmrfmaW71xo+ta9x1ceu1JeNC/hNiYoQN/hL5j1hozTTxlrT28",
+	"aRQ91MqxaSjBCjUr2FVIVApf6HClf7Rd2jjejjb2YTa9+vKo6TzPEUbh9yZ0Sx8mOwaUI6OuFDn63fyh",
+	"HAhzqNqF6CU2n9B+NWaBFmhOWKBnZLGAnGAJrn2OzbgfjNC5RAVgITUVGRPLzreohLSdrZFp6tElrW7R",
+	"XZIOcHbc/tYq5s1NOT/WJZzfsXy1x37sfZWERr0+TKz+YWHw8elXNjVsag64zam9zOk1UpnB3r1sFwQj",
+	"xrW2FfqxmJgyEnQjHa0fXBy8nlQ7Puaact5jH7id7ETDkW/lfLE5mdos8FzDrMFejxfs/nn0u/3LpmFs",
+	"Y939EY7Jdpuxnvs3Z6o1YOfrcVoxaMKxFKQj8e632uNQpbuUIZhOIZMxUa+GWyoYJOVrJOxDzAfkehrD",
+	"pd7N1zhOW9QptChRVx9cD/Ga65DtiKbrODIsnei//OfLKdYtlB6UT4y0otlFnK753NNXEl6TQYzd2t0p",
+	"kfihpgagphT1ZhWQ6QhdYGEuL8xX5RxoGFuMpxO9Dj1DQk7rWv98HMCV2+UTBwH3X5pIbEK2lsI4ZEDu",
+	"dIjWTWU/HWA+KsCKoisfbD5piIyI6DD7dv41t7fX3N4WMmMIyw3P6f1J+O06QtRB9m0AYat80I5UbZu2",
+	"fNVqkQTbTqQZdh3ojw29FUJHm90iJvzNuP230ITDKqVdXdpLt0nyDKWOLyGZUZeOZDoWX0BuipYEOmqR",
+	"qQ9OpDVuJEvmCWwr8W2n9ErY1rv1fZJ6la36iTfNpmyndWVqmMuLdl2BwJQY6x+torEfRYmbm+v7i2xK",
+	"nb1nQsaaqQ4NeX06XC6Xh8q5Oqx4AVRtM3/QV029IFhbnt3vbgHHvwc5NDa28Vu1/41Spz/wZfNicdKs",
+	"P5970JPrUt0o1gTBHnYN5Uc1+S5k1PoI6z6umrxX93EypDodagmohdxKiQR3G1r4n2DtuXdSYt4vm68k",
+	"49H6slhFA81tCkkJc0ZNCXtQ12CqEJSrze13SlYj9E91DP+2w/6NntXVF7G+Blht1xekzA8tpajuc6BO",
+	"0naySW1CS5nX5uYf5qa4YoS+VxetzMvCyGkBWrnYRUNQBl1j2igyKzGPSqzPJDPXtAsZJDMHiKl139Dd",
+	"c+L/q9CspYGYd2sJHP16HwuOS03HUutzeGHO0Lmi4Yd5fJtfMdqmtHKHpfIVxQuShdP6ghld1p/hhRyV",
+	"wJFgFc8AvX0fCwibjdU9Hh8jF9f6ZM6++Kjzzautuaj/s9BPt2zGnWiTGfO+Ld2THXPkffS7uwW3NuVh",
+	"v5phvtbUql4zNG0SFREHVE1ozm3DZdWID2pXMFB9dl/08X1JtS0fle7cTg7ilSt992AH4Xf8YP76clB/",
+	"CTj3ER/cBt94DANyaM3V0u1CSFWsBjf8hpjhNwGu9pDw5nNi6FwXH/UrIL+syamr+rIjo4CEZKVAS8Zv",
+	"7QfFQmL7oD8x10dvfw5FMv4TKJIviVXKAmeBmOp86e6kV8/ULXrjNtS56S50w/KVJk1WSZNDaDohmuCW",
+	"Te31dWgmVEjF0i6TULfNOdJwmlFnrbYM9l/On/PYJEXdTsYIPmVzTGd2uP5J12s1VfRNFCtowWzR9PL5",
+	"S1sSv1WMywTjXETPQM6mm6MBShbo9k4Ouer1AmY4WzmsYWqN0H5MjNAbnM29QFtdk15XNWoblbS678Yj",
+	"Y03rrv1LiqBZlKK9PbqI3X7iwwXR/jbXafClgQgZKvxIgyfN9g+G+5RHBwYIviHwxdYgXxqmCfUr47Wc",
+	"6ClkZzwM7ff4n+3WUn2mX7tH1S7asDXHH9MHZYc+Jh6OdNG9j8qgcZbGqOvU+4CCQL89oslolJxNSQEp",
+	"0k2oUqSbRrnPVnA1hWuhNfXkdw4ZyaEuqKljcMG9ggfFZeu+YLvQg3v5a2lLH+erw6kP7kYl44JcWLvX",
+	"mkNoPOisl+Z3zp2oeGG7YZ0dHY1H+r+zb8ffjo9wSY7ujnUzxWCQbgc2Z0KuH3Z88o2e7Tgc9vH+/wYA",
+	"sBvgwVWaAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
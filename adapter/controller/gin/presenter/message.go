@@ -0,0 +1,179 @@
+package presenter
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type ErrorCode string
+
+const (
+	ErrorCodeAccessTokenRequired          ErrorCode = "access_token_required"
+	ErrorCodeInvalidAccessToken           ErrorCode = "invalid_access_token"
+	ErrorCodeAccountNotFound              ErrorCode = "account_not_found"
+	ErrorCodeClientAuthenticationRequired ErrorCode = "client_authentication_required"
+	ErrorCodeInvalidClient                ErrorCode = "invalid_client"
+	ErrorCodeInvalidRequest               ErrorCode = "invalid_request"
+	ErrorCodeNotFound                     ErrorCode = "not_found"
+	ErrorCodeRefreshTokenRequired         ErrorCode = "refresh_token_required"
+	ErrorCodeInvalidRefreshToken          ErrorCode = "invalid_refresh_token"
+	ErrorCodeInternalServerError          ErrorCode = "internal_server_error"
+	ErrorCodeNotImplemented               ErrorCode = "not_implemented"
+	ErrorCodeTimeout                      ErrorCode = "timeout"
+	ErrorCodeRateLimitExceeded            ErrorCode = "rate_limit_exceeded"
+	ErrorCodeTooManyClientSecrets         ErrorCode = "too_many_client_secrets"
+	ErrorCodeLastClientSecret             ErrorCode = "last_client_secret"
+	ErrorCodeConsentExpired               ErrorCode = "consent_expired"
+	ErrorCodeUnauthorizedClient           ErrorCode = "unauthorized_client"
+	ErrorCodeSandboxCustomerRequired      ErrorCode = "sandbox_customer_required"
+)
+
+type Language string
+
+const (
+	LanguageEnglish  Language = "en"
+	LanguageJapanese Language = "ja"
+)
+
+// DefaultLanguage は Accept-Language から対応言語を決められない場合に使う言語
+const DefaultLanguage = LanguageEnglish
+
+var messages = map[ErrorCode]map[Language]string{
+	ErrorCodeAccessTokenRequired: {
+		LanguageEnglish:  "access token is required",
+		LanguageJapanese: "アクセストークンが必要です",
+	},
+	ErrorCodeInvalidAccessToken: {
+		LanguageEnglish:  "invalid access token",
+		LanguageJapanese: "アクセストークンが無効です",
+	},
+	ErrorCodeAccountNotFound: {
+		LanguageEnglish:  "account not found",
+		LanguageJapanese: "口座が見つかりません",
+	},
+	ErrorCodeClientAuthenticationRequired: {
+		LanguageEnglish:  "client authentication is required",
+		LanguageJapanese: "クライアント認証が必要です",
+	},
+	ErrorCodeInvalidClient: {
+		LanguageEnglish:  "invalid client",
+		LanguageJapanese: "クライアントが無効です",
+	},
+	ErrorCodeInvalidRequest: {
+		LanguageEnglish:  "invalid request",
+		LanguageJapanese: "リクエストが不正です",
+	},
+	ErrorCodeNotFound: {
+		LanguageEnglish:  "not found",
+		LanguageJapanese: "リソースが見つかりません",
+	},
+	ErrorCodeRefreshTokenRequired: {
+		LanguageEnglish:  "refresh token is required",
+		LanguageJapanese: "リフレッシュトークンが必要です",
+	},
+	ErrorCodeInvalidRefreshToken: {
+		LanguageEnglish:  "invalid refresh token",
+		LanguageJapanese: "リフレッシュトークンが無効です",
+	},
+	ErrorCodeInternalServerError: {
+		LanguageEnglish:  "internal server error",
+		LanguageJapanese: "サーバー内部でエラーが発生しました",
+	},
+	ErrorCodeNotImplemented: {
+		LanguageEnglish:  "not implemented",
+		LanguageJapanese: "未実装です",
+	},
+	ErrorCodeTimeout: {
+		LanguageEnglish:  "timeout",
+		LanguageJapanese: "タイムアウトしました",
+	},
+	ErrorCodeRateLimitExceeded: {
+		LanguageEnglish:  "rate limit exceeded",
+		LanguageJapanese: "リクエストが多すぎます。しばらくしてから再度お試しください",
+	},
+	ErrorCodeTooManyClientSecrets: {
+		LanguageEnglish:  "too many active client secrets; retire an old secret first",
+		LanguageJapanese: "有効なクライアントシークレットが多すぎます。古いシークレットを先に失効させてください",
+	},
+	ErrorCodeLastClientSecret: {
+		LanguageEnglish:  "the last active client secret cannot be retired",
+		LanguageJapanese: "最後の有効なクライアントシークレットは失効させられません",
+	},
+	ErrorCodeConsentExpired: {
+		LanguageEnglish:  "consent has expired or been revoked; the customer must consent again",
+		LanguageJapanese: "同意の期限が切れたか取り消されました。お客さまに再度同意していただく必要があります",
+	},
+	ErrorCodeUnauthorizedClient: {
+		LanguageEnglish:  "the client is not allowed to use this grant type",
+		LanguageJapanese: "このクライアントはこのグラントタイプを使えません",
+	},
+	ErrorCodeSandboxCustomerRequired: {
+		LanguageEnglish:  "this client is in sandbox mode and can only be authorized by test customers",
+		LanguageJapanese: "このクライアントはサンドボックスのため、テスト用のお客さましか承認できません",
+	},
+}
+
+// Message はエラーコードに対応するメッセージを指定言語で返す。
+// 翻訳が無い場合は DefaultLanguage、それも無い場合はエラーコード自体を返す。
+func Message(code ErrorCode, lang Language) string {
+	translations, ok := messages[code]
+	if !ok {
+		return string(code)
+	}
+	if message, ok := translations[lang]; ok {
+		return message
+	}
+	if message, ok := translations[DefaultLanguage]; ok {
+		return message
+	}
+	return string(code)
+}
+
+// ResolveLanguage は Accept-Language ヘッダーの値から q 値の高い順に対応言語を選ぶ
+func ResolveLanguage(acceptLanguage string) Language {
+	type candidate struct {
+		tag string
+		q   float64
+	}
+
+	var candidates []candidate
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		if tag == "" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || key != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				parsed = 0
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{tag: tag, q: q})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, c := range candidates {
+		if c.tag == "*" {
+			return DefaultLanguage
+		}
+		primary, _, _ := strings.Cut(c.tag, "-")
+		switch Language(primary) {
+		case LanguageEnglish, LanguageJapanese:
+			return Language(primary)
+		}
+	}
+	return DefaultLanguage
+}
@@ -0,0 +1,88 @@
+package presenter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// declaredErrorCodes は message.go で宣言されている ErrorCode 定数を列挙する
+func declaredErrorCodes(t *testing.T) []ErrorCode {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "message.go", nil, 0)
+	require.NoError(t, err)
+
+	var codes []ErrorCode
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			ident, ok := valueSpec.Type.(*ast.Ident)
+			if !ok || ident.Name != "ErrorCode" {
+				continue
+			}
+			for _, value := range valueSpec.Values {
+				lit := value.(*ast.BasicLit)
+				codes = append(codes, ErrorCode(lit.Value[1:len(lit.Value)-1]))
+			}
+		}
+	}
+	return codes
+}
+
+func TestEveryErrorCodeHasTranslations(t *testing.T) {
+	codes := declaredErrorCodes(t)
+	require.NotEmpty(t, codes)
+
+	for _, code := range codes {
+		translations, ok := messages[code]
+		if !assert.True(t, ok, "no messages for %s", code) {
+			continue
+		}
+		for _, lang := range []Language{LanguageEnglish, LanguageJapanese} {
+			assert.NotEmpty(t, translations[lang], "no %s message for %s", lang, code)
+		}
+	}
+	assert.Len(t, messages, len(codes))
+}
+
+func TestMessage(t *testing.T) {
+	assert.Equal(t, "account not found", Message(ErrorCodeAccountNotFound, LanguageEnglish))
+	assert.Equal(t, "口座が見つかりません", Message(ErrorCodeAccountNotFound, LanguageJapanese))
+	assert.Equal(t, "account not found", Message(ErrorCodeAccountNotFound, Language("fr")))
+	assert.Equal(t, "unknown_code", Message(ErrorCode("unknown_code"), LanguageEnglish))
+}
+
+func TestResolveLanguage(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		expected       Language
+	}{
+		{"", LanguageEnglish},
+		{"ja", LanguageJapanese},
+		{"ja-JP", LanguageJapanese},
+		{"en-US,en;q=0.9", LanguageEnglish},
+		{"fr-FR,ja;q=0.8,en;q=0.5", LanguageJapanese},
+		{"en;q=0.3,ja;q=0.7", LanguageJapanese},
+		{"ja;q=0,en", LanguageEnglish},
+		{"fr,de", LanguageEnglish},
+		{"*", LanguageEnglish},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ResolveLanguage(tt.acceptLanguage), tt.acceptLanguage)
+	}
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	code, response := NewErrorResponse("ja-JP,ja;q=0.9", 404, ErrorCodeAccountNotFound)
+	assert.Equal(t, 404, code)
+	assert.Equal(t, 404, response.Error.Code)
+	assert.Equal(t, "口座が見つかりません", response.Error.Message)
+}
@@ -1,9 +1,9 @@
 package presenter
 
-func NewErrorResponse(code int, message string) (int, *ErrorResponse) {
+func NewErrorResponse(acceptLanguage string, code int, errorCode ErrorCode) (int, *ErrorResponse) {
 	return code, &ErrorResponse{
 		Error: Error{
-			Message: message,
+			Message: Message(errorCode, ResolveLanguage(acceptLanguage)),
 			Code:    code,
 		},
 	}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"encoding/json"
+	"regexp"
 	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -11,25 +12,27 @@ import (
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"github.com/swaggo/swag"
-	"gorm.io/gorm"
 
 	"go-banking-api/adapter/controller/gin/handler"
 	"go-banking-api/adapter/controller/gin/middleware"
 	"go-banking-api/adapter/controller/gin/presenter"
 	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
 	"go-banking-api/pkg"
+	"go-banking-api/pkg/health"
+	"go-banking-api/pkg/jwt"
 	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
 	"go-banking-api/usecase"
 )
 
-func setupSwagger(router *gin.Engine) (*openapi3.T, error) {
+func setupSwagger(router *gin.Engine, apiDocs bool) (*openapi3.T, error) {
 	swagger, err := presenter.GetSwagger()
 	if err != nil {
 		return nil, err
 	}
 
-	env := pkg.GetEnvDefault("APP_ENV", "development")
-	if env == "development" {
+	if apiDocs {
 		swaggerJson, _ := json.Marshal(swagger)
 		var SwaggerInfo = &swag.Spec{
 			InfoInstanceName: "swagger",
@@ -41,46 +44,152 @@ func setupSwagger(router *gin.Engine) (*openapi3.T, error) {
 	return swagger, nil
 }
 
-func NewGinRouter(db *gorm.DB, corsAllowOrigins []string) (*gin.Engine, error) {
-	router := gin.Default()
+// operationIDs は OpenAPI の各オペレーションを "METHOD /gin/route" から operationId を引けるようにする
+func operationIDs(swagger *openapi3.T, basePath string) map[string]string {
+	ids := map[string]string{}
+	for path, pathItem := range swagger.Paths.Map() {
+		route := basePath + pathParamPattern.ReplaceAllString(path, ":$1")
+		for method, operation := range pathItem.Operations() {
+			ids[method+" "+route] = operation.OperationID
+		}
+	}
+	return ids
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Config はルーターと API の設定
+type Config struct {
+	CorsAllowOrigins []string
+	// TrustedProxies は X-Forwarded-For を信頼するプロキシ（IP / CIDR）
+	TrustedProxies []string
+	// APIDocs が true の場合は /swagger で API ドキュメントを公開する
+	APIDocs bool
+	// RateLimit の制限がすべて 0 の場合は制限しない
+	RateLimit usecase.RateLimitConfig
+	// RateLimitMaxKeys はプロセス内に保持するバケット数の上限
+	RateLimitMaxKeys int
+	LockoutPolicy    entity.LockoutPolicy
+	// Registration.AllowedScopes が空の場合は動的クライアント登録（/register）を受け付けない
+	Registration usecase.RegistrationPolicy
+	// BaseURL は API の外部公開 URL（https://bank.example.com/api/v1 など）。registration_client_uri に使う。
+	BaseURL string
+	// OIDC.Issuer が空の場合は ID トークンを発行せず、/userinfo や /par などは 404 を返す。
+	// OIDC.Issuer は PAR で受け付けるリクエストオブジェクトの aud にもなる。
+	OIDC usecase.OIDCConfig
+	// OIDCSigningKey は ID トークンに署名する RSA 秘密鍵（PEM）
+	OIDCSigningKey []byte
+	// DeviceVerificationURI は顧客が user_code を入力する銀行のページ。
+	// 空の場合はデバイス認可グラント（/device_authorization と /device-verifications）を受け付けない。
+	DeviceVerificationURI string
+	// AuthorizationConsentURI は /authorize から顧客を誘導する銀行の同意画面。空の場合や OIDC.Issuer が空の場合は
+	// 認可コードフロー（/par、/authorize と /authorization-verifications）を受け付けない。
+	AuthorizationConsentURI string
+}
+
+// newRateLimitUsecase は config の制限がすべて 0 の場合は nil（制限しない）を返す
+func newRateLimitUsecase(config Config, clientRepository gateway.ClientRepository, clock pkg.Clock) usecase.RateLimitUsecase {
+	if !config.RateLimit.Client.Enabled() && !config.RateLimit.Customer.Enabled() && !config.RateLimit.Source.Enabled() && !config.RateLimit.Registration.Enabled() {
+		return nil
+	}
+	store := gateway.NewMemoryRateLimitStore(config.RateLimitMaxKeys, clock)
+	return usecase.NewRateLimitUsecase(store, clientRepository, config.RateLimit, clock)
+}
+
+func NewGinRouter(config Config, repos gateway.Repositories, transactionManager usecase.TransactionManager, readiness *health.Readiness) (*gin.Engine, error) {
+	// gin.Default の Logger はパスとクエリを伏せずに標準出力へ書くため使わず、GinZap と RecoveryWithZap だけにする
+	router := gin.New()
+	// 接続元 IP はロックの単位になるため、X-Forwarded-For は信頼するプロキシからのものだけを使う
+	if err := router.SetTrustedProxies(config.TrustedProxies); err != nil {
+		return nil, err
+	}
 
-	router.Use(middleware.CorsMiddleware(corsAllowOrigins))
-	swagger, err := setupSwagger(router)
+	router.Use(middleware.CorsMiddleware(config.CorsAllowOrigins))
+	swagger, err := setupSwagger(router, config.APIDocs)
 	if err != nil {
 		logger.Warn(err.Error())
 		return nil, err
 	}
 
+	ids := operationIDs(swagger, "/api/v1")
+	router.Use(middleware.Tracing(ids))
+	router.Use(middleware.RequestID())
 	router.Use(middleware.GinZap())
 	router.Use(middleware.RecoveryWithZap())
 
-	router.GET("/health", handler.Health)
+	router.GET("/livez", handler.Livez)
+	router.GET("/readyz", handler.NewReadinessHandler(readiness).Readyz)
+	// 互換のため残している。新しい監視では /livez と /readyz を使うこと。
+	router.GET("/health", handler.Livez)
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
 
 	apiGroup := router.Group("/api")
 	{
+		apiGroup.Use(middleware.Metrics(ids))
 		apiGroup.Use(middleware.TimeoutMiddleware(2 * time.Second))
 		v1 := apiGroup.Group("/v1")
 		{
 			v1.Use(ginMiddleware.OapiRequestValidatorWithOptions(
 				swagger,
 				&ginMiddleware.Options{
+					ErrorHandler: middleware.ValidationErrorHandler,
 					Options: openapi3filter.Options{
 						AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
 					},
 				},
 			))
 
-			customerRepository := gateway.NewCustomerRepository(db)
-			accountRepository := gateway.NewAccountRepository(db)
-			clientRepository := gateway.NewClientRepository(db)
-			tokenRepository := gateway.NewTokenRepository(db)
+			customerRepository := repos.Customer()
+			accountRepository := repos.Account()
+			clientRepository := repos.Client()
+			tokenRepository := repos.Token()
+			auditRepository := repos.Audit()
 			clock := pkg.RealClock{}
-			tokenUsecase := usecase.NewTokenUsecase(tokenRepository, clock)
-			clientUsecase := usecase.NewClientUsecase(clientRepository)
-			accountInfoUseCase := usecase.NewAccountInfoUsecase(customerRepository, accountRepository)
+			var idTokenIssuer usecase.IDTokenIssuer
+			var userInfoUsecase usecase.UserInfoUsecase
+			var publicKeys []jwt.JWK
+			var pushedAuthorizationUsecase usecase.PushedAuthorizationUsecase
+			if config.OIDC.Enabled() {
+				signer, err := jwt.NewSigner(config.OIDCSigningKey)
+				if err != nil {
+					return nil, err
+				}
+				idTokenIssuer = usecase.NewIDTokenIssuer(config.OIDC, signer, clock)
+				userInfoUsecase = usecase.NewUserInfoUsecase(customerRepository, auditRepository, idTokenIssuer, clock)
+				publicKeys = []jwt.JWK{signer.PublicKey()}
+				// PAR は認可エンドポイントに渡すためのものなので、認可コードフローを有効にした場合だけ受け付ける
+				if config.AuthorizationConsentURI != "" {
+					pushedAuthorizationUsecase = usecase.NewPushedAuthorizationUsecase(transactionManager, config.OIDC.Issuer, clock)
+				}
+			}
+			tokenUsecase := usecase.NewTokenUsecase(tokenRepository, auditRepository, transactionManager, idTokenIssuer, clock)
+			clientUsecase := usecase.NewClientUsecase(clientRepository, repos.AuthLockout(), auditRepository, config.LockoutPolicy, clock)
+			accountInfoUseCase := usecase.NewAccountInfoUsecase(customerRepository, accountRepository, repos.Consent(), auditRepository, clock)
+			auditUsecase := usecase.NewAuditUsecase(auditRepository, clock)
+			v1.Use(middleware.RateLimit(newRateLimitUsecase(config, clientRepository, clock)))
 			accountInfoHandler := handler.NewAccountInfoHandler(accountInfoUseCase, tokenUsecase, clock)
-			tokenHandler := handler.NewTokenHandler(tokenUsecase, clientUsecase, clock)
-			serverHandler := handler.NewServerHandler(accountInfoHandler, tokenHandler)
+			var deviceAuthorizationUsecase usecase.DeviceAuthorizationUsecase
+			if config.DeviceVerificationURI != "" {
+				deviceAuthorizationUsecase = usecase.NewDeviceAuthorizationUsecase(transactionManager, auditRepository, idTokenIssuer, clock)
+			}
+			var authorizationCodeUsecase usecase.AuthorizationCodeUsecase
+			if config.AuthorizationConsentURI != "" && pushedAuthorizationUsecase != nil {
+				authorizationCodeUsecase = usecase.NewAuthorizationCodeUsecase(transactionManager, auditRepository, pushedAuthorizationUsecase, idTokenIssuer, clock)
+			}
+			tokenHandler := handler.NewTokenHandler(tokenUsecase, authorizationCodeUsecase, deviceAuthorizationUsecase, clientUsecase, clock)
+			auditHandler := handler.NewAuditHandler(auditUsecase, tokenUsecase)
+			clientSecretHandler := handler.NewClientSecretHandler(usecase.NewClientSecretUsecase(transactionManager, clock), clientUsecase, clock)
+			var clientRegistrationUsecase usecase.ClientRegistrationUsecase
+			if len(config.Registration.AllowedScopes) > 0 {
+				clientRegistrationUsecase = usecase.NewClientRegistrationUsecase(transactionManager, config.Registration, clock)
+			}
+			clientRegistrationHandler := handler.NewClientRegistrationHandler(clientRegistrationUsecase, config.BaseURL)
+			consentHandler := handler.NewConsentHandler(usecase.NewConsentUsecase(transactionManager, clock), tokenUsecase, clock)
+			oidcHandler := handler.NewOIDCHandler(userInfoUsecase, tokenUsecase, config.OIDC, publicKeys, deviceAuthorizationUsecase != nil, authorizationCodeUsecase != nil)
+			pushedAuthorizationHandler := handler.NewPushedAuthorizationHandler(pushedAuthorizationUsecase, clientUsecase, clock)
+			deviceAuthorizationHandler := handler.NewDeviceAuthorizationHandler(deviceAuthorizationUsecase, clientUsecase, tokenUsecase, config.DeviceVerificationURI, clock)
+			authorizationHandler := handler.NewAuthorizationHandler(authorizationCodeUsecase, tokenUsecase, config.AuthorizationConsentURI, config.OIDC.Issuer)
+			serverHandler := handler.NewServerHandler(accountInfoHandler, tokenHandler, auditHandler, clientSecretHandler, clientRegistrationHandler, consentHandler, oidcHandler, pushedAuthorizationHandler, deviceAuthorizationHandler, authorizationHandler)
 			presenter.RegisterHandlers(v1, serverHandler)
 		}
 	}
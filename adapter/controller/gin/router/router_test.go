@@ -0,0 +1,51 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-banking-api/adapter/controller/gin/middleware"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg/health"
+	"go-banking-api/usecase"
+)
+
+func TestRateLimitBeforeAuthentication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	store := inmemory.NewStore()
+	router, err := NewGinRouter(Config{
+		CorsAllowOrigins: []string{"http://localhost:8001"},
+		RateLimit:        usecase.RateLimitConfig{Source: entity.RateLimit{PerMinute: 1, Burst: 2}},
+		RateLimitMaxKeys: 100,
+		LockoutPolicy:    usecase.DefaultLockoutPolicy,
+	}, store, inmemory.NewTransactionManager(store), health.NewReadiness(0))
+	require.NoError(t, err)
+
+	postToken := func(remoteAddr string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodPost, "/api/v1/token", strings.NewReader("grant_type=refresh_token&refresh_token=refresh-token-1"))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		request.SetBasicAuth("unknown-client", "wrong-secret")
+		// OpenAPI の servers に合わせる
+		request.Host = "localhost:8080"
+		request.RemoteAddr = remoteAddr
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, request)
+		return w
+	}
+
+	// 認証に失敗するリクエストも接続元 IP ごとに数える
+	assert.Equal(t, http.StatusUnauthorized, postToken("192.0.2.1:1234").Code)
+	assert.Equal(t, http.StatusUnauthorized, postToken("192.0.2.1:1234").Code)
+	w := postToken("192.0.2.1:1234")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get(middleware.RetryAfterHeader))
+
+	assert.Equal(t, http.StatusUnauthorized, postToken("192.0.2.2:1234").Code)
+}
@@ -1,13 +1,17 @@
 package gateway
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
 	"go-banking-api/entity"
 )
 
 type AccountRepository interface {
-	Get(cifNo int) (*entity.Account, error)
+	Get(ctx context.Context, cifNo int) (*entity.Account, error)
+	// List は cifNo の口座を id の昇順に返す
+	List(ctx context.Context, cifNo int) ([]entity.Account, error)
 }
 
 type accountRepository struct {
@@ -18,10 +22,18 @@ func NewAccountRepository(db *gorm.DB) AccountRepository {
 	return &accountRepository{db: db}
 }
 
-func (a *accountRepository) Get(cifNo int) (*entity.Account, error) {
+func (a *accountRepository) Get(ctx context.Context, cifNo int) (*entity.Account, error) {
 	var account = entity.Account{}
-	if err := a.db.Where("cif_no = ?", cifNo).Take(&account).Error; err != nil {
+	if err := a.db.WithContext(ctx).Where("cif_no = ?", cifNo).Take(&account).Error; err != nil {
 		return nil, err
 	}
 	return &account, nil
 }
+
+func (a *accountRepository) List(ctx context.Context, cifNo int) ([]entity.Account, error) {
+	var accounts []entity.Account
+	if err := a.db.WithContext(ctx).Where("cif_no = ?", cifNo).Order("id").Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
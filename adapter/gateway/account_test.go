@@ -1,6 +1,7 @@
 package gateway_test
 
 import (
+	"context"
 	"errors"
 	"regexp"
 	"testing"
@@ -54,7 +55,7 @@ func (suite *AccountRepositoryTestSuite) TestAccountRepositoryGet() {
 	}
 
 	suite.DB.Create(&paramAccount)
-	got, err := suite.repository.Get(paramAccount.CifNo)
+	got, err := suite.repository.Get(context.Background(), paramAccount.CifNo)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(paramAccount, *got)
 }
@@ -63,7 +64,7 @@ func (suite *AccountRepositoryTestSuite) TestAccountGetFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `accounts` WHERE cif_no = ? LIMIT ?")).WithArgs(1, 1).WillReturnError(errors.New("get error"))
 
-	account, err := suite.repository.Get(1)
+	account, err := suite.repository.Get(context.Background(), 1)
 	suite.Assert().Nil(account)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -0,0 +1,103 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-banking-api/entity"
+)
+
+// 同時に追記された場合の再試行回数
+const auditAppendRetries = 5
+
+// AuditFilter は監査ログの検索条件。ゼロ値の項目は条件にしない。
+// 結果は ID の昇順で、AfterID より後のものを最大 Limit 件返す。
+type AuditFilter struct {
+	ClientID  string
+	CifNo     int
+	Operation entity.AuditOperation
+	From      time.Time
+	To        time.Time
+	AfterID   int64
+	Limit     int
+}
+
+// AuditRepository は追記のみの監査ログ。更新・削除の手段は提供しない。
+type AuditRepository interface {
+	// Append は直前のイベントに連結して event を追記し、event の ID・PrevHash・Hash を設定する
+	Append(ctx context.Context, event *entity.AuditEvent) error
+	List(ctx context.Context, filter AuditFilter) ([]entity.AuditEvent, error)
+}
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+// Append は prev_hash の一意制約でチェーンの分岐を防ぐ。
+// 他のプロセスと同時に追記して一意制約に違反した場合は、最新のイベントを読み直して再試行する。
+func (a *auditRepository) Append(ctx context.Context, event *entity.AuditEvent) error {
+	var err error
+	for i := 0; i < auditAppendRetries; i++ {
+		err = a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			query := tx.Order("id DESC").Limit(1)
+			if tx.Dialector.Name() != "sqlite" {
+				query = query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+			}
+			var last []entity.AuditEvent
+			if err := query.Find(&last).Error; err != nil {
+				return err
+			}
+			prevHash := ""
+			if len(last) > 0 {
+				prevHash = last[0].Hash
+			}
+
+			event.ID = 0
+			event.Chain(prevHash)
+			return tx.Create(event).Error
+		})
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return err
+		}
+	}
+	return err
+}
+
+func (a *auditRepository) List(ctx context.Context, filter AuditFilter) ([]entity.AuditEvent, error) {
+	query := a.db.WithContext(ctx).Order("id")
+	if filter.ClientID != "" {
+		query = query.Where("client_id = ?", filter.ClientID)
+	}
+	if filter.CifNo != 0 {
+		query = query.Where("cif_no = ?", filter.CifNo)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("occurred_at >= ?", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("occurred_at < ?", filter.To.UTC())
+	}
+	if filter.AfterID > 0 {
+		query = query.Where("id > ?", filter.AfterID)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var events []entity.AuditEvent
+	if err := query.Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
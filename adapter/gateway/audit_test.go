@@ -0,0 +1,109 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tester"
+)
+
+type AuditRepositoryTestSuite struct {
+	tester.DBSQLiteSuite
+	repository gateway.AuditRepository
+}
+
+func TestAuditRepositorySuite(t *testing.T) {
+	suite.Run(t, new(AuditRepositoryTestSuite))
+}
+
+func (suite *AuditRepositoryTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.repository = gateway.NewAuditRepository(suite.DB)
+}
+
+func (suite *AuditRepositoryTestSuite) SetupTest() {
+	suite.Require().NoError(suite.DB.Exec("DELETE FROM audit_events").Error)
+}
+
+func (suite *AuditRepositoryTestSuite) AfterTest(suiteName, testName string) {
+	suite.repository = gateway.NewAuditRepository(suite.DB)
+}
+
+// mockDB は一意制約違反を gorm.ErrDuplicatedKey に変換する設定のモックを使う
+func (suite *AuditRepositoryTestSuite) mockDB() sqlmock.Sqlmock {
+	db, mock, err := sqlmock.New()
+	suite.Require().NoError(err)
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{TranslateError: true})
+	suite.Require().NoError(err)
+	suite.repository = gateway.NewAuditRepository(gormDB)
+	return mock
+}
+
+func (suite *AuditRepositoryTestSuite) TestAppend() {
+	event := entity.AuditEvent{
+		OccurredAt: pkg.Str2time("2025-12-02"),
+		Operation:  entity.AuditOperationAccountRead,
+		Outcome:    entity.AuditOutcomeSuccess,
+		ClientID:   "client-1",
+		CifNo:      1,
+		RequestID:  "req-1",
+	}
+	suite.Require().NoError(suite.repository.Append(context.Background(), &event))
+	suite.Assert().NotZero(event.ID)
+	suite.Assert().Empty(event.PrevHash)
+
+	var stored entity.AuditEvent
+	suite.Require().NoError(suite.DB.Take(&stored, event.ID).Error)
+	suite.Assert().Equal(event.Hash, stored.Hash)
+	suite.Assert().Equal(stored.Hash, stored.ComputeHash())
+}
+
+func (suite *AuditRepositoryTestSuite) TestAppendRetriesOnConflict() {
+	mock := suite.mockDB()
+	selectLast := regexp.QuoteMeta("SELECT * FROM `audit_events` ORDER BY id DESC LIMIT ? FOR UPDATE")
+	insert := regexp.QuoteMeta("INSERT INTO `audit_events`")
+	columns := []string{"id", "hash"}
+
+	// 他のプロセスが先に追記したため一意制約に違反する
+	mock.ExpectBegin()
+	mock.ExpectQuery(selectLast).WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "hash-1"))
+	mock.ExpectExec(insert).WillReturnError(&mysqlDriver.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	mock.ExpectRollback()
+	// 最新のイベントを読み直して連結する
+	mock.ExpectBegin()
+	mock.ExpectQuery(selectLast).WillReturnRows(sqlmock.NewRows(columns).AddRow(2, "hash-2"))
+	mock.ExpectExec(insert).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectCommit()
+
+	event := entity.AuditEvent{OccurredAt: pkg.Str2time("2025-12-02"), Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess}
+	suite.Require().NoError(suite.repository.Append(context.Background(), &event))
+	suite.Assert().Equal("hash-2", event.PrevHash)
+	suite.Assert().Equal(int64(3), event.ID)
+	suite.Assert().NoError(mock.ExpectationsWereMet())
+}
+
+func (suite *AuditRepositoryTestSuite) TestAppendFailure() {
+	mock := suite.mockDB()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_events`")).WillReturnError(errors.New("select error"))
+	mock.ExpectRollback()
+
+	event := entity.AuditEvent{OccurredAt: pkg.Str2time("2025-12-02"), Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess}
+	err := suite.repository.Append(context.Background(), &event)
+	suite.Assert().EqualError(err, "select error")
+	suite.Assert().NoError(mock.ExpectationsWereMet())
+}
@@ -0,0 +1,98 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-banking-api/entity"
+)
+
+type AuthLockoutRepository interface {
+	Get(ctx context.Context, key string) (*entity.AuthLockout, error)
+	// RecordFailure は key の失敗を policy に従って数え、更新後の記録と新たにロックしたかどうかを返す
+	RecordFailure(ctx context.Context, key string, now time.Time, policy entity.LockoutPolicy) (*entity.AuthLockout, bool, error)
+	// Delete は key の記録を消してロックを解除する
+	Delete(ctx context.Context, key string) error
+	// DeleteByPrefix は prefix で始まるキーの記録をすべて消す。記録がない場合は gorm.ErrRecordNotFound を返す。
+	DeleteByPrefix(ctx context.Context, prefix string) error
+}
+
+type authLockoutRepository struct {
+	db *gorm.DB
+}
+
+func NewAuthLockoutRepository(db *gorm.DB) AuthLockoutRepository {
+	return &authLockoutRepository{db: db}
+}
+
+func (a *authLockoutRepository) Get(ctx context.Context, key string) (*entity.AuthLockout, error) {
+	var lockout entity.AuthLockout
+	if err := a.db.WithContext(ctx).Where("lockout_key = ?", key).Take(&lockout).Error; err != nil {
+		return nil, err
+	}
+	return &lockout, nil
+}
+
+// RecordFailure は同時に失敗した場合も数え漏れないよう、行をロックしてから更新する。
+// 初回の失敗が同時に起きて一意制約に違反した場合は、作られた行を読み直して再試行する。
+func (a *authLockoutRepository) RecordFailure(ctx context.Context, key string, now time.Time, policy entity.LockoutPolicy) (*entity.AuthLockout, bool, error) {
+	var lockout entity.AuthLockout
+	var locked bool
+	err := a.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Where("lockout_key = ?", key).Limit(1)
+		if tx.Dialector.Name() != "sqlite" {
+			query = query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+		}
+		var found []entity.AuthLockout
+		if err := query.Find(&found).Error; err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			lockout = entity.AuthLockout{Key: key}
+			locked = lockout.RegisterFailure(now, policy)
+			return tx.Create(&lockout).Error
+		}
+		lockout = found[0]
+		locked = lockout.RegisterFailure(now, policy)
+		return tx.Save(&lockout).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return a.RecordFailure(ctx, key, now, policy)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return &lockout, locked, nil
+}
+
+func (a *authLockoutRepository) Delete(ctx context.Context, key string) error {
+	result := a.db.WithContext(ctx).Where("lockout_key = ?", key).Delete(&entity.AuthLockout{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (a *authLockoutRepository) DeleteByPrefix(ctx context.Context, prefix string) error {
+	result := a.db.WithContext(ctx).Where("lockout_key LIKE ? ESCAPE '!'", escapeLike(prefix)+"%").Delete(&entity.AuthLockout{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// escapeLike は LIKE のワイルドカードを ESCAPE '!' でエスケープする
+func escapeLike(value string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(value)
+}
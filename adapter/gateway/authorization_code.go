@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type AuthorizationCodeRepository interface {
+	// Create は id か code が重複する場合 gorm.ErrDuplicatedKey を返す
+	Create(ctx context.Context, code *entity.AuthorizationCode) error
+	Get(ctx context.Context, id string) (*entity.AuthorizationCode, error)
+	GetByCode(ctx context.Context, code string) (*entity.AuthorizationCode, error)
+	// UpdateStatus は pending のものだけを status にし、期限を expiresAt に変える。
+	// 同時に承認・拒否された場合は先に更新したほうだけを成功させる。
+	UpdateStatus(ctx context.Context, id string, status entity.AuthorizationCodeStatus, cifNo int, expiresAt time.Time) error
+	// MarkExchanged は approved のものだけを exchanged にし、期限を expiresAt に変える。
+	// 同時にトークンと交換された場合は先に更新したほうだけを成功させる。
+	MarkExchanged(ctx context.Context, id string, expiresAt time.Time) error
+	DeleteByClientID(ctx context.Context, clientID string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type authorizationCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewAuthorizationCodeRepository(db *gorm.DB) AuthorizationCodeRepository {
+	return &authorizationCodeRepository{db: db}
+}
+
+func (a *authorizationCodeRepository) Create(ctx context.Context, code *entity.AuthorizationCode) error {
+	return a.db.WithContext(ctx).Create(code).Error
+}
+
+func (a *authorizationCodeRepository) Get(ctx context.Context, id string) (*entity.AuthorizationCode, error) {
+	var code entity.AuthorizationCode
+	if err := a.db.WithContext(ctx).Where("id = ?", id).Take(&code).Error; err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+func (a *authorizationCodeRepository) GetByCode(ctx context.Context, code string) (*entity.AuthorizationCode, error) {
+	var authorizationCode entity.AuthorizationCode
+	if err := a.db.WithContext(ctx).Where("code = ?", code).Take(&authorizationCode).Error; err != nil {
+		return nil, err
+	}
+	return &authorizationCode, nil
+}
+
+func (a *authorizationCodeRepository) UpdateStatus(ctx context.Context, id string, status entity.AuthorizationCodeStatus, cifNo int, expiresAt time.Time) error {
+	result := a.db.WithContext(ctx).Model(&entity.AuthorizationCode{}).
+		Where("id = ? AND status = ?", id, entity.AuthorizationCodeStatusPending).
+		Updates(map[string]any{"status": status, "cif_no": cifNo, "expires_at": expiresAt.UTC()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (a *authorizationCodeRepository) MarkExchanged(ctx context.Context, id string, expiresAt time.Time) error {
+	result := a.db.WithContext(ctx).Model(&entity.AuthorizationCode{}).
+		Where("id = ? AND status = ?", id, entity.AuthorizationCodeStatusApproved).
+		Updates(map[string]any{"status": entity.AuthorizationCodeStatusExchanged, "expires_at": expiresAt.UTC()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (a *authorizationCodeRepository) DeleteByClientID(ctx context.Context, clientID string) error {
+	return a.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&entity.AuthorizationCode{}).Error
+}
+
+func (a *authorizationCodeRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := a.db.WithContext(ctx).Where("expires_at <= ?", now.UTC()).Delete(&entity.AuthorizationCode{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,60 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type PushedAuthorizationRepository interface {
+	// Create は request_uri が重複する場合 gorm.ErrDuplicatedKey を返す
+	Create(ctx context.Context, request *entity.PushedAuthorizationRequest) error
+	Get(ctx context.Context, requestURI string) (*entity.PushedAuthorizationRequest, error)
+	// Delete は同時に使われた request_uri を一度だけ成功させるため、削除した件数で存在を確認する
+	Delete(ctx context.Context, requestURI string) error
+	DeleteByClientID(ctx context.Context, clientID string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type pushedAuthorizationRepository struct {
+	db *gorm.DB
+}
+
+func NewPushedAuthorizationRepository(db *gorm.DB) PushedAuthorizationRepository {
+	return &pushedAuthorizationRepository{db: db}
+}
+
+func (p *pushedAuthorizationRepository) Create(ctx context.Context, request *entity.PushedAuthorizationRequest) error {
+	return p.db.WithContext(ctx).Create(request).Error
+}
+
+func (p *pushedAuthorizationRepository) Get(ctx context.Context, requestURI string) (*entity.PushedAuthorizationRequest, error) {
+	var request entity.PushedAuthorizationRequest
+	if err := p.db.WithContext(ctx).Where("request_uri = ?", requestURI).Take(&request).Error; err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (p *pushedAuthorizationRepository) Delete(ctx context.Context, requestURI string) error {
+	result := p.db.WithContext(ctx).Where("request_uri = ?", requestURI).Delete(&entity.PushedAuthorizationRequest{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (p *pushedAuthorizationRepository) DeleteByClientID(ctx context.Context, clientID string) error {
+	return p.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&entity.PushedAuthorizationRequest{}).Error
+}
+
+func (p *pushedAuthorizationRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := p.db.WithContext(ctx).Where("expires_at <= ?", now.UTC()).Delete(&entity.PushedAuthorizationRequest{})
+	return result.RowsAffected, result.Error
+}
@@ -1,13 +1,38 @@
 package gateway
 
 import (
+	"context"
+	"time"
+
 	"go-banking-api/entity"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ClientRepository interface {
-	Get(clientID string) (*entity.Client, error)
+	Get(ctx context.Context, clientID string) (*entity.Client, error)
+	// GetForUpdate はトランザクション内で呼び、コミットまでクライアントの行を他の GetForUpdate から保護する
+	GetForUpdate(ctx context.Context, clientID string) (*entity.Client, error)
+	// Create は client_id が重複する場合 gorm.ErrDuplicatedKey を返す
+	Create(ctx context.Context, client *entity.Client) error
+	// List は client_id の昇順に返す
+	List(ctx context.Context) ([]entity.Client, error)
+	UpdateScope(ctx context.Context, clientID string, scope string) error
+	// UpdateMetadata は client.ClientID のクライアント名・スコープと RFC 7591 のメタデータを client の値にする
+	UpdateMetadata(ctx context.Context, client *entity.Client) error
+	SetRegistrationAccessTokenHash(ctx context.Context, clientID string, hash string) error
+	// UpdateJWKS は jwks が空の場合にリクエストオブジェクトの公開鍵を削除する
+	UpdateJWKS(ctx context.Context, clientID string, jwks string) error
+	SetFAPI(ctx context.Context, clientID string, fapi bool) error
+	SetSandbox(ctx context.Context, clientID string, sandbox bool) error
+	// SetDisabledAt は disabledAt が nil の場合に有効に戻す
+	SetDisabledAt(ctx context.Context, clientID string, disabledAt *time.Time) error
+	// Delete はクライアントのシークレットもあわせて削除する
+	Delete(ctx context.Context, clientID string) error
+	// AddSecret は secret.ClientID のクライアントにシークレットを追加し、secret.ID を設定する
+	AddSecret(ctx context.Context, secret *entity.ClientSecret) error
+	SetSecretNotAfter(ctx context.Context, clientID string, secretID int64, notAfter *time.Time) error
 }
 
 type clientRepository struct {
@@ -18,10 +43,117 @@ func NewClientRepository(db *gorm.DB) ClientRepository {
 	return &clientRepository{db: db}
 }
 
-func (c *clientRepository) Get(clientID string) (*entity.Client, error) {
+func (c *clientRepository) Get(ctx context.Context, clientID string) (*entity.Client, error) {
 	var client entity.Client
-	if err := c.db.Where("client_id = ?", clientID).Take(&client).Error; err != nil {
+	if err := c.withSecrets(ctx).Where("client_id = ?", clientID).Take(&client).Error; err != nil {
 		return nil, err
 	}
 	return &client, nil
 }
+
+// GetForUpdate は SQLite では行ロックがないため Get と同じ（書き込みはデータベース全体のロックで直列化される）
+func (c *clientRepository) GetForUpdate(ctx context.Context, clientID string) (*entity.Client, error) {
+	query := c.withSecrets(ctx).Where("client_id = ?", clientID)
+	if c.db.Dialector.Name() != "sqlite" {
+		query = query.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate})
+	}
+	var client entity.Client
+	if err := query.Take(&client).Error; err != nil {
+		return nil, err
+	}
+	return &client, nil
+}
+
+func (c *clientRepository) withSecrets(ctx context.Context) *gorm.DB {
+	return c.db.WithContext(ctx).Preload("Secrets", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+}
+
+// Create は client.Secrets もあわせて登録する
+func (c *clientRepository) Create(ctx context.Context, client *entity.Client) error {
+	return c.db.WithContext(ctx).Create(client).Error
+}
+
+func (c *clientRepository) List(ctx context.Context) ([]entity.Client, error) {
+	var clients []entity.Client
+	if err := c.withSecrets(ctx).Order("client_id").Find(&clients).Error; err != nil {
+		return nil, err
+	}
+	return clients, nil
+}
+
+func (c *clientRepository) UpdateScope(ctx context.Context, clientID string, scope string) error {
+	return c.update(ctx, clientID, map[string]interface{}{"scope": scope})
+}
+
+func (c *clientRepository) UpdateMetadata(ctx context.Context, client *entity.Client) error {
+	return c.update(ctx, client.ClientID, map[string]interface{}{
+		"client_name":                client.ClientName,
+		"scope":                      client.Scope,
+		"redirect_uris":              client.RedirectURIs,
+		"grant_types":                client.GrantTypes,
+		"token_endpoint_auth_method": client.TokenEndpointAuthMethod,
+	})
+}
+
+func (c *clientRepository) SetRegistrationAccessTokenHash(ctx context.Context, clientID string, hash string) error {
+	return c.update(ctx, clientID, map[string]interface{}{"registration_access_token_hash": hash})
+}
+
+func (c *clientRepository) UpdateJWKS(ctx context.Context, clientID string, jwks string) error {
+	return c.update(ctx, clientID, map[string]interface{}{"jwks": jwks})
+}
+
+func (c *clientRepository) SetFAPI(ctx context.Context, clientID string, fapi bool) error {
+	return c.update(ctx, clientID, map[string]interface{}{"fapi": fapi})
+}
+
+func (c *clientRepository) SetSandbox(ctx context.Context, clientID string, sandbox bool) error {
+	return c.update(ctx, clientID, map[string]interface{}{"sandbox": sandbox})
+}
+
+func (c *clientRepository) SetDisabledAt(ctx context.Context, clientID string, disabledAt *time.Time) error {
+	return c.update(ctx, clientID, map[string]interface{}{"disabled_at": disabledAt})
+}
+
+// update は値が変わらない場合も対象があれば成功とするため、件数ではなく存在を確認する
+func (c *clientRepository) update(ctx context.Context, clientID string, values map[string]interface{}) error {
+	if _, err := c.Get(ctx, clientID); err != nil {
+		return err
+	}
+	return c.db.WithContext(ctx).Model(&entity.Client{}).Where("client_id = ?", clientID).Updates(values).Error
+}
+
+func (c *clientRepository) Delete(ctx context.Context, clientID string) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("client_id = ?", clientID).Delete(&entity.ClientSecret{}).Error; err != nil {
+			return err
+		}
+		result := tx.Where("client_id = ?", clientID).Delete(&entity.Client{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+func (c *clientRepository) AddSecret(ctx context.Context, secret *entity.ClientSecret) error {
+	if _, err := c.Get(ctx, secret.ClientID); err != nil {
+		return err
+	}
+	return c.db.WithContext(ctx).Create(secret).Error
+}
+
+func (c *clientRepository) SetSecretNotAfter(ctx context.Context, clientID string, secretID int64, notAfter *time.Time) error {
+	query := c.db.WithContext(ctx).Model(&entity.ClientSecret{}).Where("id = ? AND client_id = ?", secretID, clientID)
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return c.db.WithContext(ctx).Model(&entity.ClientSecret{}).Where("id = ? AND client_id = ?", secretID, clientID).Update("not_after", notAfter).Error
+}
@@ -1,6 +1,7 @@
 package gateway_test
 
 import (
+	"context"
 	"errors"
 	"regexp"
 	"testing"
@@ -43,16 +44,19 @@ func (suite *ClientRepositoryTestSuite) TestClientRepositoryGet() {
 	suite.Require().NoError(err)
 
 	paramClient := entity.Client{
-		ClientID:     "client-1",
-		ClientSecret: secretHash,
-		ClientName:   "Test Client",
-		Scope:        "read:account_and_transactions",
+		ClientID:   "client-1",
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
+		Secrets:    []entity.ClientSecret{{SecretHash: secretHash, CreatedAt: pkg.Str2time("2025-12-02")}},
 	}
 
 	suite.DB.Create(&paramClient)
-	got, err := suite.repository.Get(paramClient.ClientID)
+	got, err := suite.repository.Get(context.Background(), paramClient.ClientID)
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(paramClient, *got)
+	suite.Assert().Equal(paramClient.ClientName, got.ClientName)
+	suite.Require().Len(got.Secrets, 1)
+	suite.Assert().Equal(paramClient.Secrets[0].ID, got.Secrets[0].ID)
+	suite.Assert().True(pkg.CompareHash(got.Secrets[0].SecretHash, "secret-1"))
 }
 
 func (suite *ClientRepositoryTestSuite) TestClientGetFailure() {
@@ -61,7 +65,7 @@ func (suite *ClientRepositoryTestSuite) TestClientGetFailure() {
 		WithArgs("client-1", 1).
 		WillReturnError(errors.New("get error"))
 
-	client, err := suite.repository.Get("client-1")
+	client, err := suite.repository.Get(context.Background(), "client-1")
 	suite.Assert().Nil(client)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
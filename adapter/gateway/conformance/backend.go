@@ -0,0 +1,153 @@
+package conformance
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+)
+
+type gormBackend struct {
+	open      func() (*gorm.DB, func() error, error)
+	terminate func() error
+	db        *gorm.DB
+}
+
+// NewGormBackend は GORM のリポジトリを使うバックエンドを返す。
+// open はマイグレーションを適用済みの DB と、後片付け（コンテナの停止など、不要なら nil）を返すこと。
+func NewGormBackend(open func() (*gorm.DB, func() error, error)) Backend {
+	return &gormBackend{open: open}
+}
+
+func (b *gormBackend) Open() error {
+	db, terminate, err := b.open()
+	if err != nil {
+		return err
+	}
+	b.db = db
+	b.terminate = terminate
+	return nil
+}
+
+func (b *gormBackend) Close() error {
+	var errs []error
+	if b.db != nil {
+		if sqlDB, err := b.db.DB(); err == nil {
+			errs = append(errs, sqlDB.Close())
+		}
+	}
+	if b.terminate != nil {
+		errs = append(errs, b.terminate())
+	}
+	return errors.Join(errs...)
+}
+
+func (b *gormBackend) Reset() error {
+	// 外部キーの参照元から順に削除する
+	for _, table := range []string{"auth_lockouts", "audit_events", "consents", "pushed_authorization_requests", "device_authorizations", "authorization_codes", "tokens", "client_secrets", "clients", "accounts", "customers"} {
+		if err := b.db.Exec("DELETE FROM " + table).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *gormBackend) Seed(fixtures Fixtures) error {
+	return b.db.Transaction(func(tx *gorm.DB) error {
+		for _, customer := range fixtures.Customers {
+			if err := tx.Create(&customer).Error; err != nil {
+				return err
+			}
+		}
+		for _, account := range fixtures.Accounts {
+			if err := tx.Create(&account).Error; err != nil {
+				return err
+			}
+		}
+		for _, client := range fixtures.Clients {
+			if err := tx.Create(&client).Error; err != nil {
+				return err
+			}
+		}
+		for _, token := range fixtures.Tokens {
+			if err := tx.Create(&token).Error; err != nil {
+				return err
+			}
+		}
+		for _, consent := range fixtures.Consents {
+			if err := tx.Create(&consent).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (b *gormBackend) Repositories() gateway.Repositories {
+	return gateway.NewRepositories(b.db)
+}
+
+func (b *gormBackend) TransactionManager() TransactionManager {
+	return gateway.NewTransactionManager(b.db)
+}
+
+type inMemoryBackend struct {
+	store *inmemory.Store
+}
+
+// NewInMemoryRepositoryBackend はインメモリのストアを使うバックエンドを返す
+func NewInMemoryBackend() Backend {
+	return &inMemoryBackend{store: inmemory.NewStore()}
+}
+
+func (b *inMemoryBackend) Open() error {
+	return nil
+}
+
+func (b *inMemoryBackend) Close() error {
+	return nil
+}
+
+func (b *inMemoryBackend) Reset() error {
+	b.store = inmemory.NewStore()
+	return nil
+}
+
+func (b *inMemoryBackend) Seed(fixtures Fixtures) error {
+	for _, customer := range fixtures.Customers {
+		if err := b.store.AddCustomer(customer); err != nil {
+			return err
+		}
+	}
+	for _, account := range fixtures.Accounts {
+		if err := b.store.AddAccount(account); err != nil {
+			return err
+		}
+	}
+	for _, client := range fixtures.Clients {
+		if err := b.store.AddClient(client); err != nil {
+			return err
+		}
+	}
+	for _, token := range fixtures.Tokens {
+		if err := b.store.AddToken(token); err != nil {
+			return err
+		}
+	}
+	for _, consent := range fixtures.Consents {
+		if _, err := b.store.AddConsent(consent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *inMemoryBackend) Repositories() gateway.Repositories {
+	return b.store
+}
+
+func (b *inMemoryBackend) TransactionManager() TransactionManager {
+	return inmemory.NewTransactionManager(b.store)
+}
@@ -0,0 +1,938 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+// Fixtures はコンフォーマンステストの前提データ
+type Fixtures struct {
+	Customers []entity.Customer
+	Accounts  []entity.Account
+	Clients   []entity.Client
+	Tokens    []entity.Token
+	Consents  []entity.Consent
+}
+
+// Backend はストレージごとの差異を吸収し、同じコンフォーマンステストを実行するためのもの。
+// Seed は一意制約に違反した場合 gorm.ErrDuplicatedKey を返すこと。
+type Backend interface {
+	Open() error
+	Close() error
+	Reset() error
+	Seed(fixtures Fixtures) error
+	Repositories() gateway.Repositories
+	TransactionManager() TransactionManager
+}
+
+// TransactionManager は usecase.TransactionManager と同じもの。usecase に依存しないよう、ここで宣言する。
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(repos gateway.Repositories) error) error
+}
+
+// Suite はどのストレージでもリポジトリが同じ振る舞いをすることを確認する
+type Suite struct {
+	suite.Suite
+	Backend Backend
+}
+
+func (suite *Suite) SetupSuite() {
+	suite.Require().NoError(suite.Backend.Open())
+}
+
+func (suite *Suite) TearDownSuite() {
+	suite.Assert().NoError(suite.Backend.Close())
+}
+
+func (suite *Suite) SetupTest() {
+	suite.Require().NoError(suite.Backend.Reset())
+	suite.Require().NoError(suite.Backend.Seed(conformanceFixtures()))
+}
+
+func conformanceFixtures() Fixtures {
+	now := pkg.Str2time("2025-12-02")
+	return Fixtures{
+		Customers: []entity.Customer{{
+			CifNo:      1,
+			NameKana:   "Taro Tanaka",
+			NameKanji:  "田中 太郎",
+			BirthDate:  pkg.Str2time("1990-01-01"),
+			Prefecture: "Tokyo",
+			City:       "Bunkyo",
+			Town:       "Kouraku",
+			Street:     "1-1-1",
+			Email:      "tarou.tanaka@example.com",
+			Phone:      "09012345678",
+			CreatedAt:  now,
+		}},
+		Accounts: []entity.Account{{
+			Id:            1,
+			CifNo:         1,
+			Status:        entity.AccountStatusActive,
+			BranchCode:    "001",
+			AccountNumber: "1234567",
+			AccountType:   "1",
+			Currency:      "JPY",
+			Balance:       10000,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}},
+		Clients: []entity.Client{{
+			ClientID:   "client-1",
+			ClientName: "Test Client",
+			Scope:      "read:account_and_transactions",
+			Secrets:    []entity.ClientSecret{{SecretHash: "secret-hash", CreatedAt: now}},
+			// RateLimitBurst は未指定（既定値を使う）
+			RateLimitPerMinute: 120,
+		}},
+		Tokens: []entity.Token{
+			{
+				AccessToken:  "access-token-1",
+				RefreshToken: "refresh-token-1",
+				Scopes:       "read:account_and_transactions",
+				ExpiresAt:    now,
+				CifNo:        1,
+				ClientID:     "client-1",
+			},
+			{
+				AccessToken:  "access-token-2",
+				RefreshToken: "refresh-token-2",
+				Scopes:       "read:account_and_transactions",
+				ExpiresAt:    now,
+				CifNo:        1,
+				ClientID:     "client-1",
+			},
+		},
+		Consents: []entity.Consent{{
+			CifNo:      1,
+			ClientID:   "client-1",
+			Scope:      "read:account_and_transactions",
+			AccountIDs: "1",
+			CreatedAt:  now,
+			ExpiresAt:  now.Add(entity.ConsentTTL),
+		}},
+	}
+}
+
+func (suite *Suite) TestCustomerGet() {
+	repos := suite.Backend.Repositories()
+	customer, err := repos.Customer().Get(context.Background(), 1)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("Taro Tanaka", customer.NameKana)
+	suite.Assert().Equal("田中 太郎", customer.NameKanji)
+}
+
+func (suite *Suite) TestCustomerGetNotFound() {
+	_, err := suite.Backend.Repositories().Customer().Get(context.Background(), 2)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAccountGet() {
+	account, err := suite.Backend.Repositories().Account().Get(context.Background(), 1)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("1234567", account.AccountNumber)
+	suite.Assert().Equal(int64(10000), account.Balance)
+	suite.Assert().Equal(entity.AccountStatusActive, account.Status)
+}
+
+func (suite *Suite) TestAccountGetNotFound() {
+	_, err := suite.Backend.Repositories().Account().Get(context.Background(), 2)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAccountList() {
+	repository := suite.Backend.Repositories().Account()
+	accounts, err := repository.List(context.Background(), 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts, 1)
+	suite.Assert().Equal(1, accounts[0].Id)
+
+	accounts, err = repository.List(context.Background(), 2)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(accounts)
+}
+
+func (suite *Suite) TestClientGet() {
+	client, err := suite.Backend.Repositories().Client().Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("Test Client", client.ClientName)
+	suite.Require().Len(client.Secrets, 1)
+	suite.Assert().Equal("secret-hash", client.Secrets[0].SecretHash)
+	suite.Assert().Equal("client-1", client.Secrets[0].ClientID)
+	suite.Assert().Nil(client.Secrets[0].NotAfter)
+	suite.Assert().Equal(120, client.RateLimitPerMinute)
+	suite.Assert().Equal(0, client.RateLimitBurst)
+}
+
+func (suite *Suite) TestClientGetNotFound() {
+	_, err := suite.Backend.Repositories().Client().Get(context.Background(), "client-2")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientGetForUpdate() {
+	err := suite.Backend.TransactionManager().Do(context.Background(), func(repos gateway.Repositories) error {
+		client, err := repos.Client().GetForUpdate(context.Background(), "client-1")
+		if err != nil {
+			return err
+		}
+		suite.Assert().Len(client.Secrets, 1)
+		_, err = repos.Client().GetForUpdate(context.Background(), "client-2")
+		suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+		return nil
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *Suite) TestClientDuplicated() {
+	err := suite.Backend.Seed(Fixtures{Clients: conformanceFixtures().Clients})
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+}
+
+func (suite *Suite) TestClientCreateAndList() {
+	repository := suite.Backend.Repositories().Client()
+	createdAt := pkg.Str2time("2025-12-03").UTC()
+	err := repository.Create(context.Background(), &entity.Client{
+		ClientID:   "client-0",
+		ClientName: "Another Client",
+		Scope:      "read:audit_log",
+		Secrets:    []entity.ClientSecret{{SecretHash: "hash-0", CreatedAt: pkg.Str2time("2025-12-02")}},
+		Sandbox:    true,
+		CreatedAt:  createdAt,
+	})
+	suite.Require().NoError(err)
+
+	clients, err := repository.List(context.Background())
+	suite.Require().NoError(err)
+	suite.Require().Len(clients, 2)
+	// client_id 順に返す
+	suite.Assert().Equal("client-0", clients[0].ClientID)
+	suite.Assert().Equal("client-1", clients[1].ClientID)
+	suite.Assert().False(clients[0].IsDisabled())
+	suite.Require().Len(clients[0].Secrets, 1)
+	suite.Assert().Equal("hash-0", clients[0].Secrets[0].SecretHash)
+	suite.Assert().True(clients[0].Sandbox)
+	suite.Assert().True(createdAt.Equal(clients[0].CreatedAt))
+	suite.Assert().False(clients[1].Sandbox)
+
+	err = repository.Create(context.Background(), &entity.Client{ClientID: "client-1", ClientName: "Duplicated", Scope: "read:audit_log"})
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+}
+
+func (suite *Suite) TestClientUpdate() {
+	repository := suite.Backend.Repositories().Client()
+	suite.Require().NoError(repository.UpdateScope(context.Background(), "client-1", "read:account_and_transactions read:audit_log"))
+	disabledAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	suite.Require().NoError(repository.SetDisabledAt(context.Background(), "client-1", &disabledAt))
+
+	client, err := repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("read:account_and_transactions read:audit_log", client.Scope)
+	suite.Require().True(client.IsDisabled())
+	suite.Assert().True(disabledAt.Equal(*client.DisabledAt))
+
+	// 同じ値での更新もエラーにしない
+	suite.Require().NoError(repository.UpdateScope(context.Background(), "client-1", "read:account_and_transactions read:audit_log"))
+	suite.Require().NoError(repository.SetDisabledAt(context.Background(), "client-1", nil))
+	client, err = repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().False(client.IsDisabled())
+
+	suite.Assert().ErrorIs(repository.UpdateScope(context.Background(), "client-2", "read:audit_log"), gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.SetDisabledAt(context.Background(), "client-2", nil), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientMetadata() {
+	repository := suite.Backend.Repositories().Client()
+	err := repository.Create(context.Background(), &entity.Client{
+		ClientID:                    "client-0",
+		ClientName:                  "Registered Client",
+		Scope:                       "read:account_and_transactions",
+		RedirectURIs:                "https://client.example.com/callback",
+		GrantTypes:                  entity.GrantTypeRefreshToken,
+		TokenEndpointAuthMethod:     entity.TokenEndpointAuthClientSecretBasic,
+		RegistrationAccessTokenHash: "registration-hash",
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(repository.UpdateMetadata(context.Background(), &entity.Client{
+		ClientID:                "client-0",
+		ClientName:              "Renamed Client",
+		Scope:                   "read:audit_log",
+		RedirectURIs:            "https://client.example.com/a https://client.example.com/b",
+		GrantTypes:              entity.GrantTypeRefreshToken,
+		TokenEndpointAuthMethod: entity.TokenEndpointAuthClientSecretBasic,
+		// 登録アクセストークンは変更しない
+		RegistrationAccessTokenHash: "ignored",
+	}))
+	client, err := repository.Get(context.Background(), "client-0")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("Renamed Client", client.ClientName)
+	suite.Assert().Equal("read:audit_log", client.Scope)
+	suite.Assert().Equal([]string{"https://client.example.com/a", "https://client.example.com/b"}, client.RedirectURIList())
+	suite.Assert().Equal([]string{entity.GrantTypeRefreshToken}, client.GrantTypeList())
+	suite.Assert().Equal(entity.TokenEndpointAuthClientSecretBasic, client.TokenEndpointAuthMethod)
+	suite.Assert().Equal("registration-hash", client.RegistrationAccessTokenHash)
+	suite.Assert().True(client.IsDynamicallyRegistered())
+
+	suite.Require().NoError(repository.SetRegistrationAccessTokenHash(context.Background(), "client-0", "rotated-hash"))
+	client, err = repository.Get(context.Background(), "client-0")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("rotated-hash", client.RegistrationAccessTokenHash)
+
+	suite.Assert().ErrorIs(repository.UpdateMetadata(context.Background(), &entity.Client{ClientID: "client-2"}), gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.SetRegistrationAccessTokenHash(context.Background(), "client-2", "hash"), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientRequestObjectSettings() {
+	repository := suite.Backend.Repositories().Client()
+	client, err := repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Empty(client.JWKS)
+	suite.Assert().False(client.FAPI)
+
+	suite.Require().NoError(repository.UpdateJWKS(context.Background(), "client-1", `{"keys":[]}`))
+	suite.Require().NoError(repository.SetFAPI(context.Background(), "client-1", true))
+	client, err = repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(`{"keys":[]}`, client.JWKS)
+	suite.Assert().True(client.FAPI)
+
+	suite.Require().NoError(repository.SetFAPI(context.Background(), "client-1", false))
+	client, err = repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().False(client.FAPI)
+
+	suite.Assert().ErrorIs(repository.UpdateJWKS(context.Background(), "client-2", ""), gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.SetFAPI(context.Background(), "client-2", true), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientSetSandbox() {
+	repository := suite.Backend.Repositories().Client()
+	suite.Require().NoError(repository.SetSandbox(context.Background(), "client-1", true))
+	client, err := repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().True(client.Sandbox)
+
+	suite.Require().NoError(repository.SetSandbox(context.Background(), "client-1", false))
+	client, err = repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().False(client.Sandbox)
+
+	suite.Assert().ErrorIs(repository.SetSandbox(context.Background(), "client-2", true), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientSecrets() {
+	repository := suite.Backend.Repositories().Client()
+	createdAt := pkg.Str2time("2025-12-03")
+	secret := entity.ClientSecret{ClientID: "client-1", SecretHash: "secret-hash-2", CreatedAt: createdAt}
+	suite.Require().NoError(repository.AddSecret(context.Background(), &secret))
+	suite.Assert().NotZero(secret.ID)
+
+	client, err := suite.Backend.Repositories().Client().Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Require().Len(client.Secrets, 2)
+	oldSecretID := client.Secrets[0].ID
+	// 追加した順に返す
+	suite.Assert().Equal(secret.ID, client.Secrets[1].ID)
+	suite.Assert().True(createdAt.Equal(client.Secrets[1].CreatedAt))
+
+	notAfter := time.Date(2025, 12, 4, 0, 0, 0, 0, time.UTC)
+	suite.Require().NoError(repository.SetSecretNotAfter(context.Background(), "client-1", oldSecretID, &notAfter))
+	client, err = repository.Get(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Require().NotNil(client.Secrets[0].NotAfter)
+	suite.Assert().True(notAfter.Equal(*client.Secrets[0].NotAfter))
+	suite.Assert().Nil(client.Secrets[1].NotAfter)
+
+	// 他のクライアントのシークレットは変更できない
+	suite.Assert().ErrorIs(repository.SetSecretNotAfter(context.Background(), "client-2", oldSecretID, nil), gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.SetSecretNotAfter(context.Background(), "client-1", secret.ID+1, nil), gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.AddSecret(context.Background(), &entity.ClientSecret{ClientID: "client-2", SecretHash: "hash", CreatedAt: createdAt}), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestClientDelete() {
+	repos := suite.Backend.Repositories()
+	deleted, err := repos.Token().DeleteByClientID(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(2), deleted)
+	suite.Require().NoError(repos.Consent().DeleteByClientID(context.Background(), "client-1"))
+	suite.Require().NoError(repos.Client().Delete(context.Background(), "client-1"))
+
+	_, err = repos.Client().Get(context.Background(), "client-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = repos.Token().Get(context.Background(), "access-token-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repos.Client().Delete(context.Background(), "client-1"), gorm.ErrRecordNotFound)
+
+	deleted, err = repos.Token().DeleteByClientID(context.Background(), "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(0), deleted)
+}
+
+func (suite *Suite) TestTokenCreate() {
+	repository := suite.Backend.Repositories().Token()
+	token := entity.Token{
+		AccessToken:  "access-token-3",
+		RefreshToken: "refresh-token-3",
+		Scopes:       "read:account_and_transactions",
+		ExpiresAt:    pkg.Str2time("2026-01-01"),
+		CifNo:        1,
+		ClientID:     "client-1",
+	}
+	suite.Require().NoError(repository.Create(context.Background(), &token))
+
+	stored, err := repository.GetByRefreshToken(context.Background(), "refresh-token-3")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-3", stored.AccessToken)
+
+	token.RefreshToken = "refresh-token-4"
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &token), gorm.ErrDuplicatedKey)
+}
+
+func (suite *Suite) TestTokenGet() {
+	repos := suite.Backend.Repositories()
+	token, err := repos.Token().Get(context.Background(), "access-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("refresh-token-1", token.RefreshToken)
+
+	token, err = repos.Token().GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-1", token.AccessToken)
+}
+
+func (suite *Suite) TestTokenGetNotFound() {
+	repos := suite.Backend.Repositories()
+	_, err := repos.Token().Get(context.Background(), "missing")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = repos.Token().GetByRefreshToken(context.Background(), "missing")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestTokenDuplicated() {
+	token := conformanceFixtures().Tokens[0]
+	err := suite.Backend.Seed(Fixtures{Tokens: []entity.Token{token}})
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+
+	token.AccessToken = "access-token-3"
+	err = suite.Backend.Seed(Fixtures{Tokens: []entity.Token{token}})
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+}
+
+func (suite *Suite) TestTokenRotation() {
+	repos := suite.Backend.Repositories()
+	newExpiresAt := pkg.Str2time("2026-01-01")
+	err := repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-3", "refresh-token-3", newExpiresAt)
+	suite.Require().NoError(err)
+
+	token, err := repos.Token().Get(context.Background(), "access-token-3")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("refresh-token-3", token.RefreshToken)
+	suite.Assert().True(newExpiresAt.Equal(token.ExpiresAt))
+	suite.Assert().Equal("read:account_and_transactions", token.Scopes)
+	suite.Assert().Equal(1, token.CifNo)
+	suite.Assert().Equal("client-1", token.ClientID)
+
+	// 更新前のトークンは使えなくなる
+	_, err = repos.Token().Get(context.Background(), "access-token-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = repos.Token().GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	err = repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-4", "refresh-token-4", newExpiresAt)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	// 他のトークンには影響しない
+	token, err = repos.Token().Get(context.Background(), "access-token-2")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("refresh-token-2", token.RefreshToken)
+}
+
+func (suite *Suite) TestTokenRotationNotFound() {
+	err := suite.Backend.Repositories().Token().UpdateByRefreshToken(context.Background(), "missing", "access-token-3", "refresh-token-3", pkg.Str2time("2026-01-01"))
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestTokenRotationDuplicated() {
+	repos := suite.Backend.Repositories()
+	newExpiresAt := pkg.Str2time("2026-01-01")
+
+	err := repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-2", "refresh-token-3", newExpiresAt)
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+	err = repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-3", "refresh-token-2", newExpiresAt)
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+
+	token, err := repos.Token().GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-1", token.AccessToken)
+}
+
+func (suite *Suite) TestTokenDeleteByCustomerAndClient() {
+	repos := suite.Backend.Repositories()
+	deleted, err := repos.Token().DeleteByCustomerAndClient(context.Background(), 2, "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(0), deleted)
+
+	deleted, err = repos.Token().DeleteByCustomerAndClient(context.Background(), 1, "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(2), deleted)
+	_, err = repos.Token().Get(context.Background(), "access-token-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestConsentGet() {
+	repository := suite.Backend.Repositories().Consent()
+	consent, err := repository.Get(context.Background(), 1, "client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("read:account_and_transactions", consent.Scope)
+	suite.Assert().Equal([]int{1}, consent.AccountIDList())
+	suite.Assert().True(pkg.Str2time("2025-12-02").Add(entity.ConsentTTL).Equal(consent.ExpiresAt))
+	suite.Assert().Nil(consent.RevokedAt)
+
+	byID, err := repository.GetByID(context.Background(), consent.ID)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("client-1", byID.ClientID)
+
+	_, err = repository.Get(context.Background(), 2, "client-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = repository.GetByID(context.Background(), consent.ID+1)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestConsentSave() {
+	repository := suite.Backend.Repositories().Consent()
+	existing, err := repository.Get(context.Background(), 1, "client-1")
+	suite.Require().NoError(err)
+	suite.Require().NoError(repository.SetRevokedAt(context.Background(), existing.ID, pkg.Str2time("2025-12-03")))
+
+	// 同じ顧客とクライアントの同意は上書きし、取り消しも解除する
+	expiresAt := pkg.Str2time("2026-06-01")
+	consent := entity.Consent{CifNo: 1, ClientID: "client-1", Scope: "read:audit_log", CreatedAt: pkg.Str2time("2026-03-03"), ExpiresAt: expiresAt}
+	suite.Require().NoError(repository.Save(context.Background(), &consent))
+	suite.Assert().Equal(existing.ID, consent.ID)
+
+	consents, err := repository.ListByCifNo(context.Background(), 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(consents, 1)
+	suite.Assert().Equal("read:audit_log", consents[0].Scope)
+	suite.Assert().Empty(consents[0].AccountIDList())
+	suite.Assert().True(expiresAt.Equal(consents[0].ExpiresAt))
+	suite.Assert().Nil(consents[0].RevokedAt)
+
+	consents, err = repository.ListByCifNo(context.Background(), 2)
+	suite.Require().NoError(err)
+	suite.Assert().Empty(consents)
+}
+
+func (suite *Suite) TestConsentRevokeAndDelete() {
+	repository := suite.Backend.Repositories().Consent()
+	consent, err := repository.Get(context.Background(), 1, "client-1")
+	suite.Require().NoError(err)
+
+	revokedAt := pkg.Str2time("2025-12-03")
+	suite.Require().NoError(repository.SetRevokedAt(context.Background(), consent.ID, revokedAt))
+	consent, err = repository.GetByID(context.Background(), consent.ID)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(consent.RevokedAt)
+	suite.Assert().True(revokedAt.Equal(*consent.RevokedAt))
+	suite.Assert().ErrorIs(repository.SetRevokedAt(context.Background(), consent.ID+1, revokedAt), gorm.ErrRecordNotFound)
+
+	suite.Require().NoError(repository.DeleteByClientID(context.Background(), "client-1"))
+	_, err = repository.GetByID(context.Background(), consent.ID)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestPushedAuthorizationRequest() {
+	repository := suite.Backend.Repositories().PushedAuthorization()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	request := entity.PushedAuthorizationRequest{
+		RequestURI:          "urn:ietf:params:oauth:request_uri:request-1",
+		ClientID:            "client-1",
+		ResponseType:        "code",
+		RedirectURI:         "https://client.example.com/callback",
+		Scope:               "openid read:account_and_transactions",
+		State:               "state-1",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+		CreatedAt:           createdAt,
+		ExpiresAt:           createdAt.Add(time.Minute),
+	}
+	suite.Require().NoError(repository.Create(context.Background(), &request))
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &request), gorm.ErrDuplicatedKey)
+
+	found, err := repository.Get(context.Background(), request.RequestURI)
+	suite.Require().NoError(err)
+	suite.Assert().Equal("client-1", found.ClientID)
+	suite.Assert().Equal([]string{"openid", "read:account_and_transactions"}, found.Scopes())
+	suite.Assert().Equal("state-1", found.State)
+	suite.Assert().Empty(found.Nonce)
+	suite.Assert().True(request.ExpiresAt.Equal(found.ExpiresAt))
+
+	// 一度削除したものは再び削除できない
+	suite.Require().NoError(repository.Delete(context.Background(), request.RequestURI))
+	suite.Assert().ErrorIs(repository.Delete(context.Background(), request.RequestURI), gorm.ErrRecordNotFound)
+	_, err = repository.Get(context.Background(), request.RequestURI)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestPushedAuthorizationRequestCleanup() {
+	repository := suite.Backend.Repositories().PushedAuthorization()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	for i, ttl := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		suite.Require().NoError(repository.Create(context.Background(), &entity.PushedAuthorizationRequest{
+			RequestURI: fmt.Sprintf("urn:ietf:params:oauth:request_uri:request-%d", i+1),
+			ClientID:   "client-1",
+			CreatedAt:  createdAt,
+			ExpiresAt:  createdAt.Add(ttl),
+		}))
+	}
+
+	// 期限ちょうどのものも削除する
+	deleted, err := repository.DeleteExpired(context.Background(), createdAt.Add(2*time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(2), deleted)
+	_, err = repository.Get(context.Background(), "urn:ietf:params:oauth:request_uri:request-3")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(repository.DeleteByClientID(context.Background(), "client-1"))
+	_, err = repository.Get(context.Background(), "urn:ietf:params:oauth:request_uri:request-3")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestDeviceAuthorization() {
+	repository := suite.Backend.Repositories().DeviceAuthorization()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	authorization := entity.DeviceAuthorization{
+		DeviceCode: "device-code-1",
+		UserCode:   "BCDFGHJK",
+		ClientID:   "client-1",
+		Scope:      "read:account_and_transactions",
+		Status:     entity.DeviceAuthorizationStatusPending,
+		Interval:   5,
+		CreatedAt:  createdAt,
+		ExpiresAt:  createdAt.Add(10 * time.Minute),
+	}
+	suite.Require().NoError(repository.Create(context.Background(), &authorization))
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &authorization), gorm.ErrDuplicatedKey)
+	// ユーザーコードも重複させない
+	sameUserCode := authorization
+	sameUserCode.DeviceCode = "device-code-2"
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &sameUserCode), gorm.ErrDuplicatedKey)
+
+	found, err := repository.GetByUserCode(context.Background(), "BCDFGHJK")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("device-code-1", found.DeviceCode)
+	suite.Assert().Equal(entity.DeviceAuthorizationStatusPending, found.Status)
+	suite.Assert().Nil(found.LastPolledAt)
+	suite.Assert().True(authorization.ExpiresAt.Equal(found.ExpiresAt))
+
+	polledAt := createdAt.Add(time.Minute)
+	suite.Require().NoError(repository.UpdatePolling(context.Background(), "device-code-1", polledAt, 10))
+	// pending のものだけを承認・拒否できる
+	suite.Require().NoError(repository.UpdateStatus(context.Background(), "device-code-1", entity.DeviceAuthorizationStatusApproved, 1))
+	suite.Assert().ErrorIs(repository.UpdateStatus(context.Background(), "device-code-1", entity.DeviceAuthorizationStatusDenied, 2), gorm.ErrRecordNotFound)
+	found, err = repository.GetByDeviceCode(context.Background(), "device-code-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(entity.DeviceAuthorizationStatusApproved, found.Status)
+	suite.Assert().Equal(1, found.CifNo)
+	suite.Assert().Equal(10, found.Interval)
+	suite.Require().NotNil(found.LastPolledAt)
+	suite.Assert().True(polledAt.Equal(*found.LastPolledAt))
+
+	// 一度削除したものは再び削除できない
+	suite.Require().NoError(repository.Delete(context.Background(), "device-code-1"))
+	suite.Assert().ErrorIs(repository.Delete(context.Background(), "device-code-1"), gorm.ErrRecordNotFound)
+	_, err = repository.GetByDeviceCode(context.Background(), "device-code-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.UpdatePolling(context.Background(), "device-code-1", polledAt, 10), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestDeviceAuthorizationCleanup() {
+	repository := suite.Backend.Repositories().DeviceAuthorization()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	for i, ttl := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		suite.Require().NoError(repository.Create(context.Background(), &entity.DeviceAuthorization{
+			DeviceCode: fmt.Sprintf("device-code-%d", i+1),
+			UserCode:   fmt.Sprintf("USERCOD%d", i+1),
+			ClientID:   "client-1",
+			Status:     entity.DeviceAuthorizationStatusPending,
+			Interval:   5,
+			CreatedAt:  createdAt,
+			ExpiresAt:  createdAt.Add(ttl),
+		}))
+	}
+
+	// 期限ちょうどのものも削除する
+	deleted, err := repository.DeleteExpired(context.Background(), createdAt.Add(2*time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(2), deleted)
+	_, err = repository.GetByDeviceCode(context.Background(), "device-code-3")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(repository.DeleteByClientID(context.Background(), "client-1"))
+	_, err = repository.GetByDeviceCode(context.Background(), "device-code-3")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAuthorizationCode() {
+	repository := suite.Backend.Repositories().AuthorizationCode()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	code := entity.AuthorizationCode{
+		ID:                  "authorization-1",
+		Code:                "code-1",
+		ClientID:            "client-1",
+		RedirectURI:         "https://app.example.com/callback",
+		Scope:               "openid read:account_and_transactions",
+		State:               "state-1",
+		Nonce:               "nonce-1",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+		Status:              entity.AuthorizationCodeStatusPending,
+		CreatedAt:           createdAt,
+		ExpiresAt:           createdAt.Add(10 * time.Minute),
+	}
+	suite.Require().NoError(repository.Create(context.Background(), &code))
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &code), gorm.ErrDuplicatedKey)
+	// 認可コードも重複させない
+	sameCode := code
+	sameCode.ID = "authorization-2"
+	suite.Assert().ErrorIs(repository.Create(context.Background(), &sameCode), gorm.ErrDuplicatedKey)
+
+	found, err := repository.Get(context.Background(), "authorization-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("code-1", found.Code)
+	suite.Assert().Equal("https://app.example.com/callback", found.RedirectURI)
+	suite.Assert().Equal("nonce-1", found.Nonce)
+	suite.Assert().Equal(entity.AuthorizationCodeStatusPending, found.Status)
+	suite.Assert().True(code.ExpiresAt.Equal(found.ExpiresAt))
+
+	// pending のものだけを承認・拒否でき、期限は承認したときのものにする
+	expiresAt := createdAt.Add(2 * time.Minute)
+	suite.Require().NoError(repository.UpdateStatus(context.Background(), "authorization-1", entity.AuthorizationCodeStatusApproved, 1, expiresAt))
+	suite.Assert().ErrorIs(repository.UpdateStatus(context.Background(), "authorization-1", entity.AuthorizationCodeStatusDenied, 2, expiresAt), gorm.ErrRecordNotFound)
+	found, err = repository.GetByCode(context.Background(), "code-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("authorization-1", found.ID)
+	suite.Assert().Equal(entity.AuthorizationCodeStatusApproved, found.Status)
+	suite.Assert().Equal(1, found.CifNo)
+	suite.Assert().True(expiresAt.Equal(found.ExpiresAt))
+
+	// approved のものだけを一度だけ交換済みにでき、交換後も認可コードで引ける
+	exchangedUntil := createdAt.Add(time.Hour)
+	suite.Require().NoError(repository.MarkExchanged(context.Background(), "authorization-1", exchangedUntil))
+	suite.Assert().ErrorIs(repository.MarkExchanged(context.Background(), "authorization-1", exchangedUntil), gorm.ErrRecordNotFound)
+	found, err = repository.GetByCode(context.Background(), "code-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(entity.AuthorizationCodeStatusExchanged, found.Status)
+	suite.Assert().Equal(1, found.CifNo)
+	suite.Assert().True(exchangedUntil.Equal(found.ExpiresAt))
+	suite.Assert().ErrorIs(repository.MarkExchanged(context.Background(), "unknown", exchangedUntil), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAuthorizationCodeCleanup() {
+	repository := suite.Backend.Repositories().AuthorizationCode()
+	createdAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	for i, ttl := range []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute} {
+		suite.Require().NoError(repository.Create(context.Background(), &entity.AuthorizationCode{
+			ID:                  fmt.Sprintf("authorization-%d", i+1),
+			Code:                fmt.Sprintf("code-%d", i+1),
+			ClientID:            "client-1",
+			RedirectURI:         "https://app.example.com/callback",
+			Scope:               "openid",
+			CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+			CodeChallengeMethod: "S256",
+			Status:              entity.AuthorizationCodeStatusPending,
+			CreatedAt:           createdAt,
+			ExpiresAt:           createdAt.Add(ttl),
+		}))
+	}
+
+	// 期限ちょうどのものも削除する
+	deleted, err := repository.DeleteExpired(context.Background(), createdAt.Add(2*time.Minute))
+	suite.Require().NoError(err)
+	suite.Assert().Equal(int64(2), deleted)
+	_, err = repository.Get(context.Background(), "authorization-3")
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(repository.DeleteByClientID(context.Background(), "client-1"))
+	_, err = repository.Get(context.Background(), "authorization-3")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestTransactionCommit() {
+	err := suite.Backend.TransactionManager().Do(context.Background(), func(repos gateway.Repositories) error {
+		return repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-3", "refresh-token-3", pkg.Str2time("2026-01-01"))
+	})
+	suite.Require().NoError(err)
+
+	token, err := suite.Backend.Repositories().Token().GetByRefreshToken(context.Background(), "refresh-token-3")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-3", token.AccessToken)
+}
+
+func (suite *Suite) TestTransactionRollback() {
+	expectedErr := errors.New("rollback")
+	err := suite.Backend.TransactionManager().Do(context.Background(), func(repos gateway.Repositories) error {
+		if err := repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-3", "refresh-token-3", pkg.Str2time("2026-01-01")); err != nil {
+			return err
+		}
+		return expectedErr
+	})
+	suite.Assert().ErrorIs(err, expectedErr)
+
+	token, err := suite.Backend.Repositories().Token().GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-1", token.AccessToken)
+}
+
+func (suite *Suite) appendAuditEvents(events ...entity.AuditEvent) []entity.AuditEvent {
+	repos := suite.Backend.Repositories()
+	for i := range events {
+		suite.Require().NoError(repos.Audit().Append(context.Background(), &events[i]))
+	}
+	return events
+}
+
+func (suite *Suite) TestAuditAppendChains() {
+	occurredAt := pkg.Str2time("2025-12-02")
+	events := suite.appendAuditEvents(
+		entity.AuditEvent{OccurredAt: occurredAt, Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess, ClientID: "client-1", CifNo: 1, RequestID: "req-1"},
+		entity.AuditEvent{OccurredAt: occurredAt, Operation: entity.AuditOperationTokenRefresh, Outcome: entity.AuditOutcomeFailure, Reason: "invalid_refresh_token", ClientID: "client-1"},
+	)
+	suite.Assert().Empty(events[0].PrevHash)
+	suite.Assert().Equal(events[0].Hash, events[1].PrevHash)
+	suite.Assert().Less(events[0].ID, events[1].ID)
+
+	stored, err := suite.Backend.Repositories().Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	suite.Require().Len(stored, 2)
+	for i, event := range stored {
+		suite.Assert().Equal(events[i].ID, event.ID)
+		suite.Assert().Equal(events[i].Hash, event.Hash)
+		// 読み戻した値からも同じハッシュが計算できる
+		suite.Assert().Equal(event.Hash, event.ComputeHash())
+	}
+	suite.Assert().Equal("invalid_refresh_token", stored[1].Reason)
+	suite.Assert().Equal("req-1", stored[0].RequestID)
+}
+
+func (suite *Suite) TestAuditListFilter() {
+	day1 := pkg.Str2time("2025-12-01")
+	day2 := pkg.Str2time("2025-12-02")
+	events := suite.appendAuditEvents(
+		entity.AuditEvent{OccurredAt: day1, Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess, ClientID: "client-1", CifNo: 1},
+		entity.AuditEvent{OccurredAt: day1, Operation: entity.AuditOperationClientAuthenticate, Outcome: entity.AuditOutcomeFailure, ClientID: "client-2"},
+		entity.AuditEvent{OccurredAt: day2, Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess, ClientID: "client-1", CifNo: 2},
+		entity.AuditEvent{OccurredAt: day2, Operation: entity.AuditOperationTokenRefresh, Outcome: entity.AuditOutcomeSuccess, ClientID: "client-1", CifNo: 1},
+	)
+	ids := func(filter gateway.AuditFilter) []int64 {
+		found, err := suite.Backend.Repositories().Audit().List(context.Background(), filter)
+		suite.Require().NoError(err)
+		result := []int64{}
+		for _, event := range found {
+			result = append(result, event.ID)
+		}
+		return result
+	}
+
+	suite.Assert().Equal([]int64{events[0].ID, events[2].ID, events[3].ID}, ids(gateway.AuditFilter{ClientID: "client-1"}))
+	suite.Assert().Equal([]int64{events[0].ID, events[3].ID}, ids(gateway.AuditFilter{CifNo: 1}))
+	suite.Assert().Equal([]int64{events[0].ID, events[2].ID}, ids(gateway.AuditFilter{Operation: entity.AuditOperationAccountRead}))
+	suite.Assert().Equal([]int64{events[2].ID, events[3].ID}, ids(gateway.AuditFilter{From: day2}))
+	suite.Assert().Equal([]int64{events[0].ID, events[1].ID}, ids(gateway.AuditFilter{To: day2}))
+	suite.Assert().Equal([]int64{events[1].ID, events[2].ID}, ids(gateway.AuditFilter{AfterID: events[0].ID, Limit: 2}))
+	suite.Assert().Empty(ids(gateway.AuditFilter{ClientID: "client-3"}))
+}
+
+func (suite *Suite) TestAuditTransactionRollback() {
+	first := suite.appendAuditEvents(entity.AuditEvent{OccurredAt: pkg.Str2time("2025-12-02"), Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess})[0]
+
+	expectedErr := errors.New("rollback")
+	err := suite.Backend.TransactionManager().Do(context.Background(), func(repos gateway.Repositories) error {
+		event := entity.AuditEvent{OccurredAt: pkg.Str2time("2025-12-02"), Operation: entity.AuditOperationTokenRefresh, Outcome: entity.AuditOutcomeSuccess}
+		if err := repos.Audit().Append(context.Background(), &event); err != nil {
+			return err
+		}
+		return expectedErr
+	})
+	suite.Assert().ErrorIs(err, expectedErr)
+
+	// ロールバックされたイベントの後にも正しく連結される
+	second := suite.appendAuditEvents(entity.AuditEvent{OccurredAt: pkg.Str2time("2025-12-02"), Operation: entity.AuditOperationAccountRead, Outcome: entity.AuditOutcomeSuccess})[0]
+	suite.Assert().Equal(first.Hash, second.PrevHash)
+
+	stored, err := suite.Backend.Repositories().Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	suite.Assert().Len(stored, 2)
+}
+
+func (suite *Suite) TestAuthLockoutRecordFailure() {
+	repository := suite.Backend.Repositories().AuthLockout()
+	policy := entity.LockoutPolicy{Threshold: 2, LockDuration: time.Minute, MaxLockDuration: time.Hour, ResetAfter: time.Hour}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := repository.Get(context.Background(), "client:client-1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	lockout, locked, err := repository.RecordFailure(context.Background(), "client:client-1", now, policy)
+	suite.Require().NoError(err)
+	suite.Assert().False(locked)
+	suite.Assert().Equal(1, lockout.Failures)
+
+	lockout, locked, err = repository.RecordFailure(context.Background(), "client:client-1", now, policy)
+	suite.Require().NoError(err)
+	suite.Assert().True(locked)
+	suite.Assert().Equal(2, lockout.Failures)
+
+	stored, err := repository.Get(context.Background(), "client:client-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(2, stored.Failures)
+	suite.Assert().True(stored.IsLocked(now))
+	suite.Assert().True(stored.LockedUntil.Equal(now.Add(time.Minute)))
+
+	// 他のキーには影響しない
+	_, err = repository.Get(context.Background(), "ip:192.0.2.1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAuthLockoutDelete() {
+	repository := suite.Backend.Repositories().AuthLockout()
+	policy := entity.LockoutPolicy{Threshold: 1, LockDuration: time.Minute, MaxLockDuration: time.Hour, ResetAfter: time.Hour}
+
+	_, _, err := repository.RecordFailure(context.Background(), "ip:192.0.2.1", time.Now(), policy)
+	suite.Require().NoError(err)
+	suite.Require().NoError(repository.Delete(context.Background(), "ip:192.0.2.1"))
+
+	_, err = repository.Get(context.Background(), "ip:192.0.2.1")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	suite.Assert().ErrorIs(repository.Delete(context.Background(), "ip:192.0.2.1"), gorm.ErrRecordNotFound)
+}
+
+func (suite *Suite) TestAuthLockoutDeleteByPrefix() {
+	repository := suite.Backend.Repositories().AuthLockout()
+	policy := entity.LockoutPolicy{Threshold: 1, LockDuration: time.Minute, MaxLockDuration: time.Hour, ResetAfter: time.Hour}
+	for _, key := range []string{"client:a_1|ip:192.0.2.1", "client:a_1|ip:192.0.2.2", "client:ab1|ip:192.0.2.1", "client:a_1"} {
+		_, _, err := repository.RecordFailure(context.Background(), key, time.Now(), policy)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().NoError(repository.DeleteByPrefix(context.Background(), "client:a_1|"))
+
+	for _, key := range []string{"client:a_1|ip:192.0.2.1", "client:a_1|ip:192.0.2.2"} {
+		_, err := repository.Get(context.Background(), key)
+		suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound, key)
+	}
+	// "_" は 1 文字のワイルドカードとして扱わない
+	for _, key := range []string{"client:ab1|ip:192.0.2.1", "client:a_1"} {
+		_, err := repository.Get(context.Background(), key)
+		suite.Assert().NoError(err, key)
+	}
+	suite.Assert().ErrorIs(repository.DeleteByPrefix(context.Background(), "client:a_1|"), gorm.ErrRecordNotFound)
+}
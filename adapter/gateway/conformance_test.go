@@ -0,0 +1,31 @@
+package gateway_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway/conformance"
+	"go-banking-api/pkg/tester"
+)
+
+func TestSQLiteRepositoryConformance(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "conformance.sqlite")
+	backend := conformance.NewGormBackend(func() (*gorm.DB, func() error, error) {
+		return tester.OpenSQLiteDB(path)
+	})
+	suite.Run(t, &conformance.Suite{Backend: backend})
+}
+
+func TestMySQLRepositoryConformance(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	suite.Run(t, &conformance.Suite{Backend: conformance.NewGormBackend(tester.OpenMySQLDB)})
+}
+
+func TestPostgresRepositoryConformance(t *testing.T) {
+	testcontainers.SkipIfProviderIsNotHealthy(t)
+	suite.Run(t, &conformance.Suite{Backend: conformance.NewGormBackend(tester.OpenPostgresDB)})
+}
@@ -0,0 +1,82 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type ConsentRepository interface {
+	// ListByCifNo は cifNo の同意を取り消し済みや期限切れのものも含めて id の昇順に返す
+	ListByCifNo(ctx context.Context, cifNo int) ([]entity.Consent, error)
+	// Save は consent.CifNo と consent.ClientID の同意を consent の内容で作成または上書きし、consent.ID を設定する。
+	// 同時に作成して一意制約に違反した場合は gorm.ErrDuplicatedKey を返す。
+	Save(ctx context.Context, consent *entity.Consent) error
+	// DeleteByClientID は clientID の同意をすべて削除する
+	DeleteByClientID(ctx context.Context, clientID string) error
+	Get(ctx context.Context, cifNo int, clientID string) (*entity.Consent, error)
+	GetByID(ctx context.Context, id int64) (*entity.Consent, error)
+	SetRevokedAt(ctx context.Context, id int64, revokedAt time.Time) error
+}
+
+type consentRepository struct {
+	db *gorm.DB
+}
+
+func NewConsentRepository(db *gorm.DB) ConsentRepository {
+	return &consentRepository{db: db}
+}
+
+func (c *consentRepository) Get(ctx context.Context, cifNo int, clientID string) (*entity.Consent, error) {
+	var consent entity.Consent
+	if err := c.db.WithContext(ctx).Where("cif_no = ? AND client_id = ?", cifNo, clientID).Take(&consent).Error; err != nil {
+		return nil, err
+	}
+	return &consent, nil
+}
+
+func (c *consentRepository) GetByID(ctx context.Context, id int64) (*entity.Consent, error) {
+	var consent entity.Consent
+	if err := c.db.WithContext(ctx).Where("id = ?", id).Take(&consent).Error; err != nil {
+		return nil, err
+	}
+	return &consent, nil
+}
+
+func (c *consentRepository) ListByCifNo(ctx context.Context, cifNo int) ([]entity.Consent, error) {
+	var consents []entity.Consent
+	if err := c.db.WithContext(ctx).Where("cif_no = ?", cifNo).Order("id").Find(&consents).Error; err != nil {
+		return nil, err
+	}
+	return consents, nil
+}
+
+func (c *consentRepository) Save(ctx context.Context, consent *entity.Consent) error {
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var found []entity.Consent
+		if err := tx.Where("cif_no = ? AND client_id = ?", consent.CifNo, consent.ClientID).Limit(1).Find(&found).Error; err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			consent.ID = 0
+			return tx.Create(consent).Error
+		}
+		consent.ID = found[0].ID
+		return tx.Save(consent).Error
+	})
+}
+
+// SetRevokedAt は値が変わらない場合も対象があれば成功とするため、件数ではなく存在を確認する
+func (c *consentRepository) SetRevokedAt(ctx context.Context, id int64, revokedAt time.Time) error {
+	if _, err := c.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return c.db.WithContext(ctx).Model(&entity.Consent{}).Where("id = ?", id).Update("revoked_at", revokedAt).Error
+}
+
+func (c *consentRepository) DeleteByClientID(ctx context.Context, clientID string) error {
+	return c.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&entity.Consent{}).Error
+}
@@ -1,13 +1,15 @@
 package gateway
 
 import (
+	"context"
+
 	"gorm.io/gorm"
 
 	"go-banking-api/entity"
 )
 
 type CustomerRepository interface {
-	Get(cifNo int) (*entity.Customer, error)
+	Get(ctx context.Context, cifNo int) (*entity.Customer, error)
 }
 
 type customerRepository struct {
@@ -18,9 +20,9 @@ func NewCustomerRepository(db *gorm.DB) CustomerRepository {
 	return &customerRepository{db: db}
 }
 
-func (c *customerRepository) Get(cifNo int) (*entity.Customer, error) {
+func (c *customerRepository) Get(ctx context.Context, cifNo int) (*entity.Customer, error) {
 	var customer = entity.Customer{}
-	if err := c.db.Take(&customer, cifNo).Error; err != nil {
+	if err := c.db.WithContext(ctx).Take(&customer, cifNo).Error; err != nil {
 		return nil, err
 	}
 	return &customer, nil
@@ -1,6 +1,7 @@
 package gateway_test
 
 import (
+	"context"
 	"errors"
 	"regexp"
 	"testing"
@@ -58,7 +59,7 @@ func (suite *CustomerRepositoryTestSuite) TestCustomer() {
 	}
 
 	suite.DB.Create(&paramCustomer)
-	got, err := suite.repository.Get(paramCustomer.CifNo)
+	got, err := suite.repository.Get(context.Background(), paramCustomer.CifNo)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(paramCustomer, *got)
 }
@@ -67,7 +68,7 @@ func (suite *CustomerRepositoryTestSuite) TestCustomerGetFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `customers` WHERE `customers`.`cif_no` = ? LIMIT ?")).WithArgs(1, 1).WillReturnError(errors.New("get error"))
 
-	customer, err := suite.repository.Get(1)
+	customer, err := suite.repository.Get(context.Background(), 1)
 	suite.Assert().Nil(customer)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -0,0 +1,99 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type DeviceAuthorizationRepository interface {
+	// Create は device_code か user_code が重複する場合 gorm.ErrDuplicatedKey を返す
+	Create(ctx context.Context, authorization *entity.DeviceAuthorization) error
+	GetByDeviceCode(ctx context.Context, deviceCode string) (*entity.DeviceAuthorization, error)
+	GetByUserCode(ctx context.Context, userCode string) (*entity.DeviceAuthorization, error)
+	// UpdateStatus は pending のものだけを status にする。同時に承認・拒否された場合は先に更新したほうだけを成功させる。
+	UpdateStatus(ctx context.Context, deviceCode string, status entity.DeviceAuthorizationStatus, cifNo int) error
+	// UpdatePolling はポーリングされた日時と、次のポーリングまでの間隔（秒）を記録する
+	UpdatePolling(ctx context.Context, deviceCode string, polledAt time.Time, interval int) error
+	// Delete は同時にトークンと交換された device_code を一度だけ成功させるため、削除した件数で存在を確認する
+	Delete(ctx context.Context, deviceCode string) error
+	DeleteByClientID(ctx context.Context, clientID string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type deviceAuthorizationRepository struct {
+	db *gorm.DB
+}
+
+func NewDeviceAuthorizationRepository(db *gorm.DB) DeviceAuthorizationRepository {
+	return &deviceAuthorizationRepository{db: db}
+}
+
+func (d *deviceAuthorizationRepository) Create(ctx context.Context, authorization *entity.DeviceAuthorization) error {
+	return d.db.WithContext(ctx).Create(authorization).Error
+}
+
+func (d *deviceAuthorizationRepository) GetByDeviceCode(ctx context.Context, deviceCode string) (*entity.DeviceAuthorization, error) {
+	var authorization entity.DeviceAuthorization
+	if err := d.db.WithContext(ctx).Where("device_code = ?", deviceCode).Take(&authorization).Error; err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (d *deviceAuthorizationRepository) GetByUserCode(ctx context.Context, userCode string) (*entity.DeviceAuthorization, error) {
+	var authorization entity.DeviceAuthorization
+	if err := d.db.WithContext(ctx).Where("user_code = ?", userCode).Take(&authorization).Error; err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (d *deviceAuthorizationRepository) UpdateStatus(ctx context.Context, deviceCode string, status entity.DeviceAuthorizationStatus, cifNo int) error {
+	result := d.db.WithContext(ctx).Model(&entity.DeviceAuthorization{}).
+		Where("device_code = ? AND status = ?", deviceCode, entity.DeviceAuthorizationStatusPending).
+		Updates(map[string]any{"status": status, "cif_no": cifNo})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) UpdatePolling(ctx context.Context, deviceCode string, polledAt time.Time, interval int) error {
+	result := d.db.WithContext(ctx).Model(&entity.DeviceAuthorization{}).
+		Where("device_code = ?", deviceCode).
+		Updates(map[string]any{"last_polled_at": polledAt.UTC(), "polling_interval": interval})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) Delete(ctx context.Context, deviceCode string) error {
+	result := d.db.WithContext(ctx).Where("device_code = ?", deviceCode).Delete(&entity.DeviceAuthorization{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) DeleteByClientID(ctx context.Context, clientID string) error {
+	return d.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&entity.DeviceAuthorization{}).Error
+}
+
+func (d *deviceAuthorizationRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := d.db.WithContext(ctx).Where("expires_at <= ?", now.UTC()).Delete(&entity.DeviceAuthorization{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type accountRepository struct {
+	store *Store
+}
+
+func (a *accountRepository) Get(_ context.Context, cifNo int) (*entity.Account, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	for _, id := range sortedAccountIDs(a.store.accounts) {
+		account := a.store.accounts[id]
+		if account.CifNo == cifNo {
+			return &account, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (a *accountRepository) List(_ context.Context, cifNo int) ([]entity.Account, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	var accounts []entity.Account
+	for _, id := range sortedAccountIDs(a.store.accounts) {
+		if account := a.store.accounts[id]; account.CifNo == cifNo {
+			accounts = append(accounts, account)
+		}
+	}
+	return accounts, nil
+}
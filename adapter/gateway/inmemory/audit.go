@@ -0,0 +1,46 @@
+package inmemory
+
+import (
+	"context"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+)
+
+type auditRepository struct {
+	store *Store
+}
+
+func (a *auditRepository) Append(_ context.Context, event *entity.AuditEvent) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	prevHash := ""
+	if n := len(a.store.auditEvents); n > 0 {
+		prevHash = a.store.auditEvents[n-1].Hash
+	}
+	event.ID = int64(len(a.store.auditEvents) + 1)
+	event.Chain(prevHash)
+	a.store.auditEvents = append(a.store.auditEvents, *event)
+	return nil
+}
+
+func (a *auditRepository) List(_ context.Context, filter gateway.AuditFilter) ([]entity.AuditEvent, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	var events []entity.AuditEvent
+	for _, event := range a.store.auditEvents {
+		if filter.Limit > 0 && len(events) >= filter.Limit {
+			break
+		}
+		if event.ID <= filter.AfterID ||
+			(filter.ClientID != "" && event.ClientID != filter.ClientID) ||
+			(filter.CifNo != 0 && event.CifNo != filter.CifNo) ||
+			(filter.Operation != "" && event.Operation != filter.Operation) ||
+			(!filter.From.IsZero() && event.OccurredAt.Before(filter.From)) ||
+			(!filter.To.IsZero() && !event.OccurredAt.Before(filter.To)) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,63 @@
+package inmemory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type authLockoutRepository struct {
+	store *Store
+}
+
+func (a *authLockoutRepository) Get(_ context.Context, key string) (*entity.AuthLockout, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	lockout, ok := a.store.authLockouts[key]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &lockout, nil
+}
+
+func (a *authLockoutRepository) RecordFailure(_ context.Context, key string, now time.Time, policy entity.LockoutPolicy) (*entity.AuthLockout, bool, error) {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	lockout, ok := a.store.authLockouts[key]
+	if !ok {
+		lockout = entity.AuthLockout{Key: key}
+	}
+	locked := lockout.RegisterFailure(now, policy)
+	a.store.authLockouts[key] = lockout
+	return &lockout, locked, nil
+}
+
+func (a *authLockoutRepository) Delete(_ context.Context, key string) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	if _, ok := a.store.authLockouts[key]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(a.store.authLockouts, key)
+	return nil
+}
+
+func (a *authLockoutRepository) DeleteByPrefix(_ context.Context, prefix string) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	deleted := false
+	for key := range a.store.authLockouts {
+		if strings.HasPrefix(key, prefix) {
+			delete(a.store.authLockouts, key)
+			deleted = true
+		}
+	}
+	if !deleted {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type authorizationCodeRepository struct {
+	store *Store
+}
+
+func (a *authorizationCodeRepository) Create(_ context.Context, code *entity.AuthorizationCode) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	if _, ok := a.store.authorizationCodes[code.ID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	for _, stored := range a.store.authorizationCodes {
+		if stored.Code == code.Code {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	a.store.authorizationCodes[code.ID] = *code
+	return nil
+}
+
+func (a *authorizationCodeRepository) Get(_ context.Context, id string) (*entity.AuthorizationCode, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	code, ok := a.store.authorizationCodes[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &code, nil
+}
+
+func (a *authorizationCodeRepository) GetByCode(_ context.Context, code string) (*entity.AuthorizationCode, error) {
+	a.store.mu.RLock()
+	defer a.store.mu.RUnlock()
+	for _, authorizationCode := range a.store.authorizationCodes {
+		if authorizationCode.Code == code {
+			return &authorizationCode, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (a *authorizationCodeRepository) UpdateStatus(_ context.Context, id string, status entity.AuthorizationCodeStatus, cifNo int, expiresAt time.Time) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	code, ok := a.store.authorizationCodes[id]
+	if !ok || code.Status != entity.AuthorizationCodeStatusPending {
+		return gorm.ErrRecordNotFound
+	}
+	code.Status = status
+	code.CifNo = cifNo
+	code.ExpiresAt = expiresAt.UTC()
+	a.store.authorizationCodes[id] = code
+	return nil
+}
+
+func (a *authorizationCodeRepository) MarkExchanged(_ context.Context, id string, expiresAt time.Time) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	code, ok := a.store.authorizationCodes[id]
+	if !ok || code.Status != entity.AuthorizationCodeStatusApproved {
+		return gorm.ErrRecordNotFound
+	}
+	code.Status = entity.AuthorizationCodeStatusExchanged
+	code.ExpiresAt = expiresAt.UTC()
+	a.store.authorizationCodes[id] = code
+	return nil
+}
+
+func (a *authorizationCodeRepository) DeleteByClientID(_ context.Context, clientID string) error {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	for id, code := range a.store.authorizationCodes {
+		if code.ClientID == clientID {
+			delete(a.store.authorizationCodes, id)
+		}
+	}
+	return nil
+}
+
+func (a *authorizationCodeRepository) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	a.store.mu.Lock()
+	defer a.store.mu.Unlock()
+	var deleted int64
+	for id, code := range a.store.authorizationCodes {
+		if code.IsExpired(now) {
+			delete(a.store.authorizationCodes, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,68 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type pushedAuthorizationRepository struct {
+	store *Store
+}
+
+func (p *pushedAuthorizationRepository) Create(_ context.Context, request *entity.PushedAuthorizationRequest) error {
+	p.store.mu.Lock()
+	defer p.store.mu.Unlock()
+	if _, ok := p.store.pushedAuthorizationRequests[request.RequestURI]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	p.store.pushedAuthorizationRequests[request.RequestURI] = *request
+	return nil
+}
+
+func (p *pushedAuthorizationRepository) Get(_ context.Context, requestURI string) (*entity.PushedAuthorizationRequest, error) {
+	p.store.mu.RLock()
+	defer p.store.mu.RUnlock()
+	request, ok := p.store.pushedAuthorizationRequests[requestURI]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &request, nil
+}
+
+func (p *pushedAuthorizationRepository) Delete(_ context.Context, requestURI string) error {
+	p.store.mu.Lock()
+	defer p.store.mu.Unlock()
+	if _, ok := p.store.pushedAuthorizationRequests[requestURI]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(p.store.pushedAuthorizationRequests, requestURI)
+	return nil
+}
+
+func (p *pushedAuthorizationRepository) DeleteByClientID(_ context.Context, clientID string) error {
+	p.store.mu.Lock()
+	defer p.store.mu.Unlock()
+	for requestURI, request := range p.store.pushedAuthorizationRequests {
+		if request.ClientID == clientID {
+			delete(p.store.pushedAuthorizationRequests, requestURI)
+		}
+	}
+	return nil
+}
+
+func (p *pushedAuthorizationRepository) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	p.store.mu.Lock()
+	defer p.store.mu.Unlock()
+	var deleted int64
+	for requestURI, request := range p.store.pushedAuthorizationRequests {
+		if request.IsExpired(now) {
+			delete(p.store.pushedAuthorizationRequests, requestURI)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
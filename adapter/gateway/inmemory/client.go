@@ -0,0 +1,137 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type clientRepository struct {
+	store *Store
+}
+
+func (c *clientRepository) Get(_ context.Context, clientID string) (*entity.Client, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	client, ok := c.store.clients[clientID]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	client.Secrets = slices.Clone(client.Secrets)
+	return &client, nil
+}
+
+// GetForUpdate は Get と同じ。トランザクションは Store.mu を保持したまま実行するため、ほかから更新されることはない。
+func (c *clientRepository) GetForUpdate(ctx context.Context, clientID string) (*entity.Client, error) {
+	return c.Get(ctx, clientID)
+}
+
+func (c *clientRepository) Create(_ context.Context, client *entity.Client) error {
+	if err := c.store.AddClient(*client); err != nil {
+		return err
+	}
+	// GORM と同じく採番した ID を呼び出し側に返す
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	copy(client.Secrets, c.store.clients[client.ClientID].Secrets)
+	return nil
+}
+
+func (c *clientRepository) List(_ context.Context) ([]entity.Client, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	clients := make([]entity.Client, 0, len(c.store.clients))
+	for _, client := range c.store.clients {
+		client.Secrets = slices.Clone(client.Secrets)
+		clients = append(clients, client)
+	}
+	sort.Slice(clients, func(i, j int) bool { return clients[i].ClientID < clients[j].ClientID })
+	return clients, nil
+}
+
+func (c *clientRepository) UpdateScope(_ context.Context, clientID string, scope string) error {
+	return c.update(clientID, func(client *entity.Client) { client.Scope = scope })
+}
+
+func (c *clientRepository) UpdateMetadata(_ context.Context, client *entity.Client) error {
+	return c.update(client.ClientID, func(stored *entity.Client) {
+		stored.ClientName = client.ClientName
+		stored.Scope = client.Scope
+		stored.RedirectURIs = client.RedirectURIs
+		stored.GrantTypes = client.GrantTypes
+		stored.TokenEndpointAuthMethod = client.TokenEndpointAuthMethod
+	})
+}
+
+func (c *clientRepository) SetRegistrationAccessTokenHash(_ context.Context, clientID string, hash string) error {
+	return c.update(clientID, func(client *entity.Client) { client.RegistrationAccessTokenHash = hash })
+}
+
+func (c *clientRepository) UpdateJWKS(_ context.Context, clientID string, jwks string) error {
+	return c.update(clientID, func(client *entity.Client) { client.JWKS = jwks })
+}
+
+func (c *clientRepository) SetFAPI(_ context.Context, clientID string, fapi bool) error {
+	return c.update(clientID, func(client *entity.Client) { client.FAPI = fapi })
+}
+
+func (c *clientRepository) SetSandbox(_ context.Context, clientID string, sandbox bool) error {
+	return c.update(clientID, func(client *entity.Client) { client.Sandbox = sandbox })
+}
+
+func (c *clientRepository) SetDisabledAt(_ context.Context, clientID string, disabledAt *time.Time) error {
+	return c.update(clientID, func(client *entity.Client) { client.DisabledAt = disabledAt })
+}
+
+func (c *clientRepository) update(clientID string, fn func(client *entity.Client)) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	client, ok := c.store.clients[clientID]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	// 更新前の値をロールバック用のスナップショットと共有しないよう、複製してから変更する
+	client.Secrets = slices.Clone(client.Secrets)
+	fn(&client)
+	c.store.clients[clientID] = client
+	return nil
+}
+
+func (c *clientRepository) AddSecret(_ context.Context, secret *entity.ClientSecret) error {
+	return c.update(secret.ClientID, func(client *entity.Client) {
+		c.store.lastClientSecretID++
+		secret.ID = c.store.lastClientSecretID
+		client.Secrets = append(client.Secrets, *secret)
+	})
+}
+
+func (c *clientRepository) SetSecretNotAfter(_ context.Context, clientID string, secretID int64, notAfter *time.Time) error {
+	found := false
+	err := c.update(clientID, func(client *entity.Client) {
+		for i := range client.Secrets {
+			if client.Secrets[i].ID == secretID {
+				client.Secrets[i].NotAfter = notAfter
+				found = true
+			}
+		}
+	})
+	if err == nil && !found {
+		return gorm.ErrRecordNotFound
+	}
+	return err
+}
+
+func (c *clientRepository) Delete(_ context.Context, clientID string) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	if _, ok := c.store.clients[clientID]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(c.store.clients, clientID)
+	return nil
+}
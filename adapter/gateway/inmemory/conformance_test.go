@@ -0,0 +1,13 @@
+package inmemory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway/conformance"
+)
+
+func TestInMemoryRepositoryConformance(t *testing.T) {
+	suite.Run(t, &conformance.Suite{Backend: conformance.NewInMemoryBackend()})
+}
@@ -0,0 +1,84 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type consentRepository struct {
+	store *Store
+}
+
+func (c *consentRepository) ListByCifNo(_ context.Context, cifNo int) ([]entity.Consent, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	var consents []entity.Consent
+	for _, consent := range c.store.consents {
+		if consent.CifNo == cifNo {
+			consents = append(consents, consent)
+		}
+	}
+	sort.Slice(consents, func(i, j int) bool { return consents[i].ID < consents[j].ID })
+	return consents, nil
+}
+
+func (c *consentRepository) Save(_ context.Context, consent *entity.Consent) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	if found, ok := c.store.findConsent(consent.CifNo, consent.ClientID); ok {
+		consent.ID = found.ID
+	} else {
+		c.store.lastConsentID++
+		consent.ID = c.store.lastConsentID
+	}
+	c.store.consents[consent.ID] = *consent
+	return nil
+}
+
+func (c *consentRepository) DeleteByClientID(_ context.Context, clientID string) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	for id, consent := range c.store.consents {
+		if consent.ClientID == clientID {
+			delete(c.store.consents, id)
+		}
+	}
+	return nil
+}
+
+func (c *consentRepository) Get(_ context.Context, cifNo int, clientID string) (*entity.Consent, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	consent, ok := c.store.findConsent(cifNo, clientID)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &consent, nil
+}
+
+func (c *consentRepository) GetByID(_ context.Context, id int64) (*entity.Consent, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	consent, ok := c.store.consents[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &consent, nil
+}
+
+func (c *consentRepository) SetRevokedAt(_ context.Context, id int64, revokedAt time.Time) error {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	consent, ok := c.store.consents[id]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	consent.RevokedAt = &revokedAt
+	c.store.consents[id] = consent
+	return nil
+}
@@ -0,0 +1,23 @@
+package inmemory
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type customerRepository struct {
+	store *Store
+}
+
+func (c *customerRepository) Get(_ context.Context, cifNo int) (*entity.Customer, error) {
+	c.store.mu.RLock()
+	defer c.store.mu.RUnlock()
+	customer, ok := c.store.customers[cifNo]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &customer, nil
+}
@@ -0,0 +1,111 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type deviceAuthorizationRepository struct {
+	store *Store
+}
+
+func (d *deviceAuthorizationRepository) Create(_ context.Context, authorization *entity.DeviceAuthorization) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	if _, ok := d.store.deviceAuthorizations[authorization.DeviceCode]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	for _, stored := range d.store.deviceAuthorizations {
+		if stored.UserCode == authorization.UserCode {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	d.store.deviceAuthorizations[authorization.DeviceCode] = *authorization
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) GetByDeviceCode(_ context.Context, deviceCode string) (*entity.DeviceAuthorization, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+	authorization, ok := d.store.deviceAuthorizations[deviceCode]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &authorization, nil
+}
+
+func (d *deviceAuthorizationRepository) GetByUserCode(_ context.Context, userCode string) (*entity.DeviceAuthorization, error) {
+	d.store.mu.RLock()
+	defer d.store.mu.RUnlock()
+	for _, authorization := range d.store.deviceAuthorizations {
+		if authorization.UserCode == userCode {
+			return &authorization, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *deviceAuthorizationRepository) UpdateStatus(_ context.Context, deviceCode string, status entity.DeviceAuthorizationStatus, cifNo int) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	authorization, ok := d.store.deviceAuthorizations[deviceCode]
+	if !ok || authorization.Status != entity.DeviceAuthorizationStatusPending {
+		return gorm.ErrRecordNotFound
+	}
+	authorization.Status = status
+	authorization.CifNo = cifNo
+	d.store.deviceAuthorizations[deviceCode] = authorization
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) UpdatePolling(_ context.Context, deviceCode string, polledAt time.Time, interval int) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	authorization, ok := d.store.deviceAuthorizations[deviceCode]
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	polledAt = polledAt.UTC()
+	authorization.LastPolledAt = &polledAt
+	authorization.Interval = interval
+	d.store.deviceAuthorizations[deviceCode] = authorization
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) Delete(_ context.Context, deviceCode string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	if _, ok := d.store.deviceAuthorizations[deviceCode]; !ok {
+		return gorm.ErrRecordNotFound
+	}
+	delete(d.store.deviceAuthorizations, deviceCode)
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) DeleteByClientID(_ context.Context, clientID string) error {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	for deviceCode, authorization := range d.store.deviceAuthorizations {
+		if authorization.ClientID == clientID {
+			delete(d.store.deviceAuthorizations, deviceCode)
+		}
+	}
+	return nil
+}
+
+func (d *deviceAuthorizationRepository) DeleteExpired(_ context.Context, now time.Time) (int64, error) {
+	d.store.mu.Lock()
+	defer d.store.mu.Unlock()
+	var deleted int64
+	for deviceCode, authorization := range d.store.deviceAuthorizations {
+		if authorization.IsExpired(now) {
+			delete(d.store.deviceAuthorizations, deviceCode)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,104 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+type InMemorySuite struct {
+	suite.Suite
+	store *Store
+}
+
+func TestInMemorySuite(t *testing.T) {
+	suite.Run(t, new(InMemorySuite))
+}
+
+func (suite *InMemorySuite) SetupTest() {
+	suite.store = NewStore()
+}
+
+func (suite *InMemorySuite) TestSeed() {
+	now := pkg.Str2time("2025-12-02")
+	err := Seed(suite.store, pkg.FixedClock{T: now})
+	suite.Require().NoError(err)
+
+	client, err := suite.store.Client().Get(context.Background(), SeedClientID)
+	suite.Require().NoError(err)
+	suite.Require().Len(client.Secrets, 1)
+	suite.Assert().True(pkg.CompareHash(client.Secrets[0].SecretHash, SeedClientSecret))
+
+	token, err := suite.store.Token().GetByRefreshToken(context.Background(), SeedRefreshToken)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(SeedCifNo, token.CifNo)
+	suite.Assert().Equal(SeedClientID, token.ClientID)
+
+	_, err = suite.store.Account().Get(context.Background(), SeedCifNo)
+	suite.Assert().NoError(err)
+}
+
+func (suite *InMemorySuite) TestAddDuplicated() {
+	token := entity.Token{AccessToken: "access", RefreshToken: "refresh"}
+	suite.Require().NoError(suite.store.AddToken(token))
+	suite.Assert().ErrorIs(suite.store.AddToken(token), gorm.ErrDuplicatedKey)
+	suite.Assert().ErrorIs(suite.store.AddToken(entity.Token{AccessToken: "other", RefreshToken: "refresh"}), gorm.ErrDuplicatedKey)
+}
+
+func (suite *InMemorySuite) TestUpdateByRefreshTokenDuplicated() {
+	suite.Require().NoError(suite.store.AddToken(entity.Token{AccessToken: "access-1", RefreshToken: "refresh-1"}))
+	suite.Require().NoError(suite.store.AddToken(entity.Token{AccessToken: "access-2", RefreshToken: "refresh-2"}))
+
+	err := suite.store.Token().UpdateByRefreshToken(context.Background(), "refresh-1", "access-2", "refresh-3", pkg.Str2time("2026-01-01"))
+	suite.Assert().ErrorIs(err, gorm.ErrDuplicatedKey)
+
+	token, err := suite.store.Token().GetByRefreshToken(context.Background(), "refresh-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-1", token.AccessToken)
+}
+
+func (suite *InMemorySuite) TestTransactionRollbackOnPanic() {
+	suite.Require().NoError(suite.store.AddToken(entity.Token{AccessToken: "access-1", RefreshToken: "refresh-1"}))
+	transactionManager := NewTransactionManager(suite.store)
+
+	suite.Assert().Panics(func() {
+		_ = transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+			if err := repos.Token().UpdateByRefreshToken(context.Background(), "refresh-1", "access-2", "refresh-2", pkg.Str2time("2026-01-01")); err != nil {
+				return err
+			}
+			panic("boom")
+		})
+	})
+
+	_, err := suite.store.Token().Get(context.Background(), "access-1")
+	suite.Assert().NoError(err)
+}
+
+func (suite *InMemorySuite) TestRollbackKeepsConcurrentWrites() {
+	transactionManager := NewTransactionManager(suite.store)
+	started := make(chan struct{})
+	recorded := make(chan error)
+
+	err := transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+		go func() {
+			close(started)
+			_, _, err := suite.store.AuthLockout().RecordFailure(context.Background(), "client:demo", pkg.Str2time("2026-01-01"), entity.LockoutPolicy{Threshold: 5})
+			recorded <- err
+		}()
+		<-started
+		return errors.New("rollback")
+	})
+	suite.Require().Error(err)
+	suite.Require().NoError(<-recorded)
+
+	lockout, err := suite.store.AuthLockout().Get(context.Background(), "client:demo")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(1, lockout.Failures)
+}
@@ -0,0 +1,87 @@
+package inmemory
+
+import (
+	"time"
+
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+// デモ用のシードデータ。DB を使わない開発モードで API を試すための固定値。
+const (
+	SeedClientID     = "demo-client"
+	SeedClientSecret = "demo-secret"
+	SeedRefreshToken = "demo-refresh-token"
+	SeedCifNo        = 1
+)
+
+// Seed は store にデモ用の顧客・口座・クライアント・同意・トークンを登録する
+func Seed(store *Store, clock pkg.Clock) error {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	now := clock.Now()
+
+	secretHash, err := pkg.HashString(SeedClientSecret)
+	if err != nil {
+		return err
+	}
+
+	if err := store.AddCustomer(entity.Customer{
+		CifNo:      SeedCifNo,
+		NameKana:   "Tanaka Taro",
+		NameKanji:  "田中 太郎",
+		BirthDate:  pkg.Str2time("1990-01-01"),
+		Prefecture: "Tokyo",
+		City:       "Chiyoda",
+		Town:       "Kanda",
+		Street:     "1-1-1",
+		Email:      "taro.tanaka@example.com",
+		Phone:      "09012345678",
+		CreatedAt:  now,
+	}); err != nil {
+		return err
+	}
+	if err := store.AddAccount(entity.Account{
+		Id:            1,
+		CifNo:         SeedCifNo,
+		Status:        entity.AccountStatusActive,
+		BranchCode:    "123",
+		AccountNumber: "1234567",
+		AccountType:   "1",
+		Currency:      "JPY",
+		Balance:       100000,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}); err != nil {
+		return err
+	}
+	if err := store.AddClient(entity.Client{
+		ClientID:                SeedClientID,
+		ClientName:              "Demo Client",
+		Scope:                   "read:account_and_transactions",
+		Secrets:                 []entity.ClientSecret{{SecretHash: secretHash, CreatedAt: now}},
+		GrantTypes:              entity.GrantTypeRefreshToken,
+		TokenEndpointAuthMethod: entity.TokenEndpointAuthClientSecretBasic,
+	}); err != nil {
+		return err
+	}
+	if _, err := store.AddConsent(entity.Consent{
+		CifNo:      SeedCifNo,
+		ClientID:   SeedClientID,
+		Scope:      "read:account_and_transactions",
+		AccountIDs: "1",
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(entity.ConsentTTL),
+	}); err != nil {
+		return err
+	}
+	return store.AddToken(entity.Token{
+		AccessToken:  "demo-access-token",
+		RefreshToken: SeedRefreshToken,
+		Scopes:       "read:account_and_transactions",
+		ExpiresAt:    now.Add(-time.Second),
+		CifNo:        SeedCifNo,
+		ClientID:     SeedClientID,
+	})
+}
@@ -0,0 +1,255 @@
+package inmemory
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+)
+
+// Store は DB を使わずにリポジトリを提供するインメモリのデータストア。
+// 見つからない場合や一意制約違反の場合は GORM 実装と同じエラーを返す。
+type Store struct {
+	// mu はトランザクション内では何もしない rwLocker に差し替える（transaction.go）
+	mu rwLocker
+	*state
+}
+
+type state struct {
+	customers map[int]entity.Customer
+	accounts  map[int]entity.Account
+	clients   map[string]entity.Client
+	tokens    map[string]entity.Token
+	// lastClientSecretID は DB の自動採番と同じく、ロールバックしても戻さない
+	lastClientSecretID int64
+	// auditEvents は追記のみで、ID は添字 + 1
+	auditEvents  []entity.AuditEvent
+	authLockouts map[string]entity.AuthLockout
+	consents     map[int64]entity.Consent
+	// lastConsentID も lastClientSecretID と同じくロールバックしても戻さない
+	lastConsentID               int64
+	pushedAuthorizationRequests map[string]entity.PushedAuthorizationRequest
+	deviceAuthorizations        map[string]entity.DeviceAuthorization
+	authorizationCodes          map[string]entity.AuthorizationCode
+}
+
+type rwLocker interface {
+	sync.Locker
+	RLock()
+	RUnlock()
+}
+
+// noLock はトランザクションが Store.mu を保持している間、その中のリポジトリが使う
+type noLock struct{}
+
+func (noLock) Lock()    {}
+func (noLock) Unlock()  {}
+func (noLock) RLock()   {}
+func (noLock) RUnlock() {}
+
+func NewStore() *Store {
+	return &Store{
+		mu: &sync.RWMutex{},
+		state: &state{
+			customers:                   map[int]entity.Customer{},
+			accounts:                    map[int]entity.Account{},
+			clients:                     map[string]entity.Client{},
+			tokens:                      map[string]entity.Token{},
+			authLockouts:                map[string]entity.AuthLockout{},
+			consents:                    map[int64]entity.Consent{},
+			pushedAuthorizationRequests: map[string]entity.PushedAuthorizationRequest{},
+			deviceAuthorizations:        map[string]entity.DeviceAuthorization{},
+			authorizationCodes:          map[string]entity.AuthorizationCode{},
+		},
+	}
+}
+
+func (s *Store) Customer() gateway.CustomerRepository {
+	return &customerRepository{store: s}
+}
+
+func (s *Store) Account() gateway.AccountRepository {
+	return &accountRepository{store: s}
+}
+
+func (s *Store) Client() gateway.ClientRepository {
+	return &clientRepository{store: s}
+}
+
+func (s *Store) Token() gateway.TokenRepository {
+	return &tokenRepository{store: s}
+}
+
+func (s *Store) Audit() gateway.AuditRepository {
+	return &auditRepository{store: s}
+}
+
+func (s *Store) AuthLockout() gateway.AuthLockoutRepository {
+	return &authLockoutRepository{store: s}
+}
+
+func (s *Store) Consent() gateway.ConsentRepository {
+	return &consentRepository{store: s}
+}
+
+func (s *Store) PushedAuthorization() gateway.PushedAuthorizationRepository {
+	return &pushedAuthorizationRepository{store: s}
+}
+
+func (s *Store) DeviceAuthorization() gateway.DeviceAuthorizationRepository {
+	return &deviceAuthorizationRepository{store: s}
+}
+
+func (s *Store) AuthorizationCode() gateway.AuthorizationCodeRepository {
+	return &authorizationCodeRepository{store: s}
+}
+
+func (s *Store) AddCustomer(customer entity.Customer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.customers[customer.CifNo]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	s.customers[customer.CifNo] = customer
+	return nil
+}
+
+func (s *Store) AddAccount(account entity.Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.accounts[account.Id]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	s.accounts[account.Id] = account
+	return nil
+}
+
+func (s *Store) AddClient(client entity.Client) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clients[client.ClientID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	client.Secrets = slices.Clone(client.Secrets)
+	for i := range client.Secrets {
+		s.lastClientSecretID++
+		client.Secrets[i].ID = s.lastClientSecretID
+		client.Secrets[i].ClientID = client.ClientID
+	}
+	s.clients[client.ClientID] = client
+	return nil
+}
+
+func (s *Store) AddToken(token entity.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tokens[token.AccessToken]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	if _, ok := s.findTokenByRefreshToken(token.RefreshToken); ok {
+		return gorm.ErrDuplicatedKey
+	}
+	s.tokens[token.AccessToken] = token
+	return nil
+}
+
+// AddConsent は consent.ID を採番して登録し、その ID を返す
+func (s *Store) AddConsent(consent entity.Consent) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.findConsent(consent.CifNo, consent.ClientID); ok {
+		return 0, gorm.ErrDuplicatedKey
+	}
+	s.lastConsentID++
+	consent.ID = s.lastConsentID
+	s.consents[consent.ID] = consent
+	return consent.ID, nil
+}
+
+// findConsent は呼び出し側で s.mu を保持していること
+func (s *Store) findConsent(cifNo int, clientID string) (entity.Consent, bool) {
+	for _, consent := range s.consents {
+		if consent.CifNo == cifNo && consent.ClientID == clientID {
+			return consent, true
+		}
+	}
+	return entity.Consent{}, false
+}
+
+// findTokenByRefreshToken は呼び出し側で s.mu を保持していること
+func (s *Store) findTokenByRefreshToken(refreshToken string) (entity.Token, bool) {
+	for _, token := range s.tokens {
+		if token.RefreshToken == refreshToken {
+			return token, true
+		}
+	}
+	return entity.Token{}, false
+}
+
+type snapshot struct {
+	customers                   map[int]entity.Customer
+	accounts                    map[int]entity.Account
+	clients                     map[string]entity.Client
+	tokens                      map[string]entity.Token
+	auditEvents                 []entity.AuditEvent
+	authLockouts                map[string]entity.AuthLockout
+	consents                    map[int64]entity.Consent
+	pushedAuthorizationRequests map[string]entity.PushedAuthorizationRequest
+	deviceAuthorizations        map[string]entity.DeviceAuthorization
+	authorizationCodes          map[string]entity.AuthorizationCode
+}
+
+func (s *Store) snapshot() snapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return snapshot{
+		customers: copyMap(s.customers),
+		accounts:  copyMap(s.accounts),
+		clients:   copyMap(s.clients),
+		tokens:    copyMap(s.tokens),
+		// 追記のみなので、件数を戻せばロールバックできる
+		auditEvents:                 s.auditEvents[:len(s.auditEvents):len(s.auditEvents)],
+		authLockouts:                copyMap(s.authLockouts),
+		consents:                    copyMap(s.consents),
+		pushedAuthorizationRequests: copyMap(s.pushedAuthorizationRequests),
+		deviceAuthorizations:        copyMap(s.deviceAuthorizations),
+		authorizationCodes:          copyMap(s.authorizationCodes),
+	}
+}
+
+func (s *Store) restore(snap snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.customers = snap.customers
+	s.accounts = snap.accounts
+	s.clients = snap.clients
+	s.tokens = snap.tokens
+	s.auditEvents = snap.auditEvents
+	s.authLockouts = snap.authLockouts
+	s.consents = snap.consents
+	s.pushedAuthorizationRequests = snap.pushedAuthorizationRequests
+	s.deviceAuthorizations = snap.deviceAuthorizations
+	s.authorizationCodes = snap.authorizationCodes
+}
+
+func copyMap[K comparable, V any](src map[K]V) map[K]V {
+	dst := make(map[K]V, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
+// sortedAccountIDs は同じ cif_no の口座が複数ある場合も結果が安定するよう、ID を昇順に並べる
+func sortedAccountIDs(m map[int]entity.Account) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
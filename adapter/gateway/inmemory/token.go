@@ -0,0 +1,86 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+)
+
+type tokenRepository struct {
+	store *Store
+}
+
+func (t *tokenRepository) Get(_ context.Context, tokenVal string) (*entity.Token, error) {
+	t.store.mu.RLock()
+	defer t.store.mu.RUnlock()
+	token, ok := t.store.tokens[tokenVal]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &token, nil
+}
+
+func (t *tokenRepository) GetByRefreshToken(_ context.Context, refreshToken string) (*entity.Token, error) {
+	t.store.mu.RLock()
+	defer t.store.mu.RUnlock()
+	token, ok := t.store.findTokenByRefreshToken(refreshToken)
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &token, nil
+}
+
+func (t *tokenRepository) UpdateByRefreshToken(_ context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	token, ok := t.store.findTokenByRefreshToken(refreshToken)
+	if !ok {
+		return gorm.ErrRecordNotFound
+	}
+	if existing, ok := t.store.tokens[accessToken]; ok && existing.AccessToken != token.AccessToken {
+		return gorm.ErrDuplicatedKey
+	}
+	if existing, ok := t.store.findTokenByRefreshToken(newRefreshToken); ok && existing.AccessToken != token.AccessToken {
+		return gorm.ErrDuplicatedKey
+	}
+
+	delete(t.store.tokens, token.AccessToken)
+	token.AccessToken = accessToken
+	token.RefreshToken = newRefreshToken
+	token.ExpiresAt = expiresAt
+	t.store.tokens[accessToken] = token
+	return nil
+}
+
+func (t *tokenRepository) Create(_ context.Context, token *entity.Token) error {
+	return t.store.AddToken(*token)
+}
+
+func (t *tokenRepository) DeleteByClientID(_ context.Context, clientID string) (int64, error) {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	var count int64
+	for accessToken, token := range t.store.tokens {
+		if token.ClientID == clientID {
+			delete(t.store.tokens, accessToken)
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (t *tokenRepository) DeleteByCustomerAndClient(_ context.Context, cifNo int, clientID string) (int64, error) {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+	var count int64
+	for accessToken, token := range t.store.tokens {
+		if token.CifNo == cifNo && token.ClientID == clientID {
+			delete(t.store.tokens, accessToken)
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,37 @@
+package inmemory
+
+import (
+	"context"
+
+	"go-banking-api/adapter/gateway"
+)
+
+type transactionManager struct {
+	store *Store
+}
+
+func NewTransactionManager(store *Store) *transactionManager {
+	return &transactionManager{store: store}
+}
+
+// Do は Store.mu を保持したままトランザクションを実行し、fn がエラーを返すか panic した場合は実行前の状態に戻す。
+// トランザクション外の書き込み（認証失敗の記録など）も終わるまで待たせるので、ロールバックで消えることはない。
+// fn には同じデータを参照してロックを取らない Store を渡すため、fn の中でトランザクション外のリポジトリを使うとデッドロックする。
+func (t *transactionManager) Do(_ context.Context, fn func(repos gateway.Repositories) error) (err error) {
+	t.store.mu.Lock()
+	defer t.store.mu.Unlock()
+
+	tx := &Store{mu: noLock{}, state: t.store.state}
+	snap := tx.snapshot()
+	defer func() {
+		if r := recover(); r != nil {
+			tx.restore(snap)
+			panic(r)
+		}
+	}()
+
+	if err = fn(tx); err != nil {
+		tx.restore(snap)
+	}
+	return err
+}
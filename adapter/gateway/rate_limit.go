@@ -0,0 +1,143 @@
+package gateway
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+// RateLimitResult はトークンバケットから 1 回分を取り出した結果
+type RateLimitResult struct {
+	// Allowed はバケットに 1 回分が残っていたかどうか
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset はバケットが満杯に戻るまでの時間
+	Reset time.Duration
+	// RetryAfter は拒否された場合に次の 1 回分が補充されるまでの時間
+	RetryAfter time.Duration
+}
+
+// RateLimitStore はキーごとのトークンバケットを保持する。
+// 複数インスタンスで制限を共有する場合は共有ストアの実装に差し替える。
+type RateLimitStore interface {
+	// Take は keys のすべてのバケットに 1 回分が残っている場合だけ、すべてから 1 回分を取り出す。
+	// 結果は keys と同じ順に返す。Limit.Enabled() が true のものだけを渡すこと。
+	Take(ctx context.Context, keys ...RateLimitKey) ([]RateLimitResult, error)
+}
+
+// RateLimitKey はバケットのキーとその制限
+type RateLimitKey struct {
+	Key   string
+	Limit entity.RateLimit
+}
+
+type rateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	capacity  float64
+	perSecond float64
+}
+
+func (b *rateLimitBucket) refill(now time.Time) float64 {
+	elapsed := now.Sub(b.updatedAt).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(b.capacity, b.tokens+elapsed*b.perSecond)
+}
+
+type memoryRateLimitStore struct {
+	mu      sync.Mutex
+	maxKeys int
+	clock   pkg.Clock
+	buckets map[string]*rateLimitBucket
+}
+
+// NewMemoryRateLimitStore はプロセス内にバケットを保持する RateLimitStore を返す。
+// キーが maxKeys を超えた場合は満杯まで補充されたバケットから捨てる。
+func NewMemoryRateLimitStore(maxKeys int, clock pkg.Clock) RateLimitStore {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &memoryRateLimitStore{
+		maxKeys: maxKeys,
+		clock:   clock,
+		buckets: map[string]*rateLimitBucket{},
+	}
+}
+
+func (s *memoryRateLimitStore) Take(_ context.Context, keys ...RateLimitKey) ([]RateLimitResult, error) {
+	now := s.clock.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buckets := make([]*rateLimitBucket, len(keys))
+	allowed := true
+	for i, key := range keys {
+		buckets[i] = s.bucket(key, now)
+		allowed = allowed && buckets[i].tokens >= 1
+	}
+
+	results := make([]RateLimitResult, len(keys))
+	for i, bucket := range buckets {
+		result := RateLimitResult{Limit: keys[i].Limit.Capacity(), Allowed: bucket.tokens >= 1}
+		if allowed {
+			bucket.tokens--
+		} else if !result.Allowed {
+			result.RetryAfter = secondsToDuration((1 - bucket.tokens) / bucket.perSecond)
+		}
+		result.Remaining = int(bucket.tokens)
+		result.Reset = secondsToDuration((bucket.capacity - bucket.tokens) / bucket.perSecond)
+		results[i] = result
+	}
+	return results, nil
+}
+
+// bucket は key のバケットを now まで補充して返す。呼び出し側で s.mu を保持していること。
+func (s *memoryRateLimitStore) bucket(key RateLimitKey, now time.Time) *rateLimitBucket {
+	capacity := float64(key.Limit.Capacity())
+	perSecond := float64(key.Limit.PerMinute) / 60
+
+	bucket, ok := s.buckets[key.Key]
+	if !ok {
+		s.evict(now)
+		bucket = &rateLimitBucket{tokens: capacity, updatedAt: now, capacity: capacity, perSecond: perSecond}
+		s.buckets[key.Key] = bucket
+	}
+	// 設定が変更された場合は新しい容量と補充速度で以降を計算する
+	bucket.tokens = math.Min(capacity, bucket.refill(now))
+	bucket.updatedAt = now
+	bucket.capacity = capacity
+	bucket.perSecond = perSecond
+	return bucket
+}
+
+// evict は呼び出し側で s.mu を保持していること
+func (s *memoryRateLimitStore) evict(now time.Time) {
+	if s.maxKeys <= 0 || len(s.buckets) < s.maxKeys {
+		return
+	}
+	// 満杯まで補充されたバケットは新しく作ったものと同じなので捨てても制限は変わらない
+	for key, bucket := range s.buckets {
+		if bucket.refill(now) >= bucket.capacity {
+			delete(s.buckets, key)
+		}
+	}
+	// それでも多すぎる場合は任意のバケットを捨てる
+	for key := range s.buckets {
+		if len(s.buckets) < s.maxKeys {
+			return
+		}
+		delete(s.buckets, key)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
@@ -0,0 +1,114 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+)
+
+type RateLimitStoreTestSuite struct {
+	suite.Suite
+	clock *manualClock
+	store gateway.RateLimitStore
+}
+
+func TestRateLimitStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitStoreTestSuite))
+}
+
+func (suite *RateLimitStoreTestSuite) SetupTest() {
+	suite.clock = &manualClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	suite.store = gateway.NewMemoryRateLimitStore(2, suite.clock)
+}
+
+func (suite *RateLimitStoreTestSuite) take(key string, limit entity.RateLimit) gateway.RateLimitResult {
+	results, err := suite.store.Take(context.Background(), gateway.RateLimitKey{Key: key, Limit: limit})
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 1)
+	return results[0]
+}
+
+func (suite *RateLimitStoreTestSuite) TestTakeUntilEmpty() {
+	limit := entity.RateLimit{PerMinute: 60, Burst: 2}
+
+	result := suite.take("client:a", limit)
+	suite.Assert().True(result.Allowed)
+	suite.Assert().Equal(2, result.Limit)
+	suite.Assert().Equal(1, result.Remaining)
+	suite.Assert().Equal(time.Second, result.Reset)
+
+	result = suite.take("client:a", limit)
+	suite.Assert().True(result.Allowed)
+	suite.Assert().Equal(0, result.Remaining)
+	suite.Assert().Equal(2*time.Second, result.Reset)
+
+	result = suite.take("client:a", limit)
+	suite.Assert().False(result.Allowed)
+	suite.Assert().Equal(0, result.Remaining)
+	suite.Assert().Equal(time.Second, result.RetryAfter)
+
+	// 他のキーには影響しない
+	suite.Assert().True(suite.take("client:b", limit).Allowed)
+}
+
+func (suite *RateLimitStoreTestSuite) TestRefill() {
+	limit := entity.RateLimit{PerMinute: 60, Burst: 1}
+
+	suite.Assert().True(suite.take("client:a", limit).Allowed)
+	suite.clock.now = suite.clock.now.Add(500 * time.Millisecond)
+	result := suite.take("client:a", limit)
+	suite.Assert().False(result.Allowed)
+	suite.Assert().Equal(500*time.Millisecond, result.RetryAfter)
+
+	suite.clock.now = suite.clock.now.Add(500 * time.Millisecond)
+	suite.Assert().True(suite.take("client:a", limit).Allowed)
+}
+
+func (suite *RateLimitStoreTestSuite) TestLimitChange() {
+	suite.Assert().True(suite.take("client:a", entity.RateLimit{PerMinute: 60, Burst: 10}).Allowed)
+
+	// 容量を小さくした場合は新しい容量で切り詰める
+	result := suite.take("client:a", entity.RateLimit{PerMinute: 60, Burst: 1})
+	suite.Assert().True(result.Allowed)
+	suite.Assert().Equal(0, result.Remaining)
+	suite.Assert().False(suite.take("client:a", entity.RateLimit{PerMinute: 60, Burst: 1}).Allowed)
+}
+
+func (suite *RateLimitStoreTestSuite) TestEvictsRefilledBuckets() {
+	limit := entity.RateLimit{PerMinute: 60, Burst: 1}
+
+	suite.Assert().True(suite.take("client:a", limit).Allowed)
+	suite.clock.now = suite.clock.now.Add(time.Second)
+	suite.Assert().True(suite.take("client:b", limit).Allowed)
+
+	// client:a は満杯まで補充済みなので、client:c を追加する際に捨てられる
+	suite.Assert().True(suite.take("client:c", limit).Allowed)
+	suite.Assert().False(suite.take("client:b", limit).Allowed)
+	suite.Assert().True(suite.take("client:a", limit).Allowed)
+}
+
+func (suite *RateLimitStoreTestSuite) TestTakeMultipleKeysAtomically() {
+	client := gateway.RateLimitKey{Key: "client:a", Limit: entity.RateLimit{PerMinute: 60, Burst: 2}}
+	customer := gateway.RateLimitKey{Key: "customer:a:1", Limit: entity.RateLimit{PerMinute: 60, Burst: 1}}
+
+	results, err := suite.store.Take(context.Background(), client, customer)
+	suite.Require().NoError(err)
+	suite.Assert().True(results[0].Allowed)
+	suite.Assert().True(results[1].Allowed)
+
+	// 顧客のバケットが空なので、クライアントのバケットからも取り出さない
+	results, err = suite.store.Take(context.Background(), client, customer)
+	suite.Require().NoError(err)
+	suite.Assert().True(results[0].Allowed)
+	suite.Assert().Equal(1, results[0].Remaining)
+	suite.Assert().False(results[1].Allowed)
+	suite.Assert().Equal(time.Second, results[1].RetryAfter)
+
+	suite.Assert().True(suite.take("client:a", client.Limit).Allowed)
+	suite.Assert().False(suite.take("client:a", client.Limit).Allowed)
+}
@@ -0,0 +1,70 @@
+// Package gateway はエンティティを gorm で永続化するリポジトリを提供する。
+//
+// 特に断りのない限り、1 件を指定して取得・更新・削除するメソッドは対象がない場合 gorm.ErrRecordNotFound を返す。
+// 期限のあるものの DeleteExpired は now の時点で期限が切れたものを削除して件数を返す。
+// SQLite では時刻を文字列で比較するため、期限は UTC で保存する。
+package gateway
+
+import "gorm.io/gorm"
+
+// Repositories は同じ接続（トランザクション）を共有するリポジトリの集合
+type Repositories interface {
+	Customer() CustomerRepository
+	Account() AccountRepository
+	Client() ClientRepository
+	Token() TokenRepository
+	Audit() AuditRepository
+	AuthLockout() AuthLockoutRepository
+	Consent() ConsentRepository
+	PushedAuthorization() PushedAuthorizationRepository
+	DeviceAuthorization() DeviceAuthorizationRepository
+	AuthorizationCode() AuthorizationCodeRepository
+}
+
+type repositories struct {
+	db *gorm.DB
+}
+
+func NewRepositories(db *gorm.DB) Repositories {
+	return &repositories{db: db}
+}
+
+func (r *repositories) Customer() CustomerRepository {
+	return NewCustomerRepository(r.db)
+}
+
+func (r *repositories) Account() AccountRepository {
+	return NewAccountRepository(r.db)
+}
+
+func (r *repositories) Client() ClientRepository {
+	return NewClientRepository(r.db)
+}
+
+func (r *repositories) Token() TokenRepository {
+	return NewTokenRepository(r.db)
+}
+
+func (r *repositories) Audit() AuditRepository {
+	return NewAuditRepository(r.db)
+}
+
+func (r *repositories) AuthLockout() AuthLockoutRepository {
+	return NewAuthLockoutRepository(r.db)
+}
+
+func (r *repositories) Consent() ConsentRepository {
+	return NewConsentRepository(r.db)
+}
+
+func (r *repositories) PushedAuthorization() PushedAuthorizationRepository {
+	return NewPushedAuthorizationRepository(r.db)
+}
+
+func (r *repositories) DeviceAuthorization() DeviceAuthorizationRepository {
+	return NewDeviceAuthorizationRepository(r.db)
+}
+
+func (r *repositories) AuthorizationCode() AuthorizationCodeRepository {
+	return NewAuthorizationCodeRepository(r.db)
+}
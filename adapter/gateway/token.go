@@ -1,6 +1,7 @@
 package gateway
 
 import (
+	"context"
 	"time"
 
 	"go-banking-api/entity"
@@ -9,9 +10,15 @@ import (
 )
 
 type TokenRepository interface {
-	Get(token string) (*entity.Token, error)
-	GetByRefreshToken(refreshToken string) (*entity.Token, error)
-	UpdateByRefreshToken(refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error
+	Get(ctx context.Context, token string) (*entity.Token, error)
+	GetByRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error)
+	UpdateByRefreshToken(ctx context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error
+	// Create はアクセストークンかリフレッシュトークンが重複する場合 gorm.ErrDuplicatedKey を返す
+	Create(ctx context.Context, token *entity.Token) error
+	// DeleteByClientID は clientID に発行したトークンをすべて失効させ、その件数を返す
+	DeleteByClientID(ctx context.Context, clientID string) (int64, error)
+	// DeleteByCustomerAndClient は clientID が cifNo のために発行したトークンをすべて失効させ、その件数を返す
+	DeleteByCustomerAndClient(ctx context.Context, cifNo int, clientID string) (int64, error)
 }
 
 type tokenRepository struct {
@@ -22,24 +29,24 @@ func NewTokenRepository(db *gorm.DB) TokenRepository {
 	return &tokenRepository{db: db}
 }
 
-func (t *tokenRepository) Get(tokenVal string) (*entity.Token, error) {
+func (t *tokenRepository) Get(ctx context.Context, tokenVal string) (*entity.Token, error) {
 	var token = entity.Token{}
-	if err := t.db.Where("access_token = ?", tokenVal).Take(&token).Error; err != nil {
+	if err := t.db.WithContext(ctx).Where("access_token = ?", tokenVal).Take(&token).Error; err != nil {
 		return nil, err
 	}
 	return &token, nil
 }
 
-func (t *tokenRepository) GetByRefreshToken(refreshToken string) (*entity.Token, error) {
+func (t *tokenRepository) GetByRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error) {
 	var token = entity.Token{}
-	if err := t.db.Where("refresh_token = ?", refreshToken).Take(&token).Error; err != nil {
+	if err := t.db.WithContext(ctx).Where("refresh_token = ?", refreshToken).Take(&token).Error; err != nil {
 		return nil, err
 	}
 	return &token, nil
 }
 
-func (t *tokenRepository) UpdateByRefreshToken(refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
-	result := t.db.Model(&entity.Token{}).Where("refresh_token = ?", refreshToken).Updates(map[string]interface{}{
+func (t *tokenRepository) UpdateByRefreshToken(ctx context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
+	result := t.db.WithContext(ctx).Model(&entity.Token{}).Where("refresh_token = ?", refreshToken).Updates(map[string]interface{}{
 		"access_token":  accessToken,
 		"refresh_token": newRefreshToken,
 		"expires_at":    expiresAt,
@@ -52,3 +59,17 @@ func (t *tokenRepository) UpdateByRefreshToken(refreshToken string, accessToken
 	}
 	return nil
 }
+
+func (t *tokenRepository) Create(ctx context.Context, token *entity.Token) error {
+	return t.db.WithContext(ctx).Create(token).Error
+}
+
+func (t *tokenRepository) DeleteByClientID(ctx context.Context, clientID string) (int64, error) {
+	result := t.db.WithContext(ctx).Where("client_id = ?", clientID).Delete(&entity.Token{})
+	return result.RowsAffected, result.Error
+}
+
+func (t *tokenRepository) DeleteByCustomerAndClient(ctx context.Context, cifNo int, clientID string) (int64, error) {
+	result := t.db.WithContext(ctx).Where("cif_no = ? AND client_id = ?", cifNo, clientID).Delete(&entity.Token{})
+	return result.RowsAffected, result.Error
+}
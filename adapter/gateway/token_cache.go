@@ -0,0 +1,325 @@
+package gateway
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+// TokenCache はアクセストークンの検索結果を保持する LRU/TTL キャッシュ。
+// 見つからなかった結果も NegativeTTL の間キャッシュする。
+// 有効なトークンは TTL と ExpiresAt の早い方までしか保持しない。
+// 無効化は同じプロセス内の更新だけに反映される。管理コマンドなど他のプロセスでの失効は TTL の間反映されない。
+type TokenCache struct {
+	mu          sync.Mutex
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
+	clock       pkg.Clock
+	entries     map[string]*list.Element
+	lru         *list.List
+	// generation は無効化のたびに進め、検索中に無効化された結果を保存しないようにする
+	generation uint64
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type TokenCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+type tokenCacheEntry struct {
+	accessToken string
+	token       *entity.Token
+	expiresAt   time.Time
+}
+
+func NewTokenCache(size int, ttl time.Duration, negativeTTL time.Duration, clock pkg.Clock) *TokenCache {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &TokenCache{
+		size:        size,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		clock:       clock,
+		entries:     map[string]*list.Element{},
+		lru:         list.New(),
+	}
+}
+
+func (c *TokenCache) Stats() TokenCacheStats {
+	c.mu.Lock()
+	size := c.lru.Len()
+	c.mu.Unlock()
+	return TokenCacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Size:      size,
+	}
+}
+
+// Invalidate は失効させたアクセストークンをキャッシュから取り除く
+func (c *TokenCache) Invalidate(accessTokens ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for _, accessToken := range accessTokens {
+		if elem, ok := c.entries[accessToken]; ok {
+			c.remove(elem)
+		}
+	}
+}
+
+// invalidateRefreshToken は refreshToken に紐づくアクセストークンをキャッシュから取り除く
+func (c *TokenCache) invalidateRefreshToken(refreshToken string) {
+	c.invalidateWhere(func(token *entity.Token) bool { return token.RefreshToken == refreshToken })
+}
+
+// invalidateClient は clientID に発行したアクセストークンをキャッシュから取り除く
+func (c *TokenCache) invalidateClient(clientID string) {
+	c.invalidateWhere(func(token *entity.Token) bool { return token.ClientID == clientID })
+}
+
+// invalidateGrant は clientID が cifNo のために発行したアクセストークンをキャッシュから取り除く
+func (c *TokenCache) invalidateGrant(grant tokenGrant) {
+	c.invalidateWhere(func(token *entity.Token) bool { return token.CifNo == grant.cifNo && token.ClientID == grant.clientID })
+}
+
+func (c *TokenCache) invalidateWhere(match func(token *entity.Token) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*tokenCacheEntry)
+		if entry.token != nil && match(entry.token) {
+			c.remove(elem)
+		}
+		elem = next
+	}
+}
+
+// lookup はキャッシュされた結果を返す。found が false の場合は呼び出し側で検索すること。
+func (c *TokenCache) lookup(accessToken string) (token *entity.Token, found bool, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[accessToken]
+	if !ok {
+		c.misses.Add(1)
+		return nil, false, c.generation
+	}
+	entry := elem.Value.(*tokenCacheEntry)
+	if !c.clock.Now().Before(entry.expiresAt) {
+		c.remove(elem)
+		c.misses.Add(1)
+		return nil, false, c.generation
+	}
+	c.lru.MoveToFront(elem)
+	c.hits.Add(1)
+	if entry.token == nil {
+		return nil, true, c.generation
+	}
+	copied := *entry.token
+	return &copied, true, c.generation
+}
+
+// store は generation が lookup 時点から変わっていない場合のみ結果を保存する。token が nil の場合は見つからなかった結果として保存する。
+func (c *TokenCache) store(accessToken string, token *entity.Token, generation uint64) {
+	now := c.clock.Now()
+	var expiresAt time.Time
+	var cached *entity.Token
+	if token == nil {
+		expiresAt = now.Add(c.negativeTTL)
+	} else {
+		expiresAt = now.Add(c.ttl)
+		if token.ExpiresAt.Before(expiresAt) {
+			expiresAt = token.ExpiresAt
+		}
+		copied := *token
+		cached = &copied
+	}
+	if !now.Before(expiresAt) || c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if elem, ok := c.entries[accessToken]; ok {
+		c.remove(elem)
+	}
+	c.entries[accessToken] = c.lru.PushFront(&tokenCacheEntry{
+		accessToken: accessToken,
+		token:       cached,
+		expiresAt:   expiresAt,
+	})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		c.evictions.Add(1)
+	}
+}
+
+// remove は呼び出し側で c.mu を保持していること
+func (c *TokenCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*tokenCacheEntry).accessToken)
+}
+
+type cachedTokenRepository struct {
+	repository TokenRepository
+	cache      *TokenCache
+	// rotated はトランザクション内で更新したリフレッシュトークン。コミット後に改めて無効化する。
+	rotated *[]string
+	// revokedClients はトランザクション内でトークンを失効させたクライアント。コミット後に改めて無効化する。
+	revokedClients *[]string
+	// revokedGrants はトランザクション内でトークンを失効させた顧客とクライアントの組。コミット後に改めて無効化する。
+	revokedGrants *[]tokenGrant
+}
+
+type tokenGrant struct {
+	cifNo    int
+	clientID string
+}
+
+// NewCachedTokenRepository は Get の結果を cache に保持する TokenRepository を返す
+func NewCachedTokenRepository(repository TokenRepository, cache *TokenCache) TokenRepository {
+	return &cachedTokenRepository{repository: repository, cache: cache}
+}
+
+func (r *cachedTokenRepository) Get(ctx context.Context, accessToken string) (*entity.Token, error) {
+	token, found, generation := r.cache.lookup(accessToken)
+	if found {
+		if token == nil {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return token, nil
+	}
+
+	token, err := r.repository.Get(ctx, accessToken)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			r.cache.store(accessToken, nil, generation)
+		}
+		return nil, err
+	}
+	r.cache.store(accessToken, token, generation)
+	return token, nil
+}
+
+func (r *cachedTokenRepository) GetByRefreshToken(ctx context.Context, refreshToken string) (*entity.Token, error) {
+	return r.repository.GetByRefreshToken(ctx, refreshToken)
+}
+
+func (r *cachedTokenRepository) UpdateByRefreshToken(ctx context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
+	if err := r.repository.UpdateByRefreshToken(ctx, refreshToken, accessToken, newRefreshToken, expiresAt); err != nil {
+		return err
+	}
+	r.cache.invalidateRefreshToken(refreshToken)
+	// 新しいアクセストークンについて見つからなかった結果が残らないようにする
+	r.cache.Invalidate(accessToken)
+	if r.rotated != nil {
+		*r.rotated = append(*r.rotated, refreshToken)
+	}
+	return nil
+}
+
+func (r *cachedTokenRepository) Create(ctx context.Context, token *entity.Token) error {
+	if err := r.repository.Create(ctx, token); err != nil {
+		return err
+	}
+	// 見つからなかった結果が残らないようにする
+	r.cache.Invalidate(token.AccessToken)
+	return nil
+}
+
+func (r *cachedTokenRepository) DeleteByClientID(ctx context.Context, clientID string) (int64, error) {
+	count, err := r.repository.DeleteByClientID(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+	r.cache.invalidateClient(clientID)
+	if r.revokedClients != nil {
+		*r.revokedClients = append(*r.revokedClients, clientID)
+	}
+	return count, nil
+}
+
+func (r *cachedTokenRepository) DeleteByCustomerAndClient(ctx context.Context, cifNo int, clientID string) (int64, error) {
+	count, err := r.repository.DeleteByCustomerAndClient(ctx, cifNo, clientID)
+	if err != nil {
+		return 0, err
+	}
+	grant := tokenGrant{cifNo: cifNo, clientID: clientID}
+	r.cache.invalidateGrant(grant)
+	if r.revokedGrants != nil {
+		*r.revokedGrants = append(*r.revokedGrants, grant)
+	}
+	return count, nil
+}
+
+type cachedRepositories struct {
+	Repositories
+	cache          *TokenCache
+	rotated        *[]string
+	revokedClients *[]string
+	revokedGrants  *[]tokenGrant
+}
+
+// NewCachedRepositories は Token() が cache を経由する Repositories を返す
+func NewCachedRepositories(repos Repositories, cache *TokenCache) Repositories {
+	return &cachedRepositories{Repositories: repos, cache: cache}
+}
+
+func (r *cachedRepositories) Token() TokenRepository {
+	return &cachedTokenRepository{repository: r.Repositories.Token(), cache: r.cache, rotated: r.rotated, revokedClients: r.revokedClients, revokedGrants: r.revokedGrants}
+}
+
+type transactionRunner interface {
+	Do(ctx context.Context, fn func(repos Repositories) error) error
+}
+
+type cachedTransactionManager struct {
+	transactionManager transactionRunner
+	cache              *TokenCache
+}
+
+// NewCachedTransactionManager はトランザクション内のトークン更新をコミット後にも cache へ反映する。
+// コミット前に無効化しただけでは、並行する Get がコミット前の値を保存する可能性があるため。
+func NewCachedTransactionManager(transactionManager transactionRunner, cache *TokenCache) *cachedTransactionManager {
+	return &cachedTransactionManager{transactionManager: transactionManager, cache: cache}
+}
+
+func (t *cachedTransactionManager) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	var rotated, revokedClients []string
+	var revokedGrants []tokenGrant
+	err := t.transactionManager.Do(ctx, func(repos Repositories) error {
+		return fn(&cachedRepositories{Repositories: repos, cache: t.cache, rotated: &rotated, revokedClients: &revokedClients, revokedGrants: &revokedGrants})
+	})
+	for _, refreshToken := range rotated {
+		t.cache.invalidateRefreshToken(refreshToken)
+	}
+	for _, clientID := range revokedClients {
+		t.cache.invalidateClient(clientID)
+	}
+	for _, grant := range revokedGrants {
+		t.cache.invalidateGrant(grant)
+	}
+	return err
+}
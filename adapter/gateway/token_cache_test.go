@@ -0,0 +1,292 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+type mockTokenRepository struct {
+	mock.Mock
+}
+
+func (m *mockTokenRepository) Get(_ context.Context, accessToken string) (*entity.Token, error) {
+	args := m.Called(accessToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Token), args.Error(1)
+}
+
+func (m *mockTokenRepository) GetByRefreshToken(_ context.Context, refreshToken string) (*entity.Token, error) {
+	args := m.Called(refreshToken)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Token), args.Error(1)
+}
+
+func (m *mockTokenRepository) UpdateByRefreshToken(_ context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
+	args := m.Called(refreshToken, accessToken, newRefreshToken, expiresAt)
+	return args.Error(0)
+}
+
+func (m *mockTokenRepository) Create(_ context.Context, token *entity.Token) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockTokenRepository) DeleteByClientID(_ context.Context, clientID string) (int64, error) {
+	args := m.Called(clientID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockTokenRepository) DeleteByCustomerAndClient(_ context.Context, cifNo int, clientID string) (int64, error) {
+	args := m.Called(cifNo, clientID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type manualClock struct {
+	now time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	return c.now
+}
+
+type TokenCacheTestSuite struct {
+	suite.Suite
+	clock      *manualClock
+	repository *mockTokenRepository
+	cache      *gateway.TokenCache
+	cached     gateway.TokenRepository
+}
+
+func TestTokenCacheTestSuite(t *testing.T) {
+	suite.Run(t, new(TokenCacheTestSuite))
+}
+
+func (suite *TokenCacheTestSuite) SetupTest() {
+	suite.clock = &manualClock{now: pkg.Str2time("2025-12-02")}
+	suite.repository = new(mockTokenRepository)
+	suite.cache = gateway.NewTokenCache(2, time.Minute, 5*time.Second, suite.clock)
+	suite.cached = gateway.NewCachedTokenRepository(suite.repository, suite.cache)
+}
+
+func (suite *TokenCacheTestSuite) token(accessToken string, expiresIn time.Duration) *entity.Token {
+	return &entity.Token{
+		AccessToken:  accessToken,
+		RefreshToken: "refresh-" + accessToken,
+		ExpiresAt:    suite.clock.now.Add(expiresIn),
+	}
+}
+
+func (suite *TokenCacheTestSuite) TestHit() {
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Once()
+
+	for i := 0; i < 3; i++ {
+		token, err := suite.cached.Get(context.Background(), "a")
+		suite.Require().NoError(err)
+		suite.Assert().Equal("refresh-a", token.RefreshToken)
+	}
+
+	suite.repository.AssertExpectations(suite.T())
+	stats := suite.cache.Stats()
+	suite.Assert().Equal(uint64(2), stats.Hits)
+	suite.Assert().Equal(uint64(1), stats.Misses)
+	suite.Assert().Equal(1, stats.Size)
+}
+
+func (suite *TokenCacheTestSuite) TestReturnsCopy() {
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Once()
+
+	token, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	token.Scopes = "modified"
+
+	token, err = suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	suite.Assert().Empty(token.Scopes)
+}
+
+func (suite *TokenCacheTestSuite) TestNegativeCache() {
+	suite.repository.On("Get", "missing").Return(nil, gorm.ErrRecordNotFound).Twice()
+
+	_, err := suite.cached.Get(context.Background(), "missing")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = suite.cached.Get(context.Background(), "missing")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.clock.now = suite.clock.now.Add(5 * time.Second)
+	_, err = suite.cached.Get(context.Background(), "missing")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestOtherErrorsNotCached() {
+	suite.repository.On("Get", "a").Return(nil, gorm.ErrInvalidDB).Twice()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrInvalidDB)
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrInvalidDB)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestNeverBeyondExpiresAt() {
+	suite.repository.On("Get", "a").Return(suite.token("a", 10*time.Second), nil).Twice()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	suite.clock.now = suite.clock.now.Add(10 * time.Second)
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestTTL() {
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Twice()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	suite.clock.now = suite.clock.now.Add(time.Minute)
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestEviction() {
+	for _, accessToken := range []string{"a", "b", "c"} {
+		suite.repository.On("Get", accessToken).Return(suite.token(accessToken, time.Hour), nil).Once()
+	}
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Once()
+
+	for _, accessToken := range []string{"a", "b", "c", "a"} {
+		_, err := suite.cached.Get(context.Background(), accessToken)
+		suite.Require().NoError(err)
+	}
+
+	suite.repository.AssertExpectations(suite.T())
+	stats := suite.cache.Stats()
+	suite.Assert().Equal(2, stats.Size)
+	suite.Assert().Equal(uint64(2), stats.Evictions)
+}
+
+func (suite *TokenCacheTestSuite) TestInvalidate() {
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Once()
+	suite.repository.On("Get", "a").Return(nil, gorm.ErrRecordNotFound).Once()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	suite.cache.Invalidate("a")
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestInvalidateDuringLookup() {
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Run(func(mock.Arguments) {
+		suite.cache.Invalidate("a")
+	}).Once()
+	suite.repository.On("Get", "a").Return(nil, gorm.ErrRecordNotFound).Once()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestUpdateByRefreshTokenInvalidates() {
+	expiresAt := suite.clock.now.Add(time.Hour)
+	suite.repository.On("Get", "a").Return(suite.token("a", time.Hour), nil).Once()
+	suite.repository.On("Get", "b").Return(nil, gorm.ErrRecordNotFound).Once()
+	suite.repository.On("UpdateByRefreshToken", "refresh-a", "b", "refresh-b", expiresAt).Return(nil).Once()
+	suite.repository.On("Get", "a").Return(nil, gorm.ErrRecordNotFound).Once()
+	suite.repository.On("Get", "b").Return(suite.token("b", time.Hour), nil).Once()
+
+	_, err := suite.cached.Get(context.Background(), "a")
+	suite.Require().NoError(err)
+	_, err = suite.cached.Get(context.Background(), "b")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	err = suite.cached.UpdateByRefreshToken(context.Background(), "refresh-a", "b", "refresh-b", expiresAt)
+	suite.Require().NoError(err)
+
+	_, err = suite.cached.Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = suite.cached.Get(context.Background(), "b")
+	suite.Assert().NoError(err)
+
+	suite.repository.AssertExpectations(suite.T())
+}
+
+func (suite *TokenCacheTestSuite) TestTransactionManagerInvalidates() {
+	store := inmemory.NewStore()
+	suite.Require().NoError(store.AddToken(entity.Token{
+		AccessToken:  "a",
+		RefreshToken: "refresh-a",
+		ExpiresAt:    suite.clock.now.Add(time.Hour),
+	}))
+	repos := gateway.NewCachedRepositories(store, suite.cache)
+	transactionManager := gateway.NewCachedTransactionManager(inmemory.NewTransactionManager(store), suite.cache)
+
+	_, err := repos.Token().Get(context.Background(), "a")
+	suite.Require().NoError(err)
+
+	err = transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+		return repos.Token().UpdateByRefreshToken(context.Background(), "refresh-a", "b", "refresh-b", suite.clock.now.Add(time.Hour))
+	})
+	suite.Require().NoError(err)
+
+	_, err = repos.Token().Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	token, err := repos.Token().Get(context.Background(), "b")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("refresh-b", token.RefreshToken)
+}
+
+func (suite *TokenCacheTestSuite) TestDeleteByCustomerAndClientInvalidates() {
+	store := inmemory.NewStore()
+	for _, token := range []entity.Token{
+		{AccessToken: "a", RefreshToken: "refresh-a", CifNo: 1, ClientID: "client-1"},
+		{AccessToken: "b", RefreshToken: "refresh-b", CifNo: 2, ClientID: "client-1"},
+	} {
+		token.ExpiresAt = suite.clock.now.Add(time.Hour)
+		suite.Require().NoError(store.AddToken(token))
+	}
+	repos := gateway.NewCachedRepositories(store, suite.cache)
+	transactionManager := gateway.NewCachedTransactionManager(inmemory.NewTransactionManager(store), suite.cache)
+
+	for _, accessToken := range []string{"a", "b"} {
+		_, err := repos.Token().Get(context.Background(), accessToken)
+		suite.Require().NoError(err)
+	}
+
+	err := transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+		_, err := repos.Token().DeleteByCustomerAndClient(context.Background(), 1, "client-1")
+		return err
+	})
+	suite.Require().NoError(err)
+
+	_, err = repos.Token().Get(context.Background(), "a")
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+	// 他の顧客のトークンは失効させない
+	_, err = repos.Token().Get(context.Background(), "b")
+	suite.Assert().NoError(err)
+}
@@ -1,6 +1,7 @@
 package gateway_test
 
 import (
+	"context"
 	"errors"
 	"regexp"
 	"testing"
@@ -52,7 +53,7 @@ func (suite *TokenRepositoryTestSuite) TestTokenRepositoryGet() {
 	}
 
 	suite.DB.Create(&paramToken)
-	got, err := suite.repository.Get(paramToken.AccessToken)
+	got, err := suite.repository.Get(context.Background(), paramToken.AccessToken)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(paramToken, *got)
 }
@@ -69,7 +70,7 @@ func (suite *TokenRepositoryTestSuite) TestTokenRepositoryGetByRefreshToken() {
 	}
 
 	suite.DB.Create(&paramToken)
-	got, err := suite.repository.GetByRefreshToken(paramToken.RefreshToken)
+	got, err := suite.repository.GetByRefreshToken(context.Background(), paramToken.RefreshToken)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(paramToken, *got)
 }
@@ -88,10 +89,10 @@ func (suite *TokenRepositoryTestSuite) TestTokenRepositoryUpdateByRefreshToken()
 	suite.DB.Create(&paramToken)
 
 	newExpiresAt := pkg.Str2time("2026-01-01")
-	err := suite.repository.UpdateByRefreshToken("refresh-token-1", "access-token-2", "refresh-token-2", newExpiresAt)
+	err := suite.repository.UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-2", "refresh-token-2", newExpiresAt)
 	suite.Assert().Nil(err)
 
-	got, err := suite.repository.Get("access-token-2")
+	got, err := suite.repository.Get(context.Background(), "access-token-2")
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("refresh-token-2", got.RefreshToken)
 	suite.Assert().Equal(newExpiresAt, got.ExpiresAt)
@@ -100,7 +101,7 @@ func (suite *TokenRepositoryTestSuite) TestTokenRepositoryUpdateByRefreshToken()
 }
 
 func (suite *TokenRepositoryTestSuite) TestTokenRepositoryUpdateByRefreshTokenNotFound() {
-	err := suite.repository.UpdateByRefreshToken("missing-refresh-token", "access-token-2", "refresh-token-2", time.Now())
+	err := suite.repository.UpdateByRefreshToken(context.Background(), "missing-refresh-token", "access-token-2", "refresh-token-2", time.Now())
 	suite.Assert().NotNil(err)
 	suite.Assert().True(errors.Is(err, gorm.ErrRecordNotFound))
 }
@@ -113,7 +114,7 @@ func (suite *TokenRepositoryTestSuite) TestTokenRepositoryUpdateByRefreshTokenEr
 		WillReturnError(errors.New("update error"))
 	mockDB.ExpectRollback()
 
-	err := suite.repository.UpdateByRefreshToken("refresh-token-1", "access-token-2", "refresh-token-2", time.Now())
+	err := suite.repository.UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-2", "refresh-token-2", time.Now())
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("update error", err.Error())
 }
@@ -122,7 +123,7 @@ func (suite *TokenRepositoryTestSuite) TestTokenGetFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tokens` WHERE access_token = ? LIMIT ?")).WithArgs("access-token-1", 1).WillReturnError(errors.New("get error"))
 
-	token, err := suite.repository.Get("access-token-1")
+	token, err := suite.repository.Get(context.Background(), "access-token-1")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
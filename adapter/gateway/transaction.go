@@ -0,0 +1,23 @@
+package gateway
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type transactionManager struct {
+	db *gorm.DB
+}
+
+func NewTransactionManager(db *gorm.DB) *transactionManager {
+	return &transactionManager{db: db}
+}
+
+// Do は fn にトランザクション内で動作する Repositories を渡して実行する。
+// fn がエラーを返すか panic した場合はロールバックし、それ以外はコミットする。
+func (t *transactionManager) Do(ctx context.Context, fn func(repos Repositories) error) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepositories(tx))
+	})
+}
@@ -0,0 +1,87 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tester"
+	"go-banking-api/usecase"
+)
+
+type TransactionManagerTestSuite struct {
+	tester.DBSQLiteSuite
+	transactionManager usecase.TransactionManager
+}
+
+func TestTransactionManagerSuite(t *testing.T) {
+	suite.Run(t, new(TransactionManagerTestSuite))
+}
+
+func (suite *TransactionManagerTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	suite.transactionManager = gateway.NewTransactionManager(suite.DB)
+}
+
+func (suite *TransactionManagerTestSuite) SetupTest() {
+	suite.Require().NoError(suite.DB.Exec("DELETE FROM tokens").Error)
+	suite.Require().NoError(suite.DB.Create(&entity.Token{
+		AccessToken:  "access-token-1",
+		RefreshToken: "refresh-token-1",
+		Scopes:       "read:account_and_transactions",
+		ExpiresAt:    pkg.Str2time("2025-12-02"),
+		CifNo:        1,
+		ClientID:     "client-1",
+	}).Error)
+}
+
+func (suite *TransactionManagerTestSuite) rotate(repos gateway.Repositories) error {
+	if _, err := repos.Token().GetByRefreshToken(context.Background(), "refresh-token-1"); err != nil {
+		return err
+	}
+	return repos.Token().UpdateByRefreshToken(context.Background(), "refresh-token-1", "access-token-2", "refresh-token-2", pkg.Str2time("2026-01-01"))
+}
+
+func (suite *TransactionManagerTestSuite) TestDoCommits() {
+	err := suite.transactionManager.Do(context.Background(), suite.rotate)
+	suite.Require().NoError(err)
+
+	got, err := gateway.NewTokenRepository(suite.DB).GetByRefreshToken(context.Background(), "refresh-token-2")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-2", got.AccessToken)
+}
+
+func (suite *TransactionManagerTestSuite) TestDoRollsBackOnError() {
+	expectedErr := errors.New("after update")
+	err := suite.transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+		if err := suite.rotate(repos); err != nil {
+			return err
+		}
+		return expectedErr
+	})
+	suite.Assert().ErrorIs(err, expectedErr)
+
+	got, err := gateway.NewTokenRepository(suite.DB).GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-1", got.AccessToken)
+}
+
+func (suite *TransactionManagerTestSuite) TestDoRollsBackOnPanic() {
+	suite.Assert().PanicsWithValue("after update", func() {
+		_ = suite.transactionManager.Do(context.Background(), func(repos gateway.Repositories) error {
+			if err := suite.rotate(repos); err != nil {
+				return err
+			}
+			panic("after update")
+		})
+	})
+
+	got, err := gateway.NewTokenRepository(suite.DB).GetByRefreshToken(context.Background(), "refresh-token-1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("access-token-1", got.AccessToken)
+}
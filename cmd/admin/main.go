@@ -0,0 +1,287 @@
+// admin はサードパーティの OAuth クライアントを登録・管理するコマンド。
+//
+// サーバーと同じ設定（CONFIG_FILE と環境変数）で DB に接続し、変更はすべて監査ログに記録する。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/joho/godotenv"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/infrastructure/config"
+	"go-banking-api/infrastructure/database"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+const usage = `usage: admin <command> [arguments]
+
+commands:
+  create -name <name> -scopes <scope,...> [-id <client-id>] [-rate-limit-per-minute n] [-rate-limit-burst n]
+  list
+  update-scopes <client-id> <scope,...>
+  update-redirect-uris <client-id> <uri,...>
+  update-jwks <client-id> <jwks-file>
+  update-grant-types <client-id> <grant-type,...>
+  set-fapi <client-id> <true|false>
+  set-sandbox <client-id> <true|false>
+  disable <client-id>
+  enable <client-id>
+  delete <client-id>
+  issue-token <client-id> <cif-no>
+  secrets <client-id>
+  add-secret <client-id>
+  retire-secret [-after <duration>] <client-id> <secret-id>`
+
+func main() {
+	if pkg.GetEnvDefault("APP_ENV", "development") == "development" {
+		if err := godotenv.Load(".env.development"); err != nil {
+			logger.Warn("Error loading .env.development file")
+		}
+	}
+
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := logger.Setup(cfg.IsDevelopment(), cfg.Log); err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer logger.Sync()
+
+	if err := run(&cfg.Database, os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dbConfig *database.Config, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+	// インメモリのストレージはプロセスごとに別物なので、サーバーのクライアントを変更できない
+	if dbConfig.Driver == config.DriverMemory {
+		return errors.New("admin requires a SQL database driver")
+	}
+	db, err := database.Open(dbConfig)
+	if err != nil {
+		return err
+	}
+	transactionManager := gateway.NewTransactionManager(db)
+	admin := usecase.NewClientAdminUsecase(transactionManager, nil)
+	secrets := usecase.NewClientSecretUsecase(transactionManager, nil)
+	ctx := context.Background()
+
+	switch args[0] {
+	case "create":
+		return runCreate(ctx, admin, args[1:], out)
+	case "list":
+		return runList(ctx, admin, out)
+	case "update-scopes":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		return admin.UpdateScopes(ctx, args[1], splitScopes(args[2]))
+	case "update-redirect-uris":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		return admin.UpdateRedirectURIs(ctx, args[1], strings.Split(args[2], ","))
+	case "update-jwks":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		jwks, err := os.ReadFile(args[2])
+		if err != nil {
+			return err
+		}
+		return admin.UpdateJWKS(ctx, args[1], jwks)
+	case "update-grant-types":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		return admin.UpdateGrantTypes(ctx, args[1], strings.Split(args[2], ","))
+	case "set-fapi":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		fapi, err := strconv.ParseBool(args[2])
+		if err != nil {
+			return errors.New(usage)
+		}
+		return admin.SetFAPI(ctx, args[1], fapi)
+	case "set-sandbox":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		sandbox, err := strconv.ParseBool(args[2])
+		if err != nil {
+			return errors.New(usage)
+		}
+		return admin.SetSandbox(ctx, args[1], sandbox)
+	case "disable":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		revoked, err := admin.DisableClient(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "revoked_tokens\t%d\n", revoked)
+		return nil
+	case "enable":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		return admin.EnableClient(ctx, args[1])
+	case "delete":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		revoked, err := admin.DeleteClient(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "revoked_tokens\t%d\n", revoked)
+		return nil
+	case "issue-token":
+		if len(args) != 3 {
+			return errors.New(usage)
+		}
+		cifNo, err := strconv.Atoi(args[2])
+		if err != nil {
+			return errors.New(usage)
+		}
+		token, err := admin.IssueToken(ctx, args[1], cifNo)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "access_token\t%s\nrefresh_token\t%s\nexpires_at\t%s\nscope\t%s\n",
+			token.AccessToken, token.RefreshToken, token.ExpiresAt.Format(time.RFC3339), token.Scopes)
+		return nil
+	case "secrets":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		return runSecrets(ctx, secrets, args[1], out)
+	case "add-secret":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		secret, plain, err := secrets.Add(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		// create と同じく、平文を表示するのはこの一度だけ
+		fmt.Fprintf(out, "secret_id\t%d\nclient_secret\t%s\n", secret.ID, plain)
+		return nil
+	case "retire-secret":
+		return runRetireSecret(ctx, secrets, args[1:], out)
+	default:
+		return errors.New(usage)
+	}
+}
+
+func runCreate(ctx context.Context, admin usecase.ClientAdminUsecase, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("create", flag.ContinueOnError)
+	var newClient usecase.NewClient
+	var scopes string
+	flags.StringVar(&newClient.ClientID, "id", "", "client ID (generated when empty)")
+	flags.StringVar(&newClient.ClientName, "name", "", "client name")
+	flags.StringVar(&scopes, "scopes", "", "comma separated scopes: "+strings.Join(usecase.ClientScopes, ","))
+	flags.IntVar(&newClient.RateLimitPerMinute, "rate-limit-per-minute", 0, "requests per minute (0 uses the server default)")
+	flags.IntVar(&newClient.RateLimitBurst, "rate-limit-burst", 0, "burst size (0 uses the server default)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 0 {
+		return errors.New(usage)
+	}
+	newClient.Scopes = splitScopes(scopes)
+
+	client, secret, err := admin.CreateClient(ctx, newClient)
+	if err != nil {
+		return err
+	}
+	// シークレットはハッシュしか保存しないため、表示するのはこの一度だけ
+	fmt.Fprintf(out, "client_id\t%s\nclient_secret\t%s\nscope\t%s\n", client.ClientID, secret, client.Scope)
+	return nil
+}
+
+func runList(ctx context.Context, admin usecase.ClientAdminUsecase, out io.Writer) error {
+	clients, err := admin.ListClients(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CLIENT_ID\tNAME\tSCOPE\tRATE_LIMIT\tFAPI\tSANDBOX\tDISABLED_AT")
+	for _, client := range clients {
+		disabledAt := "-"
+		if client.IsDisabled() {
+			disabledAt = client.DisabledAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d/%d\t%t\t%t\t%s\n",
+			client.ClientID, client.ClientName, client.Scope, client.RateLimitPerMinute, client.RateLimitBurst, client.FAPI, client.Sandbox, disabledAt)
+	}
+	return w.Flush()
+}
+
+func runSecrets(ctx context.Context, secrets usecase.ClientSecretUsecase, clientID string, out io.Writer) error {
+	list, err := secrets.List(ctx, clientID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SECRET_ID\tCREATED_AT\tNOT_AFTER\tACTIVE")
+	for _, secret := range list {
+		notAfter := "-"
+		if secret.NotAfter != nil {
+			notAfter = secret.NotAfter.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%t\n", secret.ID, secret.CreatedAt.Format(time.RFC3339), notAfter, secret.IsActive(now))
+	}
+	return w.Flush()
+}
+
+func runRetireSecret(ctx context.Context, secrets usecase.ClientSecretUsecase, args []string, out io.Writer) error {
+	flags := flag.NewFlagSet("retire-secret", flag.ContinueOnError)
+	after := flags.Duration("after", 0, "keep the secret valid for this duration (0 retires it immediately)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 || *after < 0 {
+		return errors.New(usage)
+	}
+	secretID, err := strconv.ParseInt(flags.Arg(1), 10, 64)
+	if err != nil {
+		return errors.New(usage)
+	}
+	var notAfter time.Time
+	if *after > 0 {
+		notAfter = time.Now().Add(*after)
+	}
+
+	secret, err := secrets.Retire(ctx, flags.Arg(0), secretID, notAfter)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "secret_id\t%d\nnot_after\t%s\n", secret.ID, secret.NotAfter.Format(time.RFC3339))
+	return nil
+}
+
+// splitScopes は "a,b" や "a b" の形式を受け付ける
+func splitScopes(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/usecase"
+)
+
+const auditUsage = "usage: server audit verify [anchor-hash]"
+
+func runAudit(repos gateway.Repositories, args []string) error {
+	if len(args) == 0 || args[0] != "verify" || len(args) > 2 {
+		return errors.New(auditUsage)
+	}
+	anchorHash := ""
+	if len(args) > 1 {
+		anchorHash = args[1]
+	}
+
+	result, err := usecase.NewAuditUsecase(repos.Audit(), nil).Verify(context.Background(), anchorHash)
+	if result != nil {
+		fmt.Printf("events\t%d\nlast_id\t%d\nlast_hash\t%s\n", result.Count, result.LastID, result.LastHash)
+	}
+	return err
+}
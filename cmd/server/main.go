@@ -13,10 +13,12 @@ import (
 
 	"github.com/joho/godotenv"
 
-	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/config"
 	"go-banking-api/infrastructure/web"
 	"go-banking-api/pkg"
+	"go-banking-api/pkg/health"
 	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/tracing"
 )
 
 func main() {
@@ -28,12 +30,65 @@ func main() {
 		}
 	}
 
-	db, err := database.NewDatabaseSQLFactory(database.InstanceMySQL)
+	cfg, err := config.Load(os.Getenv("CONFIG_FILE"))
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
+	if err := logger.Setup(cfg.IsDevelopment(), cfg.Log); err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		// 読み込んだ設定を確認する。パスワードなどは伏せて出力する。
+		if err := cfg.WriteRedacted(os.Stdout); err != nil {
+			logger.Fatal(err.Error())
+		}
+		return
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing.Exporter)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	storage, err := newStorage(cfg)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		defer logger.Sync()
+		if storage.db == nil {
+			logger.Fatal("migrate requires a SQL database driver")
+		}
+		if err := runMigrate(storage.db, os.Args[2:]); err != nil {
+			logger.Fatal(err.Error())
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "audit" {
+		defer logger.Sync()
+		if err := runAudit(storage.repos, os.Args[2:]); err != nil {
+			logger.Fatal(err.Error())
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "unlock" {
+		defer logger.Sync()
+		if err := runUnlock(storage.repos, os.Args[2:]); err != nil {
+			logger.Fatal(err.Error())
+		}
+		return
+	}
 
-	server, err := web.NewServer(db)
+	readiness := health.NewReadiness(cfg.App.ReadinessCheckTimeout)
+	if err := storage.addReadinessChecks(readiness); err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	server, err := web.NewServer(&cfg.Web, cfg.RouterConfig(), storage.repos, storage.transactionManager, readiness)
 	if err != nil {
 		logger.Fatal(err.Error())
 	}
@@ -50,10 +105,17 @@ func main() {
 	log.Println("Shutdown Server ...")
 	defer logger.Sync()
 
+	// 先に /readyz を失敗させ、ロードバランサーが振り分けをやめるのを待ってから受け付けを止める
+	readiness.ShutDown()
+	time.Sleep(cfg.App.ShutdownDelay)
+
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 	defer cancel()
 	if err := server.Shutdown(ctx); err != nil {
 		logger.Error(fmt.Sprintf("Server Shutdown: %s", err.Error()))
 	}
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error(fmt.Sprintf("Tracing Shutdown: %s", err.Error()))
+	}
 	<-ctx.Done()
 }
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/infrastructure/config"
+	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/database/migration"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/health"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/usecase"
+)
+
+type storage struct {
+	repos              gateway.Repositories
+	transactionManager usecase.TransactionManager
+	// db は DB_DRIVER=memory の場合 nil
+	db *gorm.DB
+	// tokenCache は TOKEN_CACHE_SIZE=0 の場合 nil
+	tokenCache *gateway.TokenCache
+}
+
+// newStorage は DB_DRIVER に応じたリポジトリとトランザクションマネージャを返す。
+// DB_DRIVER=memory の場合は DB に接続せず、デモ用シードデータを入れたインメモリストアを使う。
+func newStorage(cfg *config.Config) (*storage, error) {
+	s, err := openStorage(&cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.TokenCache.Size > 0 {
+		s.tokenCache = gateway.NewTokenCache(
+			cfg.TokenCache.Size,
+			cfg.TokenCache.TTL,
+			cfg.TokenCache.NegativeTTL,
+			pkg.RealClock{},
+		)
+		s.repos = gateway.NewCachedRepositories(s.repos, s.tokenCache)
+		s.transactionManager = gateway.NewCachedTransactionManager(s.transactionManager, s.tokenCache)
+		if err := metrics.RegisterCache("token", func() metrics.CacheStats {
+			return metrics.CacheStats(s.tokenCache.Stats())
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.db != nil {
+		sqlDB, err := s.db.DB()
+		if err != nil {
+			return nil, err
+		}
+		if err := metrics.RegisterDBStats(sqlDB, "primary"); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func openStorage(dbConfig *database.Config) (*storage, error) {
+	if dbConfig.Driver == config.DriverMemory {
+		store := inmemory.NewStore()
+		if err := inmemory.Seed(store, pkg.RealClock{}); err != nil {
+			return nil, err
+		}
+		logger.Warn("using in-memory storage with demo seed data; data is lost on shutdown",
+			"client_id", inmemory.SeedClientID)
+		return &storage{repos: store, transactionManager: inmemory.NewTransactionManager(store)}, nil
+	}
+
+	db, err := database.Open(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &storage{
+		repos:              gateway.NewRepositories(db),
+		transactionManager: gateway.NewTransactionManager(db),
+		db:                 db,
+	}, nil
+}
+
+// addReadinessChecks は DB への疎通とマイグレーションの適用状況を readiness に登録する。
+// DB_DRIVER=memory の場合は確認するものがない。
+func (s *storage) addReadinessChecks(readiness *health.Readiness) error {
+	if s.db == nil {
+		return nil
+	}
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		return err
+	}
+	migrator, err := migration.NewMigrator(s.db)
+	if err != nil {
+		return err
+	}
+	readiness.Add("database", sqlDB.PingContext)
+	readiness.Add("migrations", migrator.CheckCurrent)
+	return nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
+)
+
+const unlockUsage = "usage: server unlock [client <client-id> | ip <address>]"
+
+func runUnlock(repos gateway.Repositories, args []string) error {
+	if len(args) != 2 {
+		return errors.New(unlockUsage)
+	}
+	clientUsecase := usecase.NewClientUsecase(repos.Client(), repos.AuthLockout(), repos.Audit(), usecase.DefaultLockoutPolicy, nil)
+
+	switch args[0] {
+	case "client":
+		if err := clientUsecase.UnlockClient(context.Background(), args[1]); err != nil {
+			return err
+		}
+		logger.Info("unlocked client", "client_id", args[1])
+		return nil
+	case "ip":
+		if net.ParseIP(args[1]) == nil {
+			return errors.New(unlockUsage)
+		}
+		if err := clientUsecase.UnlockSourceIP(context.Background(), args[1]); err != nil {
+			return err
+		}
+		logger.Info("unlocked source ip", "source_ip", args[1])
+		return nil
+	default:
+		return errors.New(unlockUsage)
+	}
+}
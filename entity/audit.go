@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AuditOperation string
+
+const (
+	AuditOperationTokenRefresh       AuditOperation = "token.refresh"
+	AuditOperationClientAuthenticate AuditOperation = "client.authenticate"
+	AuditOperationClientLockout      AuditOperation = "client.lockout"
+	AuditOperationClientUnlock       AuditOperation = "client.unlock"
+	AuditOperationClientCreate       AuditOperation = "client.create"
+	AuditOperationClientRegister     AuditOperation = "client.register"
+	AuditOperationClientUpdate       AuditOperation = "client.update"
+	AuditOperationClientDisable      AuditOperation = "client.disable"
+	AuditOperationClientEnable       AuditOperation = "client.enable"
+	AuditOperationClientDelete       AuditOperation = "client.delete"
+	AuditOperationClientSecretAdd    AuditOperation = "client.secret.add"
+	AuditOperationClientSecretRetire AuditOperation = "client.secret.retire"
+	AuditOperationTokenIssue         AuditOperation = "token.issue"
+	AuditOperationAccountRead        AuditOperation = "account.read"
+	AuditOperationUserInfoRead       AuditOperation = "userinfo.read"
+	AuditOperationAuditRead          AuditOperation = "audit.read"
+	AuditOperationConsentGrant       AuditOperation = "consent.grant"
+	AuditOperationConsentRevoke      AuditOperation = "consent.revoke"
+)
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditEvent は監査ログの 1 件。直前のイベントの Hash を PrevHash に持つハッシュチェーンになっている。
+// 先頭のイベントの PrevHash は空文字列。
+type AuditEvent struct {
+	ID         int64 `gorm:"primaryKey"`
+	OccurredAt time.Time
+	Operation  AuditOperation
+	Outcome    AuditOutcome
+	Reason     string
+	ClientID   string
+	CifNo      int // 顧客が特定できない場合は 0
+	RequestID  string
+	PrevHash   string
+	Hash       string
+}
+
+// ComputeHash は ID と Hash 以外の項目から SHA-256 を計算する。
+// DB によって時刻の精度が異なるため、OccurredAt はマイクロ秒に切り捨てた UTC で扱う。
+func (e *AuditEvent) ComputeHash() string {
+	fields := []string{
+		e.PrevHash,
+		e.OccurredAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		string(e.Operation),
+		string(e.Outcome),
+		e.Reason,
+		e.ClientID,
+		strconv.Itoa(e.CifNo),
+		e.RequestID,
+	}
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+// Chain は prevHash の次のイベントとして PrevHash と Hash を設定する
+func (e *AuditEvent) Chain(prevHash string) {
+	e.OccurredAt = e.OccurredAt.UTC().Truncate(time.Microsecond)
+	e.PrevHash = prevHash
+	e.Hash = e.ComputeHash()
+}
@@ -0,0 +1,58 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/entity"
+)
+
+func TestAuditEventChain(t *testing.T) {
+	first := entity.AuditEvent{
+		OccurredAt: time.Date(2025, 12, 2, 10, 0, 0, 123456789, time.FixedZone("JST", 9*60*60)),
+		Operation:  entity.AuditOperationAccountRead,
+		Outcome:    entity.AuditOutcomeSuccess,
+		ClientID:   "client-1",
+		CifNo:      1,
+		RequestID:  "req-1",
+	}
+	first.Chain("")
+
+	assert.Equal(t, time.UTC, first.OccurredAt.Location())
+	assert.Equal(t, 123456000, first.OccurredAt.Nanosecond())
+	assert.Empty(t, first.PrevHash)
+	assert.Len(t, first.Hash, 64)
+	assert.Equal(t, first.Hash, first.ComputeHash())
+
+	second := first
+	second.RequestID = "req-2"
+	second.Chain(first.Hash)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.NotEqual(t, first.Hash, second.Hash)
+}
+
+func TestAuditEventComputeHashDetectsTampering(t *testing.T) {
+	event := entity.AuditEvent{
+		OccurredAt: time.Date(2025, 12, 2, 10, 0, 0, 0, time.UTC),
+		Operation:  entity.AuditOperationTokenRefresh,
+		Outcome:    entity.AuditOutcomeFailure,
+		Reason:     "invalid_refresh_token",
+		ClientID:   "client-1",
+	}
+	event.Chain("")
+
+	tampered := event
+	tampered.Outcome = entity.AuditOutcomeSuccess
+	assert.NotEqual(t, event.Hash, tampered.ComputeHash())
+
+	tampered = event
+	tampered.CifNo = 2
+	assert.NotEqual(t, event.Hash, tampered.ComputeHash())
+
+	// 時刻の表現（タイムゾーン）が変わってもハッシュは変わらない
+	moved := event
+	moved.OccurredAt = event.OccurredAt.In(time.FixedZone("JST", 9*60*60))
+	assert.Equal(t, event.Hash, moved.ComputeHash())
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+type AuthorizationCodeStatus string
+
+const (
+	AuthorizationCodeStatusPending  AuthorizationCodeStatus = "pending"
+	AuthorizationCodeStatusApproved AuthorizationCodeStatus = "approved"
+	AuthorizationCodeStatusDenied   AuthorizationCodeStatus = "denied"
+	// AuthorizationCodeStatusExchanged はトークンと交換済み。再利用を検知できるよう、期限までは残す。
+	AuthorizationCodeStatusExchanged AuthorizationCodeStatus = "exchanged"
+)
+
+// AuthorizationCode は認可コードフローの認可。/authorize で PAR の認可リクエストから作成し、
+// 顧客が銀行のアプリで ID のものを承認すると、クライアントは Code を一度だけトークンと交換できる。
+type AuthorizationCode struct {
+	// ID は同意画面に渡す識別子。認可コードとは別の値にし、承認前の認可コードを同意画面に渡さない。
+	ID string `gorm:"primaryKey"`
+	// Code は作成時に生成するが、承認するまでクライアントには渡さない
+	Code        string
+	ClientID    string
+	RedirectURI string `gorm:"column:redirect_uri"`
+	// Scope は要求されたスコープ（スペース区切り）
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Status              AuthorizationCodeStatus
+	// CifNo は承認・拒否した顧客。pending の間は 0。
+	CifNo     int
+	CreatedAt time.Time
+	// ExpiresAt は承認するまでは同意画面の期限、承認後は認可コードの期限、交換後は再利用を検知する期限
+	ExpiresAt time.Time
+}
+
+func (a *AuthorizationCode) IsExpired(now time.Time) bool {
+	return !now.Before(a.ExpiresAt)
+}
+
+func (a *AuthorizationCode) Scopes() []string {
+	return strings.Fields(a.Scope)
+}
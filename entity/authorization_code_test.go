@@ -0,0 +1,19 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/entity"
+)
+
+func TestAuthorizationCode(t *testing.T) {
+	now := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	code := entity.AuthorizationCode{Scope: "openid read:account_and_transactions", ExpiresAt: now.Add(time.Minute)}
+
+	assert.False(t, code.IsExpired(now))
+	assert.True(t, code.IsExpired(code.ExpiresAt))
+	assert.Equal(t, []string{"openid", "read:account_and_transactions"}, code.Scopes())
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// PushedAuthorizationRequest は PAR（RFC 9126）で事前に送られた認可リクエスト。
+// 検証済みのパラメーターを保存し、request_uri で一度だけ参照できる。
+type PushedAuthorizationRequest struct {
+	RequestURI   string `gorm:"primaryKey;column:request_uri"`
+	ClientID     string
+	ResponseType string
+	RedirectURI  string `gorm:"column:redirect_uri"`
+	// Scope は要求されたスコープ（スペース区切り）
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	CreatedAt           time.Time
+	ExpiresAt           time.Time
+}
+
+func (r *PushedAuthorizationRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+func (r *PushedAuthorizationRequest) Scopes() []string {
+	return strings.Fields(r.Scope)
+}
@@ -1,8 +1,109 @@
 package entity
 
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
 type Client struct {
-	ClientID     string `gorm:"primaryKey"`
-	ClientSecret string
-	ClientName   string
-	Scope        string
+	ClientID   string `gorm:"primaryKey"`
+	ClientName string
+	Scope      string
+	// Secrets はローテーション中の古いシークレットや失効済みのものも含む（id 順）
+	Secrets []ClientSecret `gorm:"foreignKey:ClientID"`
+	// RateLimitPerMinute / RateLimitBurst は 0 の場合サーバーの既定値を使う
+	RateLimitPerMinute int
+	RateLimitBurst     int
+	// DisabledAt は無効にした日時。nil の場合は有効。
+	DisabledAt *time.Time
+	// 以下は動的クライアント登録（RFC 7591）のメタデータ。複数の値はスペース区切り。
+	RedirectURIs            string `gorm:"column:redirect_uris"`
+	GrantTypes              string
+	TokenEndpointAuthMethod string
+	// RegistrationAccessTokenHash は設定エンドポイント（RFC 7592）で使うトークンの SHA-256。
+	// 管理コマンドで登録したクライアントは空で、設定エンドポイントからは操作できない。
+	RegistrationAccessTokenHash string
+	// JWKS はリクエストオブジェクトの署名を検証する公開鍵（JWK Set の JSON）。空の場合は署名付きのリクエストを受け付けない。
+	JWKS string `gorm:"column:jwks"`
+	// FAPI が true のクライアントは、認可リクエストを署名付きのリクエストオブジェクトで送る必要がある
+	FAPI bool `gorm:"column:fapi"`
+	// Sandbox が true のクライアントは、テスト用の顧客（Customer.Sandbox）からしか同意を得られない。
+	// 動的クライアント登録で作ったクライアントは Sandbox になる。
+	Sandbox   bool
+	CreatedAt time.Time
+}
+
+const (
+	GrantTypeRefreshToken = "refresh_token"
+	// GrantTypeAuthorizationCode は PAR と /authorize を使う認可コードフロー
+	GrantTypeAuthorizationCode = "authorization_code"
+	// GrantTypeDeviceCode はデバイス認可グラント（RFC 8628）
+	GrantTypeDeviceCode = "urn:ietf:params:oauth:grant-type:device_code"
+
+	TokenEndpointAuthClientSecretBasic = "client_secret_basic"
+)
+
+// ClientSecret はクライアントシークレットのハッシュ。ローテーション中は複数が同時に有効になる。
+type ClientSecret struct {
+	ID         int64 `gorm:"primaryKey"`
+	ClientID   string
+	SecretHash string
+	CreatedAt  time.Time
+	// NotAfter 以降は認証に使えない。nil の場合は期限なし。
+	NotAfter *time.Time
+}
+
+func (s *ClientSecret) IsActive(now time.Time) bool {
+	return s.NotAfter == nil || now.Before(*s.NotAfter)
+}
+
+// ActiveSecrets は now の時点で認証に使えるシークレットを返す
+func (c *Client) ActiveSecrets(now time.Time) []ClientSecret {
+	var secrets []ClientSecret
+	for _, secret := range c.Secrets {
+		if secret.IsActive(now) {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets
+}
+
+func (c *Client) IsDisabled() bool {
+	return c.DisabledAt != nil
+}
+
+// Scopes は Scope（スペース区切り）を分割して返す
+func (c *Client) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+func (c *Client) RedirectURIList() []string {
+	return strings.Fields(c.RedirectURIs)
+}
+
+func (c *Client) GrantTypeList() []string {
+	return strings.Fields(c.GrantTypes)
+}
+
+// AllowsGrantType は grantType がクライアントに許可されているかどうか
+func (c *Client) AllowsGrantType(grantType string) bool {
+	return slices.Contains(c.GrantTypeList(), grantType)
+}
+
+// IsDynamicallyRegistered は RFC 7591 の登録エンドポイントから登録したクライアントかどうか
+func (c *Client) IsDynamicallyRegistered() bool {
+	return c.RegistrationAccessTokenHash != ""
+}
+
+// RateLimit はクライアントごとの設定を defaultLimit に上書きしたレート制限を返す
+func (c *Client) RateLimit(defaultLimit RateLimit) RateLimit {
+	limit := defaultLimit
+	if c.RateLimitPerMinute > 0 {
+		limit.PerMinute = c.RateLimitPerMinute
+	}
+	if c.RateLimitBurst > 0 {
+		limit.Burst = c.RateLimitBurst
+	}
+	return limit
 }
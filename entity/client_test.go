@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -10,14 +11,56 @@ import (
 
 func TestClient(t *testing.T) {
 	client := entity.Client{
-		ClientID:     "client-123",
-		ClientSecret: "secret-xyz",
-		ClientName:   "Test Client",
-		Scope:        "read:account_and_transactions",
+		ClientID:   "client-123",
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
 	}
 
 	assert.Equal(t, "client-123", client.ClientID)
-	assert.Equal(t, "secret-xyz", client.ClientSecret)
 	assert.Equal(t, "Test Client", client.ClientName)
 	assert.Equal(t, "read:account_and_transactions", client.Scope)
 }
+
+func TestClientAllowsGrantType(t *testing.T) {
+	client := entity.Client{GrantTypes: "refresh_token " + entity.GrantTypeDeviceCode}
+	assert.True(t, client.AllowsGrantType(entity.GrantTypeDeviceCode))
+	assert.False(t, (&entity.Client{GrantTypes: "refresh_token"}).AllowsGrantType(entity.GrantTypeDeviceCode))
+}
+
+func TestClientActiveSecrets(t *testing.T) {
+	now := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	expired := now
+	expiring := now.Add(time.Hour)
+	client := entity.Client{Secrets: []entity.ClientSecret{
+		{ID: 1, NotAfter: &expired},
+		{ID: 2, NotAfter: &expiring},
+		{ID: 3},
+	}}
+
+	// not_after ちょうどの時点で使えなくなる
+	active := client.ActiveSecrets(now)
+	assert.Len(t, active, 2)
+	assert.Equal(t, int64(2), active[0].ID)
+	assert.Equal(t, int64(3), active[1].ID)
+	assert.Len(t, client.ActiveSecrets(expiring), 1)
+}
+
+func TestClientRateLimit(t *testing.T) {
+	defaultLimit := entity.RateLimit{PerMinute: 600, Burst: 100}
+
+	client := entity.Client{ClientID: "client-123"}
+	assert.Equal(t, defaultLimit, client.RateLimit(defaultLimit))
+
+	client.RateLimitPerMinute = 60
+	assert.Equal(t, entity.RateLimit{PerMinute: 60, Burst: 100}, client.RateLimit(defaultLimit))
+
+	client.RateLimitBurst = 10
+	assert.Equal(t, entity.RateLimit{PerMinute: 60, Burst: 10}, client.RateLimit(defaultLimit))
+}
+
+func TestRateLimitCapacity(t *testing.T) {
+	assert.Equal(t, 10, entity.RateLimit{PerMinute: 60, Burst: 10}.Capacity())
+	assert.Equal(t, 60, entity.RateLimit{PerMinute: 60}.Capacity())
+	assert.True(t, entity.RateLimit{PerMinute: 60}.Enabled())
+	assert.False(t, entity.RateLimit{}.Enabled())
+}
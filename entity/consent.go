@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Consent は顧客がクライアントに与えた同意。顧客とクライアントの組ごとに 1 件で、同意し直すと上書きする。
+type Consent struct {
+	ID       int64 `gorm:"primaryKey"`
+	CifNo    int
+	ClientID string
+	// Scope は同意したスコープ（スペース区切り）
+	Scope string
+	// AccountIDs は参照を許可した口座の ID（スペース区切り）
+	AccountIDs string
+	CreatedAt  time.Time
+	// ExpiresAt 以降はリフレッシュトークンを使えず、同意し直す必要がある
+	ExpiresAt time.Time
+	// RevokedAt は顧客が取り消した日時。nil の場合は取り消していない。
+	RevokedAt *time.Time
+}
+
+// ConsentTTL は同意の有効期間。期限が切れたら顧客に同意し直してもらう（90 日ごとの再同意）。
+const ConsentTTL = 90 * 24 * time.Hour
+
+type ConsentStatus string
+
+const (
+	ConsentStatusActive  ConsentStatus = "active"
+	ConsentStatusExpired ConsentStatus = "expired"
+	ConsentStatusRevoked ConsentStatus = "revoked"
+)
+
+// Status は now の時点の状態を返す。取り消した同意は期限にかかわらず revoked とする。
+func (c *Consent) Status(now time.Time) ConsentStatus {
+	switch {
+	case c.RevokedAt != nil:
+		return ConsentStatusRevoked
+	case !now.Before(c.ExpiresAt):
+		return ConsentStatusExpired
+	default:
+		return ConsentStatusActive
+	}
+}
+
+func (c *Consent) IsActive(now time.Time) bool {
+	return c.Status(now) == ConsentStatusActive
+}
+
+func (c *Consent) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// AccountIDList は AccountIDs を分割して返す。数値でない値は無視する。
+func (c *Consent) AccountIDList() []int {
+	var ids []int
+	for _, field := range strings.Fields(c.AccountIDs) {
+		if id, err := strconv.Atoi(field); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,30 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/entity"
+)
+
+func TestConsentStatus(t *testing.T) {
+	now := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	consent := entity.Consent{ExpiresAt: now.Add(time.Hour)}
+
+	assert.Equal(t, entity.ConsentStatusActive, consent.Status(now))
+	assert.True(t, consent.IsActive(now))
+	// expires_at ちょうどの時点で期限切れになる
+	assert.Equal(t, entity.ConsentStatusExpired, consent.Status(consent.ExpiresAt))
+
+	consent.RevokedAt = &now
+	assert.Equal(t, entity.ConsentStatusRevoked, consent.Status(now))
+	assert.Equal(t, entity.ConsentStatusRevoked, consent.Status(consent.ExpiresAt))
+	assert.False(t, consent.IsActive(now))
+}
+
+func TestConsentAccountIDList(t *testing.T) {
+	assert.Equal(t, []int{1, 3}, (&entity.Consent{AccountIDs: "1 3"}).AccountIDList())
+	assert.Empty(t, (&entity.Consent{}).AccountIDList())
+}
@@ -16,4 +16,6 @@ type Customer struct {
 	Email      string
 	Phone      string
 	CreatedAt  time.Time
+	// Sandbox はサンドボックスのクライアントから使えるテスト用の顧客
+	Sandbox bool
 }
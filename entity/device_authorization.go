@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+type DeviceAuthorizationStatus string
+
+const (
+	DeviceAuthorizationStatusPending  DeviceAuthorizationStatus = "pending"
+	DeviceAuthorizationStatusApproved DeviceAuthorizationStatus = "approved"
+	DeviceAuthorizationStatusDenied   DeviceAuthorizationStatus = "denied"
+)
+
+// DeviceAuthorization はデバイス認可グラント（RFC 8628）の認可リクエスト。
+// デバイスは DeviceCode で /token をポーリングし、顧客は UserCode を入力して承認・拒否する。
+type DeviceAuthorization struct {
+	DeviceCode string `gorm:"primaryKey"`
+	// UserCode は区切りを除いた大文字の値で保存する
+	UserCode string
+	ClientID string
+	// Scope は要求されたスコープ（スペース区切り）
+	Scope  string
+	Status DeviceAuthorizationStatus
+	// CifNo は承認・拒否した顧客。pending の間は 0。
+	CifNo int
+	// Interval はポーリングの間隔（秒）。間隔より短くポーリングされるたびに延ばす。
+	Interval int `gorm:"column:polling_interval"`
+	// LastPolledAt は最後にポーリングされた日時。nil の場合はまだポーリングされていない。
+	LastPolledAt *time.Time
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func (d *DeviceAuthorization) IsExpired(now time.Time) bool {
+	return !now.Before(d.ExpiresAt)
+}
+
+func (d *DeviceAuthorization) Scopes() []string {
+	return strings.Fields(d.Scope)
+}
+
+// PollingInterval は Interval を time.Duration で返す
+func (d *DeviceAuthorization) PollingInterval() time.Duration {
+	return time.Duration(d.Interval) * time.Second
+}
+
+// FormattedUserCode は顧客に表示するため、ユーザーコードを 4 文字ずつハイフンで区切って返す
+func (d *DeviceAuthorization) FormattedUserCode() string {
+	if len(d.UserCode) <= 4 {
+		return d.UserCode
+	}
+	return d.UserCode[:4] + "-" + d.UserCode[4:]
+}
@@ -0,0 +1,20 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/entity"
+)
+
+func TestDeviceAuthorization(t *testing.T) {
+	now := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	authorization := entity.DeviceAuthorization{UserCode: "BCDFGHJK", Interval: 5, ExpiresAt: now.Add(time.Minute)}
+
+	assert.False(t, authorization.IsExpired(now))
+	assert.True(t, authorization.IsExpired(authorization.ExpiresAt))
+	assert.Equal(t, "BCDF-GHJK", authorization.FormattedUserCode())
+	assert.Equal(t, 5*time.Second, authorization.PollingInterval())
+}
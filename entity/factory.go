@@ -1,10 +0,0 @@
-package entity
-
-func NewDomains() []interface{} {
-	return []interface{}{
-		&Customer{},
-		&Account{},
-		&Token{},
-		&Client{},
-	}
-}
@@ -0,0 +1,68 @@
+package entity
+
+import "time"
+
+// LockoutPolicy は認証失敗によるロックの設定
+type LockoutPolicy struct {
+	// Threshold 回続けて失敗するとロックする
+	Threshold int
+	// LockDuration は最初のロックの長さ。以降は失敗のたびに倍にし、MaxLockDuration で打ち切る。
+	LockDuration    time.Duration
+	MaxLockDuration time.Duration
+	// ResetAfter は最後の失敗（またはロックの解除）からこの時間が経つと失敗回数を数え直す
+	ResetAfter time.Duration
+	// DelayStep は失敗 1 回ごとに応答を遅らせる時間。MaxDelay で打ち切る。
+	DelayStep time.Duration
+	MaxDelay  time.Duration
+}
+
+// Delay は failures 回失敗した後の応答の遅延
+func (p LockoutPolicy) Delay(failures int) time.Duration {
+	delay := time.Duration(failures) * p.DelayStep
+	if delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// AuthLockout はクライアント ID または接続元 IP ごとの認証失敗の記録
+type AuthLockout struct {
+	Key           string `gorm:"column:lockout_key;primaryKey"`
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   time.Time
+}
+
+func (l *AuthLockout) IsLocked(now time.Time) bool {
+	return now.Before(l.LockedUntil)
+}
+
+// RegisterFailure は失敗を 1 回数え、このときに新たにロックした場合は true を返す
+func (l *AuthLockout) RegisterFailure(now time.Time, policy LockoutPolicy) bool {
+	lastActivity := l.LastFailureAt
+	if l.LockedUntil.After(lastActivity) {
+		lastActivity = l.LockedUntil
+	}
+	if now.Sub(lastActivity) >= policy.ResetAfter {
+		l.Failures = 0
+	}
+	l.Failures++
+	l.LastFailureAt = now
+	if l.LockedUntil.IsZero() {
+		// ゼロ値を保存できない DB があるため、ロックしていない状態は過去の時刻で表す
+		l.LockedUntil = now
+	}
+
+	if policy.Threshold <= 0 || l.Failures < policy.Threshold || l.IsLocked(now) {
+		return false
+	}
+	duration := policy.LockDuration
+	for i := policy.Threshold; i < l.Failures && duration < policy.MaxLockDuration; i++ {
+		duration *= 2
+	}
+	if duration > policy.MaxLockDuration {
+		duration = policy.MaxLockDuration
+	}
+	l.LockedUntil = now.Add(duration)
+	return true
+}
@@ -0,0 +1,67 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/entity"
+)
+
+var testLockoutPolicy = entity.LockoutPolicy{
+	Threshold:       3,
+	LockDuration:    time.Minute,
+	MaxLockDuration: 4 * time.Minute,
+	ResetAfter:      15 * time.Minute,
+	DelayStep:       100 * time.Millisecond,
+	MaxDelay:        250 * time.Millisecond,
+}
+
+func TestAuthLockoutLocksAfterThreshold(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lockout := entity.AuthLockout{Key: "client:client-1"}
+
+	assert.False(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.False(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.False(t, lockout.IsLocked(now))
+
+	assert.True(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.True(t, lockout.IsLocked(now))
+	assert.Equal(t, now.Add(time.Minute), lockout.LockedUntil)
+
+	// ロック中の失敗ではロックを延ばさない
+	assert.False(t, lockout.RegisterFailure(now.Add(30*time.Second), testLockoutPolicy))
+	assert.Equal(t, now.Add(time.Minute), lockout.LockedUntil)
+	assert.False(t, lockout.IsLocked(now.Add(time.Minute)))
+}
+
+func TestAuthLockoutDoublesLockDuration(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lockout := entity.AuthLockout{Key: "ip:192.0.2.1", Failures: 3, LastFailureAt: now, LockedUntil: now}
+
+	assert.True(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.Equal(t, now.Add(2*time.Minute), lockout.LockedUntil)
+
+	now = lockout.LockedUntil
+	assert.True(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.Equal(t, now.Add(4*time.Minute), lockout.LockedUntil)
+
+	now = lockout.LockedUntil
+	assert.True(t, lockout.RegisterFailure(now, testLockoutPolicy))
+	assert.Equal(t, now.Add(4*time.Minute), lockout.LockedUntil)
+}
+
+func TestAuthLockoutResetsAfterQuietPeriod(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lockout := entity.AuthLockout{Key: "client:client-1", Failures: 2, LastFailureAt: now}
+
+	assert.False(t, lockout.RegisterFailure(now.Add(15*time.Minute), testLockoutPolicy))
+	assert.Equal(t, 1, lockout.Failures)
+}
+
+func TestLockoutPolicyDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), testLockoutPolicy.Delay(0))
+	assert.Equal(t, 200*time.Millisecond, testLockoutPolicy.Delay(2))
+	assert.Equal(t, 250*time.Millisecond, testLockoutPolicy.Delay(10))
+}
@@ -0,0 +1,20 @@
+package entity
+
+// RateLimit はトークンバケットの設定。1 分あたり PerMinute 回補充し、最大 Burst 回まで連続で受け付ける。
+type RateLimit struct {
+	PerMinute int
+	Burst     int
+}
+
+// Enabled は PerMinute が 0 以下の場合 false を返す
+func (l RateLimit) Enabled() bool {
+	return l.PerMinute > 0
+}
+
+// Capacity はバケットの容量。Burst が未指定の場合は PerMinute と同じにする。
+func (l RateLimit) Capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return l.PerMinute
+}
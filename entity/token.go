@@ -13,6 +13,8 @@ type Token struct {
 	ExpiresAt    time.Time
 	CifNo        int
 	ClientID     string
+	// IDToken は openid スコープのトークンを発行したときの ID トークン。保存しない。
+	IDToken string `gorm:"-"`
 }
 
 func (t *Token) IsExpired(clock pkg.Clock) bool {
@@ -0,0 +1,417 @@
+// Package config はサーバーの設定をまとめて読み込む。
+//
+// 既定値、CONFIG_FILE に指定した YAML ファイル、環境変数（*_FILE を含む）の順に上書きし、起動前に検証する。
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"go-banking-api/adapter/controller/gin/router"
+	"go-banking-api/entity"
+	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/web"
+	"go-banking-api/pkg/envconfig"
+	"go-banking-api/pkg/jwt"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/tracing"
+	"go-banking-api/usecase"
+)
+
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
+
+	// DriverMemory は DB に接続せずインメモリのストレージを使う（開発・デモ用）
+	DriverMemory = "memory"
+
+	// defaultDBPassword はローカル開発用のパスワード。本番では使わせない。
+	defaultDBPassword = "password"
+)
+
+type Config struct {
+	App        AppConfig        `yaml:"app"`
+	Log        logger.Config    `yaml:"log"`
+	Web        web.Config       `yaml:"web"`
+	API        APIConfig        `yaml:"api"`
+	Database   database.Config  `yaml:"database"`
+	TokenCache TokenCacheConfig `yaml:"token_cache"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+}
+
+type AppConfig struct {
+	Env                   string        `yaml:"env" env:"APP_ENV"`
+	ReadinessCheckTimeout time.Duration `yaml:"readiness_check_timeout" env:"READINESS_CHECK_TIMEOUT"`
+	// ShutdownDelay は /readyz を失敗させてから受け付けを止めるまでの待ち時間
+	ShutdownDelay time.Duration `yaml:"shutdown_delay" env:"SHUTDOWN_DELAY"`
+}
+
+type APIConfig struct {
+	// BaseURL は API の外部公開 URL（https://bank.example.com/api/v1 など）。空の場合は api.oidc.issuer を使う。
+	BaseURL             string                    `yaml:"base_url" env:"API_BASE_URL"`
+	CorsAllowOrigins    []string                  `yaml:"cors_allow_origins" env:"CORS_ALLOW_ORIGINS"`
+	TrustedProxies      []string                  `yaml:"trusted_proxies" env:"TRUSTED_PROXIES"`
+	RateLimit           RateLimitConfig           `yaml:"rate_limit"`
+	AuthLockout         AuthLockoutConfig         `yaml:"auth_lockout"`
+	Registration        RegistrationConfig        `yaml:"registration"`
+	OIDC                OIDCConfig                `yaml:"oidc"`
+	DeviceAuthorization DeviceAuthorizationConfig `yaml:"device_authorization"`
+	Authorization       AuthorizationConfig       `yaml:"authorization"`
+}
+
+type RateLimitConfig struct {
+	PerMinute         int `yaml:"per_minute" env:"RATE_LIMIT_PER_MINUTE"`
+	Burst             int `yaml:"burst" env:"RATE_LIMIT_BURST"`
+	CustomerPerMinute int `yaml:"customer_per_minute" env:"RATE_LIMIT_CUSTOMER_PER_MINUTE"`
+	CustomerBurst     int `yaml:"customer_burst" env:"RATE_LIMIT_CUSTOMER_BURST"`
+	// SourcePerMinute / SourceBurst は認証前に確認する接続元 IP ごとの制限
+	SourcePerMinute int           `yaml:"source_per_minute" env:"RATE_LIMIT_SOURCE_PER_MINUTE"`
+	SourceBurst     int           `yaml:"source_burst" env:"RATE_LIMIT_SOURCE_BURST"`
+	ClientCacheTTL  time.Duration `yaml:"client_cache_ttl" env:"RATE_LIMIT_CLIENT_CACHE_TTL"`
+	MaxKeys         int           `yaml:"max_keys" env:"RATE_LIMIT_MAX_KEYS"`
+}
+
+type AuthLockoutConfig struct {
+	Threshold   int           `yaml:"threshold" env:"AUTH_LOCKOUT_THRESHOLD"`
+	Duration    time.Duration `yaml:"duration" env:"AUTH_LOCKOUT_DURATION"`
+	MaxDuration time.Duration `yaml:"max_duration" env:"AUTH_LOCKOUT_MAX_DURATION"`
+}
+
+// RegistrationConfig は動的クライアント登録（RFC 7591）の設定。登録は認証なしで受け付けるため既定では無効にする。
+type RegistrationConfig struct {
+	Enabled       bool     `yaml:"enabled" env:"REGISTRATION_ENABLED"`
+	AllowedScopes []string `yaml:"allowed_scopes" env:"REGISTRATION_ALLOWED_SCOPES"`
+	// RateLimitPerMinute / RateLimitBurst は接続元 IP ごとの登録の制限
+	RateLimitPerMinute int `yaml:"rate_limit_per_minute" env:"REGISTRATION_RATE_LIMIT_PER_MINUTE"`
+	RateLimitBurst     int `yaml:"rate_limit_burst" env:"REGISTRATION_RATE_LIMIT_BURST"`
+}
+
+// OIDCConfig は OpenID Connect の設定。Issuer が空の場合は ID トークンを発行しない。
+type OIDCConfig struct {
+	// Issuer は API のベース URL（https://bank.example.com/api/v1 など）。ディスカバリーはこの下に置く。
+	Issuer string `yaml:"issuer" env:"OIDC_ISSUER"`
+	// SigningKey は ID トークンに署名する RSA 秘密鍵（PEM）。OIDC_SIGNING_KEY_FILE で鍵ファイルを指定できる。
+	SigningKey string `yaml:"signing_key" env:"OIDC_SIGNING_KEY" secret:"true"`
+	// SubjectSecret は CIF 番号から sub を導く鍵。変えると sub が変わる。
+	SubjectSecret string `yaml:"subject_secret" env:"OIDC_SUBJECT_SECRET" secret:"true"`
+}
+
+// DeviceAuthorizationConfig はデバイス認可グラント（RFC 8628）の設定。VerificationURI が空の場合は無効にする。
+type DeviceAuthorizationConfig struct {
+	// VerificationURI は顧客が user_code を入力する銀行のページ（https://bank.example.com/device など）
+	VerificationURI string `yaml:"verification_uri" env:"DEVICE_VERIFICATION_URI"`
+}
+
+// AuthorizationConfig は認可コードフロー（/authorize）の設定。ConsentURI が空の場合は無効にする。
+// PAR の認可リクエストを使うため、api.oidc.issuer も必要。
+type AuthorizationConfig struct {
+	// ConsentURI は /authorize から顧客を誘導する銀行の同意画面（https://bank.example.com/consent など）
+	ConsentURI string `yaml:"consent_uri" env:"AUTHORIZATION_CONSENT_URI"`
+}
+
+// oidcSubjectSecretMinLength は sub から CIF 番号を総当たりで求められないための鍵の長さ
+const oidcSubjectSecretMinLength = 32
+
+// tokenCacheMaxTTL はキャッシュの保持時間の上限。
+// 管理コマンドによる無効化や削除は別プロセスのため、サーバーのキャッシュにはこの時間まで反映されない。
+const tokenCacheMaxTTL = time.Minute
+
+// TokenCacheConfig は Size が 0 の場合キャッシュしない
+type TokenCacheConfig struct {
+	Size        int           `yaml:"size" env:"TOKEN_CACHE_SIZE"`
+	TTL         time.Duration `yaml:"ttl" env:"TOKEN_CACHE_TTL"`
+	NegativeTTL time.Duration `yaml:"negative_ttl" env:"TOKEN_CACHE_NEGATIVE_TTL"`
+}
+
+type TracingConfig struct {
+	Exporter string `yaml:"exporter" env:"OTEL_TRACES_EXPORTER"`
+}
+
+func Default() *Config {
+	return &Config{
+		App: AppConfig{
+			Env:                   EnvDevelopment,
+			ReadinessCheckTimeout: time.Second,
+			ShutdownDelay:         5 * time.Second,
+		},
+		Web: web.DefaultConfig(),
+		API: APIConfig{
+			CorsAllowOrigins: []string{"http://0.0.0.0:8001"},
+			RateLimit: RateLimitConfig{
+				PerMinute:       600,
+				Burst:           100,
+				SourcePerMinute: 600,
+				SourceBurst:     100,
+				ClientCacheTTL:  time.Minute,
+				MaxKeys:         100000,
+			},
+			AuthLockout: AuthLockoutConfig{
+				Threshold:   usecase.DefaultLockoutPolicy.Threshold,
+				Duration:    usecase.DefaultLockoutPolicy.LockDuration,
+				MaxDuration: usecase.DefaultLockoutPolicy.MaxLockDuration,
+			},
+			Registration: RegistrationConfig{
+				AllowedScopes:      []string{usecase.AccountReadScope},
+				RateLimitPerMinute: 10,
+				RateLimitBurst:     5,
+			},
+		},
+		Database: database.DefaultConfig(),
+		TokenCache: TokenCacheConfig{
+			Size:        10000,
+			TTL:         10 * time.Second,
+			NegativeTTL: 5 * time.Second,
+		},
+		Tracing: TracingConfig{Exporter: tracing.ExporterNone},
+	}
+}
+
+// Load は path の YAML ファイル（空の場合は読まない）と環境変数で既定値を上書きし、検証した設定を返す
+func Load(path string) (*Config, error) {
+	config := Default()
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("config: %w", err)
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		// 項目名の誤りに気付けるよう、知らない項目はエラーにする
+		decoder.KnownFields(true)
+		if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("config: %s: %w", path, err)
+		}
+	}
+	if err := envconfig.Apply(config); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	if config.Database.Driver != DriverMemory {
+		config.Database.SetDriverDefaults()
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("config: %w", err)
+	}
+	return config, nil
+}
+
+// Validate は起動前に検出できる設定の誤りをまとめて返す
+func (c *Config) Validate() error {
+	var errs []error
+	// "prod" などの誤りで本番向けの検証を素通りしないよう、知らない値は受け付けない
+	switch c.App.Env {
+	case EnvDevelopment, EnvProduction:
+	case "":
+		errs = append(errs, errors.New("app.env: must be set"))
+	default:
+		errs = append(errs, fmt.Errorf("app.env: must be %q or %q, got %q", EnvDevelopment, EnvProduction, c.App.Env))
+	}
+	if c.App.ReadinessCheckTimeout <= 0 {
+		errs = append(errs, errors.New("app.readiness_check_timeout: must be positive"))
+	}
+	if c.App.ShutdownDelay < 0 {
+		errs = append(errs, errors.New("app.shutdown_delay: must not be negative"))
+	}
+	if err := c.Web.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.Database.Driver != DriverMemory {
+		if err := c.Database.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	rateLimit := c.API.RateLimit
+	if rateLimit.PerMinute < 0 || rateLimit.Burst < 0 || rateLimit.CustomerPerMinute < 0 || rateLimit.CustomerBurst < 0 || rateLimit.SourcePerMinute < 0 || rateLimit.SourceBurst < 0 || rateLimit.ClientCacheTTL < 0 || rateLimit.MaxKeys < 0 {
+		errs = append(errs, errors.New("api.rate_limit: must not be negative"))
+	}
+	lockout := c.API.AuthLockout
+	if lockout.Threshold < 0 || lockout.Duration <= 0 || lockout.MaxDuration < lockout.Duration {
+		errs = append(errs, errors.New("api.auth_lockout: threshold must not be negative and max_duration must be at least duration"))
+	}
+	if registration := c.API.Registration; registration.Enabled {
+		if len(registration.AllowedScopes) == 0 {
+			errs = append(errs, errors.New("api.registration.allowed_scopes: must be set when registration is enabled"))
+		}
+		for _, scope := range registration.AllowedScopes {
+			switch {
+			case !slices.Contains(usecase.ClientScopes, scope):
+				errs = append(errs, fmt.Errorf("api.registration.allowed_scopes: unknown scope %q", scope))
+			// 顧客の同意やデバイスの承認を操作できるのは銀行のアプリだけで、認証なしで登録したクライアントには許可しない
+			case scope == usecase.ConsentManageScope:
+				errs = append(errs, fmt.Errorf("api.registration.allowed_scopes: %q cannot be granted to dynamically registered clients", scope))
+			}
+		}
+		if registration.RateLimitPerMinute <= 0 || registration.RateLimitBurst < 0 {
+			errs = append(errs, errors.New("api.registration.rate_limit_per_minute: must be positive when registration is enabled"))
+		}
+		if c.API.baseURL() == "" {
+			errs = append(errs, errors.New("api.base_url: must be set (or api.oidc.issuer) when registration is enabled"))
+		}
+	}
+	if err := c.API.validateBaseURL(c.IsProduction()); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, c.API.OIDC.validate(c.IsProduction())...)
+	errs = append(errs, c.API.DeviceAuthorization.validate(c.IsProduction())...)
+	errs = append(errs, c.API.Authorization.validate(c.IsProduction())...)
+	if c.API.Authorization.ConsentURI != "" && c.API.OIDC.Issuer == "" {
+		errs = append(errs, errors.New("api.oidc.issuer: must be set to enable the authorization endpoint"))
+	}
+	if c.TokenCache.Size < 0 || c.TokenCache.TTL < 0 || c.TokenCache.NegativeTTL < 0 {
+		errs = append(errs, errors.New("token_cache: must not be negative"))
+	}
+	if c.TokenCache.TTL > tokenCacheMaxTTL || c.TokenCache.NegativeTTL > tokenCacheMaxTTL {
+		errs = append(errs, fmt.Errorf("token_cache: ttl and negative_ttl must be at most %s", tokenCacheMaxTTL))
+	}
+	switch c.Tracing.Exporter {
+	case "", tracing.ExporterNone, tracing.ExporterOTLP, tracing.ExporterConsole, tracing.ExporterStdout:
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter: unsupported exporter %q", c.Tracing.Exporter))
+	}
+	if c.IsProduction() {
+		errs = append(errs, c.validateProduction()...)
+	}
+	return errors.Join(errs...)
+}
+
+// baseURL は BaseURL が空の場合 OIDC の Issuer（API のベース URL）を返す
+func (c APIConfig) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	return c.OIDC.Issuer
+}
+
+func (c APIConfig) validateBaseURL(production bool) error {
+	if c.BaseURL == "" {
+		return nil
+	}
+	baseURL, err := url.Parse(c.BaseURL)
+	switch {
+	case err != nil || baseURL.Host == "" || baseURL.RawQuery != "" || baseURL.Fragment != "" || strings.HasSuffix(c.BaseURL, "/"):
+		return errors.New("api.base_url: must be an absolute URL without query, fragment or trailing slash")
+	case baseURL.Scheme != "https" && (production || baseURL.Scheme != "http"):
+		return errors.New("api.base_url: must use https")
+	}
+	return nil
+}
+
+func (c OIDCConfig) validate(production bool) []error {
+	if c.Issuer == "" {
+		if c.SigningKey != "" || c.SubjectSecret != "" {
+			return []error{errors.New("api.oidc.issuer: must be set to enable OpenID Connect")}
+		}
+		return nil
+	}
+	var errs []error
+	issuer, err := url.Parse(c.Issuer)
+	switch {
+	case err != nil || issuer.Host == "" || issuer.RawQuery != "" || issuer.Fragment != "" || strings.HasSuffix(c.Issuer, "/"):
+		errs = append(errs, errors.New("api.oidc.issuer: must be an absolute URL without query, fragment or trailing slash"))
+	case issuer.Scheme != "https" && (production || issuer.Scheme != "http"):
+		errs = append(errs, errors.New("api.oidc.issuer: must use https"))
+	}
+	if _, err := jwt.NewSigner([]byte(c.SigningKey)); err != nil {
+		errs = append(errs, fmt.Errorf("api.oidc.signing_key: %w", err))
+	}
+	if len(c.SubjectSecret) < oidcSubjectSecretMinLength {
+		errs = append(errs, fmt.Errorf("api.oidc.subject_secret: must be at least %d bytes", oidcSubjectSecretMinLength))
+	}
+	return errs
+}
+
+func (c DeviceAuthorizationConfig) validate(production bool) []error {
+	if c.VerificationURI == "" {
+		return nil
+	}
+	verificationURI, err := url.Parse(c.VerificationURI)
+	switch {
+	case err != nil || verificationURI.Host == "" || verificationURI.Fragment != "":
+		return []error{errors.New("api.device_authorization.verification_uri: must be an absolute URL without fragment")}
+	case verificationURI.Scheme != "https" && (production || verificationURI.Scheme != "http"):
+		return []error{errors.New("api.device_authorization.verification_uri: must use https")}
+	}
+	return nil
+}
+
+func (c AuthorizationConfig) validate(production bool) []error {
+	if c.ConsentURI == "" {
+		return nil
+	}
+	consentURI, err := url.Parse(c.ConsentURI)
+	switch {
+	case err != nil || consentURI.Host == "" || consentURI.Fragment != "":
+		return []error{errors.New("api.authorization.consent_uri: must be an absolute URL without fragment")}
+	case consentURI.Scheme != "https" && (production || consentURI.Scheme != "http"):
+		return []error{errors.New("api.authorization.consent_uri: must use https")}
+	}
+	return nil
+}
+
+// validateProduction は開発用の既定値のまま本番で起動しないようにする
+func (c *Config) validateProduction() []error {
+	var errs []error
+	switch c.Database.Driver {
+	case DriverMemory:
+		errs = append(errs, errors.New("database.driver: memory is not allowed when APP_ENV is production"))
+	case "sqlite":
+	default:
+		if c.Database.Password == "" || c.Database.Password == defaultDBPassword {
+			errs = append(errs, errors.New("database.password: default or empty credentials are not allowed when APP_ENV is production"))
+		}
+	}
+	// ロードバランサーの後ろでプロキシを設定しないと、すべての接続元がロードバランサーのアドレスになり、
+	// 1 つの接続元の失敗で全員がロックされたり制限されたりする
+	if len(c.API.TrustedProxies) == 0 && (c.API.AuthLockout.Threshold > 0 || c.API.RateLimit.SourcePerMinute > 0) {
+		errs = append(errs, errors.New("api.trusted_proxies: must be set when APP_ENV is production and the lockout or rate limit per source IP is enabled"))
+	}
+	return errs
+}
+
+func (c *Config) IsDevelopment() bool {
+	return c.App.Env == EnvDevelopment
+}
+
+func (c *Config) IsProduction() bool {
+	return c.App.Env == EnvProduction
+}
+
+func (c *Config) RouterConfig() router.Config {
+	policy := usecase.DefaultLockoutPolicy
+	policy.Threshold = c.API.AuthLockout.Threshold
+	policy.LockDuration = c.API.AuthLockout.Duration
+	policy.MaxLockDuration = c.API.AuthLockout.MaxDuration
+	var registration usecase.RegistrationPolicy
+	var registrationRateLimit entity.RateLimit
+	if c.API.Registration.Enabled {
+		registration.AllowedScopes = c.API.Registration.AllowedScopes
+		registrationRateLimit = entity.RateLimit{PerMinute: c.API.Registration.RateLimitPerMinute, Burst: c.API.Registration.RateLimitBurst}
+	}
+	return router.Config{
+		CorsAllowOrigins: c.API.CorsAllowOrigins,
+		TrustedProxies:   c.API.TrustedProxies,
+		APIDocs:          c.IsDevelopment(),
+		RateLimit: usecase.RateLimitConfig{
+			Client:         entity.RateLimit{PerMinute: c.API.RateLimit.PerMinute, Burst: c.API.RateLimit.Burst},
+			Customer:       entity.RateLimit{PerMinute: c.API.RateLimit.CustomerPerMinute, Burst: c.API.RateLimit.CustomerBurst},
+			Source:         entity.RateLimit{PerMinute: c.API.RateLimit.SourcePerMinute, Burst: c.API.RateLimit.SourceBurst},
+			Registration:   registrationRateLimit,
+			ClientCacheTTL: c.API.RateLimit.ClientCacheTTL,
+		},
+		RateLimitMaxKeys:        c.API.RateLimit.MaxKeys,
+		LockoutPolicy:           policy,
+		Registration:            registration,
+		BaseURL:                 c.API.baseURL(),
+		OIDC:                    usecase.OIDCConfig{Issuer: c.API.OIDC.Issuer, SubjectSecret: c.API.OIDC.SubjectSecret},
+		OIDCSigningKey:          []byte(c.API.OIDC.SigningKey),
+		DeviceVerificationURI:   c.API.DeviceAuthorization.VerificationURI,
+		AuthorizationConsentURI: c.API.Authorization.ConsentURI,
+	}
+}
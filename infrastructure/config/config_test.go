@@ -0,0 +1,302 @@
+package config
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-banking-api/entity"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+
+	assert.Equal(t, EnvDevelopment, config.App.Env)
+	assert.Equal(t, "mysql", config.Database.Driver)
+	assert.Equal(t, "3306", config.Database.Port)
+	assert.Equal(t, "3306", config.Database.Replica.Port)
+	assert.Equal(t, "api_database", config.Database.Database)
+	assert.Equal(t, "8080", config.Web.Port)
+	assert.Equal(t, 600, config.RouterConfig().RateLimit.Client.PerMinute)
+	assert.Equal(t, entity.RateLimit{PerMinute: 600, Burst: 100}, config.RouterConfig().RateLimit.Source)
+	assert.True(t, config.RouterConfig().APIDocs)
+}
+
+func TestLoadFileAndEnvOverrides(t *testing.T) {
+	path := writeConfigFile(t, `
+app:
+  env: production
+  shutdown_delay: 10s
+database:
+  driver: postgres
+  host: db.internal
+  password: from-file
+  pool:
+    max_open_conns: 50
+api:
+  trusted_proxies: [10.0.0.0/8]
+  auth_lockout:
+    threshold: 3
+`)
+	t.Setenv("DB_HOST", "db.override")
+	passwordFile := filepath.Join(t.TempDir(), "db-password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("from-secret\n"), 0o600))
+	t.Setenv("DB_PASSWORD_FILE", passwordFile)
+
+	config, err := Load(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, EnvProduction, config.App.Env)
+	assert.Equal(t, 10*time.Second, config.App.ShutdownDelay)
+	assert.Equal(t, "postgres", config.Database.Driver)
+	// ポートなどは driver に応じた既定値になる
+	assert.Equal(t, "5432", config.Database.Port)
+	assert.Equal(t, "disable", config.Database.SSLMode)
+	// 環境変数はファイルより優先する
+	assert.Equal(t, "db.override", config.Database.Host)
+	assert.Equal(t, "from-secret", config.Database.Password)
+	assert.Equal(t, 50, config.Database.Pool.MaxOpenConns)
+	// ファイルにない項目は既定値のまま
+	assert.Equal(t, 25, config.Database.Pool.MaxIdleConns)
+	routerConfig := config.RouterConfig()
+	assert.Equal(t, []string{"10.0.0.0/8"}, routerConfig.TrustedProxies)
+	assert.Equal(t, 3, routerConfig.LockoutPolicy.Threshold)
+	assert.Equal(t, time.Minute, routerConfig.LockoutPolicy.LockDuration)
+	assert.False(t, routerConfig.APIDocs)
+}
+
+func TestLoadRejectsUnknownFields(t *testing.T) {
+	path := writeConfigFile(t, "database:\n  hostname: db.internal\n")
+	_, err := Load(path)
+	assert.ErrorContains(t, err, "field hostname not found")
+}
+
+func TestLoadReportsAllErrors(t *testing.T) {
+	t.Setenv("DB_DRIVER", "oracle")
+	t.Setenv("WEB_PORT", "http")
+	t.Setenv("RATE_LIMIT_BURST", "-1")
+	t.Setenv("TOKEN_CACHE_TTL", "forever")
+
+	_, err := Load("")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, `TOKEN_CACHE_TTL: invalid duration "forever"`)
+
+	t.Setenv("TOKEN_CACHE_TTL", "30s")
+	_, err = Load("")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, `database.driver: invalid sql db driver: "oracle"`)
+	assert.ErrorContains(t, err, `web.port: invalid port "http"`)
+	assert.ErrorContains(t, err, "api.rate_limit: must not be negative")
+}
+
+func TestLoadTokenCacheTTL(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, config.TokenCache.TTL)
+
+	// 管理コマンドでの無効化が反映されない時間を長くしない
+	t.Setenv("TOKEN_CACHE_TTL", "5m")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "token_cache: ttl and negative_ttl must be at most 1m0s")
+}
+
+func TestLoadRegistration(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+	// 既定では登録を受け付けない
+	assert.Empty(t, config.RouterConfig().Registration.AllowedScopes)
+
+	t.Setenv("REGISTRATION_ENABLED", "true")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.base_url: must be set")
+
+	t.Setenv("API_BASE_URL", "https://bank.example.com/api/v1")
+	config, err = Load("")
+	require.NoError(t, err)
+	routerConfig := config.RouterConfig()
+	assert.Equal(t, []string{"read:account_and_transactions"}, routerConfig.Registration.AllowedScopes)
+	assert.Equal(t, "https://bank.example.com/api/v1", routerConfig.BaseURL)
+	assert.Equal(t, entity.RateLimit{PerMinute: 10, Burst: 5}, routerConfig.RateLimit.Registration)
+
+	t.Setenv("REGISTRATION_RATE_LIMIT_PER_MINUTE", "0")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.registration.rate_limit_per_minute: must be positive")
+	t.Setenv("REGISTRATION_RATE_LIMIT_PER_MINUTE", "10")
+
+	t.Setenv("REGISTRATION_ALLOWED_SCOPES", "read:account_and_transactions,write:account")
+	_, err = Load("")
+	assert.ErrorContains(t, err, `api.registration.allowed_scopes: unknown scope "write:account"`)
+
+	t.Setenv("REGISTRATION_ALLOWED_SCOPES", "read:account_and_transactions,manage:consents")
+	_, err = Load("")
+	assert.ErrorContains(t, err, `api.registration.allowed_scopes: "manage:consents" cannot be granted to dynamically registered clients`)
+}
+
+func TestLoadOIDC(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+	// 既定では ID トークンを発行しない
+	assert.False(t, config.RouterConfig().OIDC.Enabled())
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "oidc.pem")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+	t.Setenv("OIDC_ISSUER", "https://bank.example.com/api/v1")
+	t.Setenv("OIDC_SIGNING_KEY_FILE", keyFile)
+	t.Setenv("OIDC_SUBJECT_SECRET", "0123456789abcdef0123456789abcdef")
+	config, err = Load("")
+	require.NoError(t, err)
+	routerConfig := config.RouterConfig()
+	assert.Equal(t, "https://bank.example.com/api/v1", routerConfig.OIDC.Issuer)
+	assert.Equal(t, string(bytes.TrimSpace(keyPEM)), string(routerConfig.OIDCSigningKey))
+
+	t.Setenv("OIDC_ISSUER", "https://bank.example.com/api/v1/")
+	require.NoError(t, os.Unsetenv("OIDC_SIGNING_KEY_FILE"))
+	t.Setenv("OIDC_SIGNING_KEY", "not a key")
+	t.Setenv("OIDC_SUBJECT_SECRET", "short")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.oidc.issuer: must be an absolute URL")
+	assert.ErrorContains(t, err, "api.oidc.signing_key: jwt: no PEM data found")
+	assert.ErrorContains(t, err, "api.oidc.subject_secret: must be at least 32 bytes")
+
+	t.Setenv("OIDC_ISSUER", "")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.oidc.issuer: must be set to enable OpenID Connect")
+}
+
+func TestLoadDeviceAuthorization(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+	// 既定ではデバイス認可グラントを受け付けない
+	assert.Empty(t, config.RouterConfig().DeviceVerificationURI)
+
+	t.Setenv("DEVICE_VERIFICATION_URI", "https://bank.example.com/device")
+	config, err = Load("")
+	require.NoError(t, err)
+	assert.Equal(t, "https://bank.example.com/device", config.RouterConfig().DeviceVerificationURI)
+
+	t.Setenv("DEVICE_VERIFICATION_URI", "/device")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.device_authorization.verification_uri: must be an absolute URL")
+
+	t.Setenv("APP_ENV", EnvProduction)
+	t.Setenv("DB_PASSWORD", "a-real-password")
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8")
+	t.Setenv("DEVICE_VERIFICATION_URI", "http://bank.example.com/device")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.device_authorization.verification_uri: must use https")
+}
+
+func TestLoadAuthorization(t *testing.T) {
+	config, err := Load("")
+	require.NoError(t, err)
+	// 既定では認可エンドポイントを提供しない
+	assert.Empty(t, config.RouterConfig().AuthorizationConsentURI)
+
+	// PAR の認可リクエストを使うため OpenID Connect が必要
+	t.Setenv("AUTHORIZATION_CONSENT_URI", "https://bank.example.com/consent")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.oidc.issuer: must be set to enable the authorization endpoint")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	t.Setenv("OIDC_ISSUER", "https://bank.example.com/api/v1")
+	t.Setenv("OIDC_SIGNING_KEY", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})))
+	t.Setenv("OIDC_SUBJECT_SECRET", "0123456789abcdef0123456789abcdef")
+	config, err = Load("")
+	require.NoError(t, err)
+	assert.Equal(t, "https://bank.example.com/consent", config.RouterConfig().AuthorizationConsentURI)
+
+	t.Setenv("AUTHORIZATION_CONSENT_URI", "/consent")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "api.authorization.consent_uri: must be an absolute URL")
+}
+
+func TestLoadProductionRefusesDefaultCredentials(t *testing.T) {
+	t.Setenv("APP_ENV", EnvProduction)
+
+	_, err := Load("")
+	assert.ErrorContains(t, err, "database.password: default or empty credentials are not allowed")
+
+	t.Setenv("DB_DRIVER", DriverMemory)
+	_, err = Load("")
+	assert.ErrorContains(t, err, "database.driver: memory is not allowed")
+
+	t.Setenv("DB_DRIVER", "mysql")
+	t.Setenv("DB_PASSWORD", "a-real-password")
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8")
+	_, err = Load("")
+	assert.NoError(t, err)
+}
+
+func TestLoadRejectsUnknownEnv(t *testing.T) {
+	// 本番向けの検証を素通りしないよう、production の誤りは起動しない
+	t.Setenv("APP_ENV", "prod")
+	_, err := Load("")
+	assert.ErrorContains(t, err, `app.env: must be "development" or "production", got "prod"`)
+
+	t.Setenv("APP_ENV", "")
+	_, err = Load("")
+	assert.ErrorContains(t, err, "app.env: must be set")
+}
+
+func TestLoadProductionRequiresTrustedProxies(t *testing.T) {
+	t.Setenv("APP_ENV", EnvProduction)
+	t.Setenv("DB_PASSWORD", "a-real-password")
+
+	// 接続元 IP ごとのロックと制限は、プロキシを設定しないとロードバランサーのアドレスで数えてしまう
+	_, err := Load("")
+	assert.ErrorContains(t, err, "api.trusted_proxies: must be set")
+
+	t.Setenv("AUTH_LOCKOUT_THRESHOLD", "0")
+	t.Setenv("RATE_LIMIT_SOURCE_PER_MINUTE", "0")
+	_, err = Load("")
+	assert.NoError(t, err)
+
+	t.Setenv("AUTH_LOCKOUT_THRESHOLD", "5")
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8,192.0.2.1")
+	config, err := Load("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.0.2.1"}, config.RouterConfig().TrustedProxies)
+}
+
+func TestWriteRedacted(t *testing.T) {
+	config := Default()
+	config.Database.Password = "s3cret"
+	config.Log.SubjectHashKey = ""
+
+	var out bytes.Buffer
+	require.NoError(t, config.WriteRedacted(&out))
+
+	assert.NotContains(t, out.String(), "s3cret")
+	assert.Contains(t, out.String(), "password: '[REDACTED]'")
+	// 未設定の secret は伏せずに空であることを示す
+	assert.Contains(t, out.String(), `subject_hash_key: ""`)
+	assert.Contains(t, out.String(), "conn_max_lifetime: 5m0s")
+
+	// 書き出した設定はそのまま読み込める
+	config.Database.Password = ""
+	out.Reset()
+	require.NoError(t, config.WriteRedacted(&out))
+	_, err := Load(writeConfigFile(t, out.String()))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,70 @@
+package config
+
+import (
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Redacted は secret タグの付いた値を伏せる
+const Redacted = "[REDACTED]"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// WriteRedacted は設定を YAML で書き出す。パスワードなどの secret タグの付いた値は伏せる。
+func (c *Config) WriteRedacted(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(redactedNode(reflect.ValueOf(*c), false)); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redactedNode は value を YAML のノードにする。時間は "5s" のように読める形で書き出す。
+func redactedNode(value reflect.Value, secret bool) *yaml.Node {
+	if value.Type() == durationType {
+		return scalar(time.Duration(value.Int()).String())
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+			if !field.IsExported() || name == "" || name == "-" {
+				continue
+			}
+			node.Content = append(node.Content, scalar(name), redactedNode(value.Field(i), field.Tag.Get("secret") == "true"))
+		}
+		return node
+	case reflect.Slice:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+		for i := 0; i < value.Len(); i++ {
+			node.Content = append(node.Content, redactedNode(value.Index(i), secret))
+		}
+		return node
+	case reflect.String:
+		// 未設定であることは伏せずに示す
+		if secret && value.String() != "" {
+			return scalar(Redacted)
+		}
+		node := scalar(value.String())
+		node.Tag = "!!str"
+		return node
+	case reflect.Int:
+		return scalar(strconv.FormatInt(value.Int(), 10))
+	case reflect.Bool:
+		return scalar(strconv.FormatBool(value.Bool()))
+	default:
+		return scalar(value.String())
+	}
+}
+
+func scalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
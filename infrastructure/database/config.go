@@ -1,31 +1,156 @@
 package database
 
 import (
-	"go-banking-api/pkg"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-banking-api/pkg/envconfig"
+	"go-banking-api/pkg/logger"
 )
 
 type Config struct {
-	Host     string
-	Database string
-	Port     string
-	Driver   string
-	User     string
-	Password string
+	Host     string        `yaml:"host" env:"DB_HOST"`
+	Database string        `yaml:"name" env:"DB_NAME"`
+	Port     string        `yaml:"port" env:"DB_PORT"`
+	Driver   string        `yaml:"driver" env:"DB_DRIVER"`
+	User     string        `yaml:"user" env:"DB_USER"`
+	Password string        `yaml:"password" env:"DB_PASSWORD" secret:"true"`
+	SSLMode  string        `yaml:"sslmode" env:"DB_SSLMODE"`
+	Replica  ReplicaConfig `yaml:"replica"`
+	Pool     PoolConfig    `yaml:"pool"`
+	Retry    RetryConfig   `yaml:"retry"`
 }
 
-func NewConfigMySQL() *Config {
-	return &Config{
-		Host:     pkg.GetEnvDefault("DB_HOST", "localhost"),
-		Database: pkg.GetEnvDefault("DB_NAME", "api_database"),
-		Port:     pkg.GetEnvDefault("DB_PORT", "3306"),
-		Driver:   pkg.GetEnvDefault("DB_DRIVER", "mysql"),
-		User:     pkg.GetEnvDefault("DB_USER", "app"),
-		Password: pkg.GetEnvDefault("DB_PASSWORD", "password"),
+// ReplicaConfig は参照系クエリを振り分けるリードレプリカの接続先。
+// Host が空の場合はレプリカを使わず、すべてのクエリをプライマリに送る。
+type ReplicaConfig struct {
+	Host string `yaml:"host" env:"DB_REPLICA_HOST"`
+	Port string `yaml:"port" env:"DB_REPLICA_PORT"`
+	// Tables はレプリカから読み出すテーブル。
+	// 更新直後に読み直す tokens などはレプリカ遅延の影響を受けるため、既定では含めない。
+	Tables []string `yaml:"tables" env:"DB_REPLICA_TABLES"`
+}
+
+type PoolConfig struct {
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME"`
+}
+
+// RetryConfig は起動時の接続リトライ設定。待機時間は InitialBackoff から倍々に増え MaxBackoff で頭打ちになる。
+type RetryConfig struct {
+	MaxAttempts    int           `yaml:"max_attempts" env:"DB_CONNECT_MAX_ATTEMPTS"`
+	InitialBackoff time.Duration `yaml:"initial_backoff" env:"DB_CONNECT_INITIAL_BACKOFF"`
+	MaxBackoff     time.Duration `yaml:"max_backoff" env:"DB_CONNECT_MAX_BACKOFF"`
+}
+
+// DefaultConfig はローカル開発用の既定値を返す。
+// ドライバーによって変わる値は空のままにし、SetDriverDefaults で埋める。
+func DefaultConfig() Config {
+	return Config{
+		Host:     "localhost",
+		Driver:   "mysql",
+		User:     "app",
+		Password: "password",
+		Replica: ReplicaConfig{
+			Tables: []string{"customers", "accounts"},
+		},
+		Pool: PoolConfig{
+			MaxOpenConns:    25,
+			MaxIdleConns:    25,
+			ConnMaxLifetime: 5 * time.Minute,
+			ConnMaxIdleTime: time.Minute,
+		},
+		Retry: RetryConfig{
+			MaxAttempts:    5,
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     10 * time.Second,
+		},
 	}
 }
 
+// SetDriverDefaults は未設定のポート・DB 名などを Driver に応じた既定値で埋める
+func (c *Config) SetDriverDefaults() {
+	instance, err := InstanceFromDriver(c.Driver)
+	if err != nil {
+		return
+	}
+	switch instance {
+	case InstanceMySQL:
+		setDefault(&c.Port, "3306")
+		setDefault(&c.Database, "api_database")
+	case InstancePostgres:
+		setDefault(&c.Port, "5432")
+		setDefault(&c.Database, "api_database")
+		setDefault(&c.SSLMode, "disable")
+	case InstanceSQLite:
+		setDefault(&c.Database, "api_database.sqlite")
+	}
+	setDefault(&c.Replica.Port, c.Port)
+}
+
+// Validate は起動前に検出できる設定の誤りを返す。SetDriverDefaults の後に呼び出すこと。
+func (c Config) Validate() error {
+	instance, err := InstanceFromDriver(c.Driver)
+	if err != nil {
+		return fmt.Errorf("database.driver: %w", err)
+	}
+	var errs []error
+	if instance != InstanceSQLite {
+		if c.Host == "" {
+			errs = append(errs, errors.New("database.host: must be set"))
+		}
+		if c.User == "" {
+			errs = append(errs, errors.New("database.user: must be set"))
+		}
+	}
+	if c.Database == "" {
+		errs = append(errs, errors.New("database.name: must be set"))
+	}
+	if c.Pool.MaxOpenConns < 0 || c.Pool.MaxIdleConns < 0 || c.Pool.ConnMaxLifetime < 0 || c.Pool.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("database.pool: must not be negative"))
+	}
+	if c.Retry.MaxAttempts < 1 {
+		errs = append(errs, errors.New("database.retry.max_attempts: must be at least 1"))
+	}
+	return errors.Join(errs...)
+}
+
+func setDefault(value *string, defVal string) {
+	if *value == "" {
+		*value = defVal
+	}
+}
+
+func NewConfigMySQL() *Config {
+	return newConfigFromEnv("mysql")
+}
+
+func NewConfigPostgres() *Config {
+	return newConfigFromEnv("postgres")
+}
+
 func NewConfigSQLite() *Config {
-	return &Config{
-		Database: pkg.GetEnvDefault("DB_NAME", "api_database.sqlite"),
+	return newConfigFromEnv("sqlite")
+}
+
+// newConfigFromEnv は設定ファイルを使わず、既定値を環境変数だけで上書きした driver の設定を返す（テスト用）
+func newConfigFromEnv(driver string) *Config {
+	config := DefaultConfig()
+	if err := envconfig.Apply(&config); err != nil {
+		logger.Warn("ignoring invalid database environment variables", "error", err.Error())
 	}
+	config.Driver = driver
+	config.SetDriverDefaults()
+	return &config
+}
+
+// replica は ReplicaConfig の接続先で上書きした Config を返す
+func (c *Config) replica() *Config {
+	replica := *c
+	replica.Host = c.Replica.Host
+	replica.Port = c.Replica.Port
+	return &replica
 }
@@ -3,38 +3,187 @@ package database
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"time"
 
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"go-banking-api/pkg/logger"
 )
 
 const (
 	InstanceSQLite int = iota
 	InstanceMySQL
+	InstancePostgres
 )
 
 var (
 	errInvalidSQLDatabaseInstance = errors.New("invalid sql db instance")
+	errInvalidSQLDatabaseDriver   = errors.New("invalid sql db driver")
 )
 
+// InstanceFromDriver は DB_DRIVER の値を NewDatabaseSQLFactory のインスタンス種別に変換する
+func InstanceFromDriver(driver string) (int, error) {
+	switch driver {
+	case "mysql":
+		return InstanceMySQL, nil
+	case "postgres", "postgresql":
+		return InstancePostgres, nil
+	case "sqlite":
+		return InstanceSQLite, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", errInvalidSQLDatabaseDriver, driver)
+	}
+}
+
+// NewDatabaseSQLFactory は環境変数だけで設定した instance の DB に接続する（テスト用）。
+// サーバーは設定ファイルを読み込んだ Config を Open に渡すこと。
 func NewDatabaseSQLFactory(instance int) (db *gorm.DB, err error) {
 	switch instance {
 	case InstanceMySQL:
-		configs := NewConfigMySQL()
-		dsn := fmt.Sprintf(
-			"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True",
-			configs.User,
-			configs.Password,
-			configs.Host,
-			configs.Port,
-			configs.Database)
-		db, err = gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		return Open(NewConfigMySQL())
+	case InstancePostgres:
+		return Open(NewConfigPostgres())
+	case InstanceSQLite:
+		return Open(NewConfigSQLite())
+	default:
+		return nil, errInvalidSQLDatabaseInstance
+	}
+}
+
+// Open は configs.Driver の DB に接続する
+func Open(configs *Config) (*gorm.DB, error) {
+	instance, err := InstanceFromDriver(configs.Driver)
+	if err != nil {
+		return nil, err
+	}
+	switch instance {
+	case InstanceMySQL:
+		return open(configs, func(c *Config) gorm.Dialector {
+			return mysql.Open(MySQLDSN(c))
+		})
+	case InstancePostgres:
+		return open(configs, func(c *Config) gorm.Dialector {
+			return postgres.Open(PostgresDSN(c))
+		})
 	case InstanceSQLite:
-		configs := NewConfigSQLite()
-		db, err = gorm.Open(sqlite.Open(configs.Database), &gorm.Config{})
+		return open(configs, func(c *Config) gorm.Dialector {
+			return sqlite.Open(c.Database)
+		})
 	default:
 		return nil, errInvalidSQLDatabaseInstance
 	}
-	return db, err
+}
+
+func MySQLDSN(configs *Config) string {
+	return fmt.Sprintf(
+		"%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True",
+		configs.User,
+		configs.Password,
+		configs.Host,
+		configs.Port,
+		configs.Database)
+}
+
+func PostgresDSN(configs *Config) string {
+	query := url.Values{}
+	query.Set("sslmode", configs.SSLMode)
+	query.Set("TimeZone", "UTC")
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(configs.User, configs.Password),
+		Host:     net.JoinHostPort(configs.Host, configs.Port),
+		Path:     "/" + configs.Database,
+		RawQuery: query.Encode(),
+	}
+	return dsn.String()
+}
+
+// open は接続できるまで configs.Retry に従ってリトライし、プール設定とレプリカを構成した *gorm.DB を返す
+func open(configs *Config, dialector func(*Config) gorm.Dialector) (*gorm.DB, error) {
+	var db *gorm.DB
+	err := connectWithRetry(configs.Retry, time.Sleep, func() error {
+		opened, err := gorm.Open(dialector(configs), &gorm.Config{TranslateError: true})
+		if err != nil {
+			return err
+		}
+		if err := opened.Use(TracingPlugin{}); err != nil {
+			closeDB(opened)
+			return err
+		}
+		if configs.Replica.Host != "" {
+			if err := useReplica(opened, dialector(configs.replica()), configs.Replica.Tables, configs.Pool); err != nil {
+				closeDB(opened)
+				return err
+			}
+		}
+		db = opened
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(configs.Pool.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(configs.Pool.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(configs.Pool.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(configs.Pool.ConnMaxIdleTime)
+	return db, nil
+}
+
+// useReplica は tables への参照系クエリを replica に振り分ける。
+// 更新系クエリとトランザクション内のクエリはプライマリに送られる。
+func useReplica(db *gorm.DB, replica gorm.Dialector, tables []string, pool PoolConfig) error {
+	datas := make([]interface{}, 0, len(tables))
+	for _, table := range tables {
+		datas = append(datas, table)
+	}
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: []gorm.Dialector{replica},
+	}, datas...).
+		SetMaxOpenConns(pool.MaxOpenConns).
+		SetMaxIdleConns(pool.MaxIdleConns).
+		SetConnMaxLifetime(pool.ConnMaxLifetime).
+		SetConnMaxIdleTime(pool.ConnMaxIdleTime)
+	return db.Use(resolver)
+}
+
+func connectWithRetry(retry RetryConfig, sleep func(time.Duration), connect func() error) error {
+	attempts := retry.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	backoff := retry.InitialBackoff
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = connect(); err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+		logger.Warn("failed to connect to database, retrying", "attempt", attempt, "backoff", backoff.String(), "error", err.Error())
+		sleep(backoff)
+		backoff *= 2
+		if retry.MaxBackoff > 0 && backoff > retry.MaxBackoff {
+			backoff = retry.MaxBackoff
+		}
+	}
+	return fmt.Errorf("failed to connect to database after %d attempts: %w", attempts, err)
+}
+
+func closeDB(db *gorm.DB) {
+	if sqlDB, err := db.DB(); err == nil {
+		_ = sqlDB.Close()
+	}
 }
@@ -0,0 +1,141 @@
+package migration_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"go-banking-api/infrastructure/database/migration"
+)
+
+type MigratorTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	migrator *migration.Migrator
+}
+
+func TestMigratorTestSuite(t *testing.T) {
+	suite.Run(t, new(MigratorTestSuite))
+}
+
+func (suite *MigratorTestSuite) SetupTest() {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(suite.T().TempDir(), "migration.sqlite")), &gorm.Config{})
+	suite.Require().NoError(err)
+	suite.db = db
+	suite.migrator, err = migration.NewMigrator(db)
+	suite.Require().NoError(err)
+}
+
+func (suite *MigratorTestSuite) TestUpAppliesAllMigrationsOnce() {
+	migrations, err := migration.Load("sqlite")
+	suite.Require().NoError(err)
+
+	count, err := suite.migrator.Up()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(len(migrations), count)
+	for _, table := range []string{"customers", "accounts", "clients", "tokens"} {
+		suite.Assert().True(suite.db.Migrator().HasTable(table), table)
+	}
+
+	count, err = suite.migrator.Up()
+	suite.Require().NoError(err)
+	suite.Assert().Equal(0, count)
+
+	pending, err := suite.migrator.Pending()
+	suite.Require().NoError(err)
+	suite.Assert().Empty(pending)
+}
+
+func (suite *MigratorTestSuite) TestDownRevertsLatestMigration() {
+	_, err := suite.migrator.Up()
+	suite.Require().NoError(err)
+
+	count, err := suite.migrator.Down(1)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(1, count)
+
+	statuses, err := suite.migrator.Status()
+	suite.Require().NoError(err)
+	latest := statuses[len(statuses)-1]
+	suite.Assert().False(latest.Applied)
+	suite.Assert().Nil(latest.AppliedAt)
+
+	pending, err := suite.migrator.Pending()
+	suite.Require().NoError(err)
+	suite.Assert().Len(pending, 1)
+}
+
+func (suite *MigratorTestSuite) TestStatusBeforeUp() {
+	statuses, err := suite.migrator.Status()
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(statuses)
+	for _, status := range statuses {
+		suite.Assert().False(status.Applied)
+	}
+}
+
+func (suite *MigratorTestSuite) TestLoadUnsupportedDialect() {
+	_, err := migration.Load("oracle")
+	suite.Assert().ErrorIs(err, migration.ErrUnsupportedDialect)
+}
+
+func (suite *MigratorTestSuite) TestDialectsHaveSameVersions() {
+	expected, err := migration.Load("sqlite")
+	suite.Require().NoError(err)
+	for _, dialect := range []string{"mysql", "postgres"} {
+		migrations, err := migration.Load(dialect)
+		suite.Require().NoError(err)
+		suite.Require().Equal(len(expected), len(migrations), dialect)
+		for i := range expected {
+			suite.Assert().Equal(expected[i].Version, migrations[i].Version, dialect)
+			suite.Assert().Equal(expected[i].Name, migrations[i].Name, dialect)
+		}
+	}
+}
+
+func (suite *MigratorTestSuite) TestCheckCurrent() {
+	// schema_migrations がない場合もエラーにする
+	suite.Assert().Error(suite.migrator.CheckCurrent(context.Background()))
+
+	_, err := suite.migrator.Up()
+	suite.Require().NoError(err)
+	suite.Assert().NoError(suite.migrator.CheckCurrent(context.Background()))
+
+	_, err = suite.migrator.Down(1)
+	suite.Require().NoError(err)
+	suite.Assert().ErrorIs(suite.migrator.CheckCurrent(context.Background()), migration.ErrPendingMigrations)
+}
+
+func (suite *MigratorTestSuite) TestUpReportsFailedStatement() {
+	suite.Require().NoError(suite.db.Exec("CREATE TABLE tokens (id INTEGER)").Error)
+
+	count, err := suite.migrator.Up()
+	suite.Assert().Equal(0, count)
+	suite.Require().Error(err)
+	suite.Assert().Contains(err.Error(), "migration 1_create_initial_tables up: statement 4/4")
+	// SQLite では DDL もロールバックされる
+	suite.Assert().False(suite.db.Migrator().HasTable("customers"))
+}
+
+func (suite *MigratorTestSuite) TestDownClientSecretsRestoresActiveSecret() {
+	migrations, err := migration.Load("sqlite")
+	suite.Require().NoError(err)
+	_, err = suite.migrator.Up()
+	suite.Require().NoError(err)
+
+	now := time.Now().UTC()
+	suite.Require().NoError(suite.db.Exec("INSERT INTO clients (client_id, client_name, scope) VALUES ('app', 'App', '')").Error)
+	suite.Require().NoError(suite.db.Exec("INSERT INTO client_secrets (client_id, secret_hash, created_at, not_after) VALUES ('app', 'active', ?, NULL), ('app', 'retired', ?, ?)", now, now, now.Add(-time.Minute)).Error)
+
+	// 0006 まで戻すと、新しくても失効済みのシークレットではなく有効なシークレットを戻す
+	_, err = suite.migrator.Down(len(migrations) - 5)
+	suite.Require().NoError(err)
+	var secret string
+	suite.Require().NoError(suite.db.Raw("SELECT client_secret FROM clients WHERE client_id = 'app'").Scan(&secret).Error)
+	suite.Assert().Equal("active", secret)
+}
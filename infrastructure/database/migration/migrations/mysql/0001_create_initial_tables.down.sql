@@ -0,0 +1,4 @@
+DROP TABLE tokens;
+DROP TABLE clients;
+DROP TABLE accounts;
+DROP TABLE customers;
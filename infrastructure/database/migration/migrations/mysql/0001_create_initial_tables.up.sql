@@ -1,7 +1,3 @@
-CREATE DATABASE IF NOT EXISTS api_database;
-
-USE api_database;
-
 CREATE TABLE customers (
     cif_no INT PRIMARY KEY AUTO_INCREMENT,
     name_kana VARCHAR(255) NOT NULL,
@@ -51,4 +47,3 @@ CREATE TABLE tokens (
     CONSTRAINT fk_tokens_clients FOREIGN KEY (client_id) REFERENCES clients(client_id),
     CONSTRAINT fk_tokens_customers FOREIGN KEY (cif_no) REFERENCES customers(cif_no)
 );
-
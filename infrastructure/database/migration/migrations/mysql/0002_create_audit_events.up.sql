@@ -0,0 +1,17 @@
+CREATE TABLE audit_events (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    occurred_at DATETIME(6) NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    outcome VARCHAR(16) NOT NULL,
+    reason VARCHAR(64) NOT NULL DEFAULT '',
+    client_id VARCHAR(255) NOT NULL DEFAULT '',
+    cif_no INT NOT NULL DEFAULT 0,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    UNIQUE KEY uk_audit_events_prev_hash (prev_hash),
+    UNIQUE KEY uk_audit_events_hash (hash),
+    KEY idx_audit_events_client_id (client_id, occurred_at),
+    KEY idx_audit_events_cif_no (cif_no, occurred_at),
+    KEY idx_audit_events_occurred_at (occurred_at)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE clients
+    DROP COLUMN rate_limit_burst,
+    DROP COLUMN rate_limit_per_minute;
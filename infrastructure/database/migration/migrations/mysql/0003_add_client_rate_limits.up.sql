@@ -0,0 +1,3 @@
+ALTER TABLE clients
+    ADD COLUMN rate_limit_per_minute INT NOT NULL DEFAULT 0,
+    ADD COLUMN rate_limit_burst INT NOT NULL DEFAULT 0;
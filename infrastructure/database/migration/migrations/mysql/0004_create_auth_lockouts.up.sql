@@ -0,0 +1,6 @@
+CREATE TABLE auth_lockouts (
+    lockout_key VARCHAR(320) PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure_at DATETIME(6) NOT NULL,
+    locked_until DATETIME(6) NOT NULL
+);
@@ -0,0 +1,5 @@
+ALTER TABLE clients
+    DROP COLUMN registration_access_token_hash,
+    DROP COLUMN token_endpoint_auth_method,
+    DROP COLUMN grant_types,
+    DROP COLUMN redirect_uris;
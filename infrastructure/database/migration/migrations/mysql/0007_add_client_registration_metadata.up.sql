@@ -0,0 +1,5 @@
+ALTER TABLE clients
+    ADD COLUMN redirect_uris VARCHAR(2000) NOT NULL DEFAULT '',
+    ADD COLUMN grant_types VARCHAR(255) NOT NULL DEFAULT 'refresh_token',
+    ADD COLUMN token_endpoint_auth_method VARCHAR(64) NOT NULL DEFAULT 'client_secret_basic',
+    ADD COLUMN registration_access_token_hash VARCHAR(64) NOT NULL DEFAULT '';
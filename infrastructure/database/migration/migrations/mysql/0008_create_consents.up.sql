@@ -0,0 +1,19 @@
+CREATE TABLE consents (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    cif_no INT NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    scope TEXT NOT NULL,
+    account_ids VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    revoked_at DATETIME(6) NULL,
+    CONSTRAINT uk_consents_cif_no_client_id UNIQUE (cif_no, client_id),
+    CONSTRAINT fk_consents_customers FOREIGN KEY (cif_no) REFERENCES customers(cif_no),
+    CONSTRAINT fk_consents_clients FOREIGN KEY (client_id) REFERENCES clients(client_id),
+    INDEX idx_consents_client_id (client_id)
+);
+INSERT INTO consents (cif_no, client_id, scope, account_ids, created_at, expires_at)
+SELECT t.cif_no, t.client_id, MAX(t.scopes),
+    COALESCE((SELECT GROUP_CONCAT(a.id ORDER BY a.id SEPARATOR ' ') FROM accounts a WHERE a.cif_no = t.cif_no), ''),
+    CURRENT_TIMESTAMP(6), DATE_ADD(CURRENT_TIMESTAMP(6), INTERVAL 90 DAY)
+FROM tokens t GROUP BY t.cif_no, t.client_id;
@@ -0,0 +1,4 @@
+DROP TABLE pushed_authorization_requests;
+ALTER TABLE clients
+    DROP COLUMN fapi,
+    DROP COLUMN jwks;
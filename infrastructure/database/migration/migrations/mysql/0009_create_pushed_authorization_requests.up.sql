@@ -0,0 +1,19 @@
+ALTER TABLE clients
+    ADD COLUMN jwks VARCHAR(8000) NOT NULL DEFAULT '',
+    ADD COLUMN fapi BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE TABLE pushed_authorization_requests (
+    request_uri VARCHAR(255) PRIMARY KEY,
+    client_id VARCHAR(255) NOT NULL,
+    response_type VARCHAR(64) NOT NULL,
+    redirect_uri VARCHAR(2000) NOT NULL,
+    scope TEXT NOT NULL,
+    state VARCHAR(255) NOT NULL DEFAULT '',
+    nonce VARCHAR(255) NOT NULL DEFAULT '',
+    code_challenge VARCHAR(128) NOT NULL,
+    code_challenge_method VARCHAR(16) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    CONSTRAINT fk_pushed_authorization_requests_clients FOREIGN KEY (client_id) REFERENCES clients(client_id),
+    INDEX idx_pushed_authorization_requests_client_id (client_id),
+    INDEX idx_pushed_authorization_requests_expires_at (expires_at)
+);
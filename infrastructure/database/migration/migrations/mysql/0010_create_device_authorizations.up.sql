@@ -0,0 +1,16 @@
+CREATE TABLE device_authorizations (
+    device_code VARCHAR(255) PRIMARY KEY,
+    user_code VARCHAR(16) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    scope TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    cif_no INT NOT NULL DEFAULT 0,
+    polling_interval INT NOT NULL,
+    last_polled_at DATETIME(6) NULL,
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    CONSTRAINT uk_device_authorizations_user_code UNIQUE (user_code),
+    CONSTRAINT fk_device_authorizations_clients FOREIGN KEY (client_id) REFERENCES clients(client_id),
+    INDEX idx_device_authorizations_client_id (client_id),
+    INDEX idx_device_authorizations_expires_at (expires_at)
+);
@@ -0,0 +1 @@
+DROP TABLE authorization_codes;
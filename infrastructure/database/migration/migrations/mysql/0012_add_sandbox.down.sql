@@ -0,0 +1,2 @@
+ALTER TABLE customers DROP COLUMN sandbox;
+ALTER TABLE clients DROP COLUMN sandbox;
@@ -0,0 +1,2 @@
+ALTER TABLE clients ADD COLUMN sandbox BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE customers ADD COLUMN sandbox BOOLEAN NOT NULL DEFAULT FALSE;
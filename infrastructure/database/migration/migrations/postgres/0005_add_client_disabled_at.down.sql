@@ -0,0 +1 @@
+ALTER TABLE clients DROP COLUMN disabled_at;
@@ -0,0 +1,12 @@
+CREATE TABLE client_secrets (
+    id BIGSERIAL PRIMARY KEY,
+    client_id VARCHAR(255) NOT NULL,
+    secret_hash VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    not_after TIMESTAMPTZ NULL,
+    CONSTRAINT fk_client_secrets_clients FOREIGN KEY (client_id) REFERENCES clients(client_id)
+);
+CREATE INDEX idx_client_secrets_client_id ON client_secrets (client_id);
+INSERT INTO client_secrets (client_id, secret_hash, created_at)
+SELECT client_id, client_secret, CURRENT_TIMESTAMP FROM clients;
+ALTER TABLE clients DROP COLUMN client_secret;
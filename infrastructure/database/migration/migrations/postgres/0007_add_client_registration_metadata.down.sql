@@ -0,0 +1,4 @@
+ALTER TABLE clients DROP COLUMN registration_access_token_hash;
+ALTER TABLE clients DROP COLUMN token_endpoint_auth_method;
+ALTER TABLE clients DROP COLUMN grant_types;
+ALTER TABLE clients DROP COLUMN redirect_uris;
@@ -0,0 +1,4 @@
+ALTER TABLE clients ADD COLUMN redirect_uris VARCHAR(2000) NOT NULL DEFAULT '';
+ALTER TABLE clients ADD COLUMN grant_types VARCHAR(255) NOT NULL DEFAULT 'refresh_token';
+ALTER TABLE clients ADD COLUMN token_endpoint_auth_method VARCHAR(64) NOT NULL DEFAULT 'client_secret_basic';
+ALTER TABLE clients ADD COLUMN registration_access_token_hash VARCHAR(64) NOT NULL DEFAULT '';
@@ -0,0 +1,19 @@
+CREATE TABLE consents (
+    id BIGSERIAL PRIMARY KEY,
+    cif_no INTEGER NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    scope TEXT NOT NULL,
+    account_ids VARCHAR(1000) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    CONSTRAINT uk_consents_cif_no_client_id UNIQUE (cif_no, client_id),
+    CONSTRAINT fk_consents_customers FOREIGN KEY (cif_no) REFERENCES customers(cif_no),
+    CONSTRAINT fk_consents_clients FOREIGN KEY (client_id) REFERENCES clients(client_id)
+);
+CREATE INDEX idx_consents_client_id ON consents (client_id);
+INSERT INTO consents (cif_no, client_id, scope, account_ids, created_at, expires_at)
+SELECT t.cif_no, t.client_id, MAX(t.scopes),
+    COALESCE((SELECT STRING_AGG(a.id::text, ' ' ORDER BY a.id) FROM accounts a WHERE a.cif_no = t.cif_no), ''),
+    CURRENT_TIMESTAMP, CURRENT_TIMESTAMP + INTERVAL '90 days'
+FROM tokens t GROUP BY t.cif_no, t.client_id;
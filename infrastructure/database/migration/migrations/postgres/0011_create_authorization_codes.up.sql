@@ -0,0 +1,19 @@
+CREATE TABLE authorization_codes (
+    id VARCHAR(255) PRIMARY KEY,
+    code VARCHAR(255) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    redirect_uri VARCHAR(2000) NOT NULL,
+    scope TEXT NOT NULL,
+    state VARCHAR(255) NOT NULL DEFAULT '',
+    nonce VARCHAR(255) NOT NULL DEFAULT '',
+    code_challenge VARCHAR(128) NOT NULL,
+    code_challenge_method VARCHAR(16) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    cif_no INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uk_authorization_codes_code UNIQUE (code),
+    CONSTRAINT fk_authorization_codes_clients FOREIGN KEY (client_id) REFERENCES clients(client_id)
+);
+CREATE INDEX idx_authorization_codes_client_id ON authorization_codes (client_id);
+CREATE INDEX idx_authorization_codes_expires_at ON authorization_codes (expires_at);
@@ -0,0 +1,18 @@
+CREATE TABLE audit_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    occurred_at TIMESTAMP NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    outcome VARCHAR(16) NOT NULL,
+    reason VARCHAR(64) NOT NULL DEFAULT '',
+    client_id VARCHAR(255) NOT NULL DEFAULT '',
+    cif_no INTEGER NOT NULL DEFAULT 0,
+    request_id VARCHAR(128) NOT NULL DEFAULT '',
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    CONSTRAINT uk_audit_events_prev_hash UNIQUE (prev_hash),
+    CONSTRAINT uk_audit_events_hash UNIQUE (hash)
+);
+
+CREATE INDEX idx_audit_events_client_id ON audit_events (client_id, occurred_at);
+CREATE INDEX idx_audit_events_cif_no ON audit_events (cif_no, occurred_at);
+CREATE INDEX idx_audit_events_occurred_at ON audit_events (occurred_at);
@@ -0,0 +1,2 @@
+ALTER TABLE clients DROP COLUMN rate_limit_burst;
+ALTER TABLE clients DROP COLUMN rate_limit_per_minute;
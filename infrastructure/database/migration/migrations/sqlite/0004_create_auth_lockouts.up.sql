@@ -0,0 +1,6 @@
+CREATE TABLE auth_lockouts (
+    lockout_key VARCHAR(320) PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NOT NULL
+);
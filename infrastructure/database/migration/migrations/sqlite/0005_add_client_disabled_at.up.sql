@@ -0,0 +1 @@
+ALTER TABLE clients ADD COLUMN disabled_at TIMESTAMP NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE clients ADD COLUMN client_secret VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE clients SET client_secret = COALESCE((
+    SELECT secret_hash FROM client_secrets
+    WHERE client_secrets.client_id = clients.client_id
+      AND (not_after IS NULL OR datetime(not_after) > CURRENT_TIMESTAMP)
+    ORDER BY id DESC LIMIT 1
+), '');
+DROP TABLE client_secrets;
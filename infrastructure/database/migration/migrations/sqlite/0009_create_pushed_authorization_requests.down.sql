@@ -0,0 +1,3 @@
+DROP TABLE pushed_authorization_requests;
+ALTER TABLE clients DROP COLUMN fapi;
+ALTER TABLE clients DROP COLUMN jwks;
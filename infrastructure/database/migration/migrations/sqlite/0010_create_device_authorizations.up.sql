@@ -0,0 +1,16 @@
+CREATE TABLE device_authorizations (
+    device_code VARCHAR(255) PRIMARY KEY,
+    user_code VARCHAR(16) NOT NULL,
+    client_id VARCHAR(255) NOT NULL,
+    scope TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    cif_no INTEGER NOT NULL DEFAULT 0,
+    polling_interval INTEGER NOT NULL,
+    last_polled_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    CONSTRAINT uk_device_authorizations_user_code UNIQUE (user_code),
+    CONSTRAINT fk_device_authorizations_clients FOREIGN KEY (client_id) REFERENCES clients(client_id)
+);
+CREATE INDEX idx_device_authorizations_client_id ON device_authorizations (client_id);
+CREATE INDEX idx_device_authorizations_expires_at ON device_authorizations (expires_at);
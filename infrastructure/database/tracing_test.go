@@ -0,0 +1,65 @@
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"go-banking-api/pkg/tracing"
+)
+
+type tracedRow struct {
+	ID   int
+	Name string
+}
+
+func TestTracingPlugin(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "tracing.sqlite")), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(TracingPlugin{}))
+	require.NoError(t, db.AutoMigrate(&tracedRow{}))
+
+	ctx, parent := tracing.Start(context.Background(), "parent")
+	require.NoError(t, db.WithContext(ctx).Create(&tracedRow{ID: 1, Name: "secret-value"}).Error)
+	var row tracedRow
+	err = db.WithContext(ctx).Where("name = ?", "missing").Take(&row).Error
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	parent.End()
+
+	var create, query sdktrace.ReadOnlySpan
+	for _, span := range recorder.Ended() {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			continue
+		}
+		switch span.Name() {
+		case "gorm.create":
+			create = span
+		case "gorm.query":
+			query = span
+		}
+	}
+	require.NotNil(t, create)
+	require.NotNil(t, query)
+
+	assert.Equal(t, trace.SpanKindClient, create.SpanKind())
+	assert.Contains(t, create.Attributes(), semconv.DBSystemNameKey.String("sqlite"))
+	assert.Contains(t, create.Attributes(), semconv.DBCollectionName("traced_rows"))
+	for _, attribute := range create.Attributes() {
+		assert.NotContains(t, attribute.Value.Emit(), "secret-value")
+	}
+	assert.Contains(t, query.Attributes(), semconv.DBQueryText("SELECT * FROM `traced_rows` WHERE name = ? LIMIT 1"))
+	// 見つからないことはエラーとして記録しない
+	assert.Equal(t, "Unset", query.Status().Code.String())
+}
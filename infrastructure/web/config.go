@@ -1,22 +1,79 @@
 package web
 
 import (
-	"strings"
-
-	"go-banking-api/pkg"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 )
 
 type Config struct {
-	Host             string
-	Port             string
-	CorsAllowOrigins []string
+	Host     string        `yaml:"host" env:"WEB_HOST"`
+	Port     string        `yaml:"port" env:"WEB_PORT"`
+	Timeouts TimeoutConfig `yaml:"timeouts"`
+	TLS      TLSConfig     `yaml:"tls"`
+	// HTTP2Cleartext は TLS を使わない場合も HTTP/2（h2c）を受け付ける。TLS を前段で終端する構成向け。
+	HTTP2Cleartext bool `yaml:"http2_cleartext" env:"WEB_HTTP2_CLEARTEXT"`
+}
+
+// TimeoutConfig は http.Server のタイムアウト。
+// WriteTimeout は API のタイムアウト（2 秒）より長くすること。
+type TimeoutConfig struct {
+	ReadHeader time.Duration `yaml:"read_header" env:"WEB_READ_HEADER_TIMEOUT"`
+	Read       time.Duration `yaml:"read" env:"WEB_READ_TIMEOUT"`
+	Write      time.Duration `yaml:"write" env:"WEB_WRITE_TIMEOUT"`
+	Idle       time.Duration `yaml:"idle" env:"WEB_IDLE_TIMEOUT"`
+}
+
+// TLSConfig は TLS の設定。CertFile が空の場合は平文で待ち受ける。
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file" env:"TLS_CERT_FILE"`
+	KeyFile  string `yaml:"key_file" env:"TLS_KEY_FILE"`
+	// MinVersion は "1.2" または "1.3"
+	MinVersion string `yaml:"min_version" env:"TLS_MIN_VERSION"`
+	// CipherSuites は TLS 1.2 で使う暗号スイートの名前（crypto/tls の定数名）。空の場合は Go の既定値を使う。
+	CipherSuites []string `yaml:"cipher_suites" env:"TLS_CIPHER_SUITES"`
+	// ReloadInterval は証明書ファイルの更新を確認する間隔。0 の場合は再読み込みしない。
+	ReloadInterval time.Duration `yaml:"reload_interval" env:"TLS_RELOAD_INTERVAL"`
+}
+
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Host: "0.0.0.0",
+		Port: "8080",
+		Timeouts: TimeoutConfig{
+			ReadHeader: 5 * time.Second,
+			Read:       10 * time.Second,
+			Write:      15 * time.Second,
+			Idle:       2 * time.Minute,
+		},
+		TLS: TLSConfig{
+			MinVersion:     "1.2",
+			ReloadInterval: time.Minute,
+		},
+	}
 }
 
-func NewConfigWeb() *Config {
-	return &Config{
-		Host: pkg.GetEnvDefault("WEB_HOST", "0.0.0.0"),
-		Port: pkg.GetEnvDefault("WEB_PORT", "8080"),
-		CorsAllowOrigins: strings.Split(pkg.GetEnvDefault("CORS_ALLOW_ORIGINS",
-			"http://0.0.0.0:8001"), ","),
+// Validate は起動前に検出できる設定の誤りを返す
+func (c Config) Validate() error {
+	var errs []error
+	if port, err := strconv.Atoi(c.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("web.port: invalid port %q", c.Port))
+	}
+	if c.Timeouts.ReadHeader <= 0 || c.Timeouts.Read <= 0 || c.Timeouts.Write <= 0 || c.Timeouts.Idle <= 0 {
+		errs = append(errs, errors.New("web.timeouts: must be positive"))
+	}
+	if c.TLS.Enabled() || c.TLS.KeyFile != "" {
+		if c.TLS.CertFile == "" || c.TLS.KeyFile == "" {
+			errs = append(errs, errors.New("web.tls: cert_file and key_file must be set together"))
+		}
+		if _, err := newTLSConfig(c.TLS, &certReloader{}); err != nil {
+			errs = append(errs, fmt.Errorf("web.tls: %w", err))
+		}
 	}
+	return errors.Join(errs...)
 }
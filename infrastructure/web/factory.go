@@ -3,7 +3,10 @@ package web
 import (
 	"context"
 
-	"gorm.io/gorm"
+	"go-banking-api/adapter/controller/gin/router"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/pkg/health"
+	"go-banking-api/usecase"
 )
 
 type Server interface {
@@ -11,7 +14,6 @@ type Server interface {
 	Shutdown(ctx context.Context) error
 }
 
-func NewServer(db *gorm.DB) (Server, error) {
-	config := NewConfigWeb()
-	return NewGinServer(config.Host, config.Port, config.CorsAllowOrigins, db)
+func NewServer(config *Config, routerConfig router.Config, repos gateway.Repositories, transactionManager usecase.TransactionManager, readiness *health.Readiness) (Server, error) {
+	return NewGinServer(config, routerConfig, repos, transactionManager, readiness)
 }
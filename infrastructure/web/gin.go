@@ -5,34 +5,76 @@ import (
 	"fmt"
 	"net/http"
 
-	"gorm.io/gorm"
-
 	"go-banking-api/adapter/controller/gin/router"
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/pkg/health"
 	"go-banking-api/pkg/logger"
+	"go-banking-api/usecase"
 )
 
 type GinWebServer struct {
-	server *http.Server
+	server    *http.Server
+	tls       TLSConfig
+	reloader  *certReloader
+	reloadCtx context.Context
+	// stopReload は証明書の再読み込みを止める
+	stopReload context.CancelFunc
 }
 
 func (g *GinWebServer) Start() error {
-	return g.server.ListenAndServe()
+	if g.reloader == nil {
+		return g.server.ListenAndServe()
+	}
+	go g.reloader.watch(g.reloadCtx, g.tls.ReloadInterval)
+	// 証明書は TLSConfig.GetCertificate から取得する
+	return g.server.ListenAndServeTLS("", "")
 }
 
 func (g *GinWebServer) Shutdown(ctx context.Context) error {
+	if g.stopReload != nil {
+		g.stopReload()
+	}
 	return g.server.Shutdown(ctx)
 }
 
-func NewGinServer(host, port string, corsAllowOrigins []string, db *gorm.DB) (Server, error) {
-	router, err := router.NewGinRouter(db, corsAllowOrigins)
+func NewGinServer(config *Config, routerConfig router.Config, repos gateway.Repositories, transactionManager usecase.TransactionManager, readiness *health.Readiness) (Server, error) {
+	router, err := router.NewGinRouter(routerConfig, repos, transactionManager, readiness)
+	if err != nil {
+		logger.Error(err.Error(), "host", config.Host, "port", config.Port)
+		return nil, err
+	}
+	return newGinWebServer(config, router)
+}
+
+func newGinWebServer(config *Config, handler http.Handler) (*GinWebServer, error) {
+	server := &http.Server{
+		Addr:              fmt.Sprintf("%s:%s", config.Host, config.Port),
+		Handler:           handler,
+		ReadHeaderTimeout: config.Timeouts.ReadHeader,
+		ReadTimeout:       config.Timeouts.Read,
+		WriteTimeout:      config.Timeouts.Write,
+		IdleTimeout:       config.Timeouts.Idle,
+		Protocols:         new(http.Protocols),
+	}
+	server.Protocols.SetHTTP1(true)
+	// TLS の場合は ALPN で HTTP/2 を選べるようにする
+	server.Protocols.SetHTTP2(true)
+	server.Protocols.SetUnencryptedHTTP2(config.HTTP2Cleartext)
+
+	webServer := &GinWebServer{server: server, tls: config.TLS}
+	if !config.TLS.Enabled() {
+		return webServer, nil
+	}
+	reloader, err := newCertReloader(config.TLS.CertFile, config.TLS.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load TLS certificate: %w", err)
+	}
+	tlsConfig, err := newTLSConfig(config.TLS, reloader)
 	if err != nil {
-		logger.Error(err.Error(), "host", host, "port", port)
 		return nil, err
 	}
-	return &GinWebServer{
-		server: &http.Server{
-			Addr:    fmt.Sprintf("%s:%s", host, port),
-			Handler: router,
-		},
-	}, err
+	server.TLSConfig = tlsConfig
+	webServer.reloader = reloader
+	webServer.reloadCtx, webServer.stopReload = context.WithCancel(context.Background())
+	return webServer, nil
 }
@@ -62,7 +62,9 @@ func (t *AccountInfoTestSuite) TestGetAccountInfo() {
 	}, t.basicAuthEditor())
 	t.Require().NoError(err)
 	t.Require().NotNil(tokenResponse.JSON200)
-	accessToken := tokenResponse.JSON200.Data.AccessToken
+	token, err := tokenResponse.JSON200.AsTokenResponse()
+	t.Require().NoError(err)
+	accessToken := token.Data.AccessToken
 
 	authEditor := func(ctx context.Context, req *http.Request) error {
 		req.Header.Set("Authorization", "Bearer "+accessToken)
@@ -74,7 +76,7 @@ func (t *AccountInfoTestSuite) TestGetAccountInfo() {
 	t.Assert().Equal(http.StatusOK, getResponse.StatusCode())
 	t.Assert().Equal("1234", getResponse.JSON200.Data.BankCode)
 	t.Assert().Equal("123", getResponse.JSON200.Data.BranchCode)
-	t.Assert().Equal(presenter.Active, getResponse.JSON200.Data.Status)
+	t.Assert().Equal(presenter.AccountStatusActive, getResponse.JSON200.Data.Status)
 	t.Assert().Equal("1", getResponse.JSON200.Data.AccountType)
 	t.Assert().Equal("1234567", getResponse.JSON200.Data.AccountNumber)
 	t.Assert().Equal("JPY", getResponse.JSON200.Data.Currency)
@@ -82,6 +84,17 @@ func (t *AccountInfoTestSuite) TestGetAccountInfo() {
 	t.Assert().Equal("Tanaka Taro", getResponse.JSON200.Data.NameKana)
 	t.Assert().Equal("田中 太郎", getResponse.JSON200.Data.NameKanji)
 
+	// 参照は X-Request-ID とともに監査ログに残る
+	requestID := getResponse.HTTPResponse.Header.Get("X-Request-ID")
+	t.Require().NotEmpty(requestID)
+	var auditEvent entity.AuditEvent
+	err = t.DB.Where("request_id = ?", requestID).Take(&auditEvent).Error
+	t.Require().NoError(err)
+	t.Assert().Equal(entity.AuditOperationAccountRead, auditEvent.Operation)
+	t.Assert().Equal(entity.AuditOutcomeSuccess, auditEvent.Outcome)
+	t.Assert().Equal(testClientID, auditEvent.ClientID)
+	t.Assert().Equal(1, auditEvent.CifNo)
+	t.Assert().Equal(auditEvent.Hash, auditEvent.ComputeHash())
 }
 
 func (t *AccountInfoTestSuite) TestPostToken() {
@@ -96,18 +109,20 @@ func (t *AccountInfoTestSuite) TestPostToken() {
 	t.Require().NoError(err)
 	t.Require().NotNil(response.JSON200)
 	t.Assert().Equal(http.StatusOK, response.StatusCode())
-	t.Assert().Equal(api.Version, response.JSON200.ApiVersion)
-	t.Assert().Equal("Bearer", response.JSON200.Data.TokenType)
-	t.Assert().NotEmpty(response.JSON200.Data.AccessToken)
-	t.Assert().NotEmpty(response.JSON200.Data.RefreshToken)
-	t.Assert().NotEqual("test-refresh-token", response.JSON200.Data.RefreshToken)
-	t.Assert().Greater(response.JSON200.Data.ExpiresIn, 0)
-	t.Assert().LessOrEqual(response.JSON200.Data.ExpiresIn, 3600)
+	token, err := response.JSON200.AsTokenResponse()
+	t.Require().NoError(err)
+	t.Assert().Equal(api.Version, token.ApiVersion)
+	t.Assert().Equal("Bearer", token.Data.TokenType)
+	t.Assert().NotEmpty(token.Data.AccessToken)
+	t.Assert().NotEmpty(token.Data.RefreshToken)
+	t.Assert().NotEqual("test-refresh-token", token.Data.RefreshToken)
+	t.Assert().Greater(token.Data.ExpiresIn, 0)
+	t.Assert().LessOrEqual(token.Data.ExpiresIn, 3600)
 
 	var storedToken entity.Token
-	err = t.DB.Where("access_token = ?", response.JSON200.Data.AccessToken).Take(&storedToken).Error
+	err = t.DB.Where("access_token = ?", token.Data.AccessToken).Take(&storedToken).Error
 	t.Require().NoError(err)
-	t.Assert().Equal(response.JSON200.Data.RefreshToken, storedToken.RefreshToken)
+	t.Assert().Equal(token.Data.RefreshToken, storedToken.RefreshToken)
 	t.Assert().Equal(1, storedToken.CifNo)
 	t.Assert().Equal(testClientID, storedToken.ClientID)
 	t.Assert().True(storedToken.ExpiresAt.After(time.Now()))
@@ -122,7 +137,7 @@ func (t *AccountInfoTestSuite) getRefreshToken() string {
 
 func (t *AccountInfoTestSuite) waitForHealth(timeout time.Duration) error {
 	deadline := time.Now().Add(timeout)
-	url := pkg.GetEndpoint("health")
+	url := pkg.GetEndpoint("readyz")
 	for time.Now().Before(deadline) {
 		resp, err := http.Get(url)
 		if err == nil {
@@ -140,9 +155,15 @@ func (t *AccountInfoTestSuite) cleanupDatabase() error {
 	if t.DB == nil {
 		return nil
 	}
+	if err := t.DB.Exec("DELETE FROM consents").Error; err != nil {
+		return err
+	}
 	if err := t.DB.Exec("DELETE FROM tokens").Error; err != nil {
 		return err
 	}
+	if err := t.DB.Exec("DELETE FROM client_secrets").Error; err != nil {
+		return err
+	}
 	if err := t.DB.Exec("DELETE FROM clients").Error; err != nil {
 		return err
 	}
@@ -179,10 +200,10 @@ func (t *AccountInfoTestSuite) seedDatabase() error {
 	}
 
 	if err := t.DB.Create(&entity.Client{
-		ClientID:     testClientID,
-		ClientSecret: secretHash,
-		ClientName:   "Test Client",
-		Scope:        "read:account_and_transactions",
+		ClientID:   testClientID,
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
+		Secrets:    []entity.ClientSecret{{SecretHash: secretHash, CreatedAt: time.Now()}},
 	}).Error; err != nil {
 		return err
 	}
@@ -228,5 +249,16 @@ func (t *AccountInfoTestSuite) seedDatabase() error {
 		return err
 	}
 
+	if err := t.DB.Create(&entity.Consent{
+		CifNo:      1,
+		ClientID:   testClientID,
+		Scope:      "read:account_and_transactions",
+		AccountIDs: "1",
+		CreatedAt:  time.Now(),
+		ExpiresAt:  time.Now().Add(entity.ConsentTTL),
+	}).Error; err != nil {
+		return err
+	}
+
 	return nil
 }
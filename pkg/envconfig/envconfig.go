@@ -0,0 +1,118 @@
+// Package envconfig は構造体タグ env に従って環境変数で設定を上書きする。
+//
+// `env:"DB_PASSWORD"` のフィールドは DB_PASSWORD のほか、DB_PASSWORD_FILE に指定したファイルの内容でも上書きできる。
+// 対応する型は string / int / bool / time.Duration / []string（カンマ区切り）と、それらを含む構造体。
+package envconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FileSuffix を付けた環境変数にはファイルのパスを指定する（Docker / Kubernetes の secret 向け）
+const FileSuffix = "_FILE"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Apply は v（構造体へのポインタ）のうち、環境変数が設定されているフィールドを上書きする。
+// 値を解釈できないものはまとめてエラーにする。
+func Apply(v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return errors.New("envconfig: v must be a pointer to a struct")
+	}
+	return errors.Join(apply(value.Elem())...)
+}
+
+func apply(value reflect.Value) []error {
+	var errs []error
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		key := field.Tag.Get("env")
+		if key == "" {
+			if field.Type.Kind() == reflect.Struct && field.Type != durationType {
+				errs = append(errs, apply(value.Field(i))...)
+			}
+			continue
+		}
+		raw, ok, err := lookup(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if err := set(value.Field(i), raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+	return errs
+}
+
+// lookup は key か key_FILE の値を返す。両方が設定されている場合はどちらを使うか曖昧なためエラーにする。
+func lookup(key string) (string, bool, error) {
+	raw, ok := os.LookupEnv(key)
+	path, fileOK := os.LookupEnv(key + FileSuffix)
+	if !fileOK {
+		return raw, ok, nil
+	}
+	if ok {
+		return "", false, fmt.Errorf("%s and %s%s are both set", key, key, FileSuffix)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("%s%s: %w", key, FileSuffix, err)
+	}
+	// エディタや echo が付けた末尾の改行はパスワードの一部として扱わない
+	return strings.TrimRight(string(content), "\r\n"), true, nil
+}
+
+func set(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", raw)
+		}
+		field.SetInt(int64(d))
+		return nil
+	}
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		field.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %s", field.Type())
+		}
+		var list []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		field.Set(reflect.ValueOf(list))
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return nil
+}
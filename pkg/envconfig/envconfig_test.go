@@ -0,0 +1,74 @@
+package envconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPool struct {
+	MaxConns int           `env:"TEST_MAX_CONNS"`
+	Lifetime time.Duration `env:"TEST_LIFETIME"`
+}
+
+type testConfig struct {
+	Host     string   `env:"TEST_HOST"`
+	Password string   `env:"TEST_PASSWORD"`
+	Enabled  bool     `env:"TEST_ENABLED"`
+	Origins  []string `env:"TEST_ORIGINS"`
+	Pool     testPool
+	Untagged string
+}
+
+func TestApply(t *testing.T) {
+	t.Setenv("TEST_HOST", "db.internal")
+	t.Setenv("TEST_ENABLED", "true")
+	t.Setenv("TEST_ORIGINS", "https://a.example, https://b.example,")
+	t.Setenv("TEST_MAX_CONNS", "10")
+	t.Setenv("TEST_LIFETIME", "90s")
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte("s3cret\n"), 0o600))
+	t.Setenv("TEST_PASSWORD_FILE", passwordFile)
+
+	config := testConfig{Host: "localhost", Untagged: "kept"}
+	require.NoError(t, Apply(&config))
+
+	assert.Equal(t, testConfig{
+		Host:     "db.internal",
+		Password: "s3cret",
+		Enabled:  true,
+		Origins:  []string{"https://a.example", "https://b.example"},
+		Pool:     testPool{MaxConns: 10, Lifetime: 90 * time.Second},
+		Untagged: "kept",
+	}, config)
+}
+
+func TestApplyKeepsUnsetFields(t *testing.T) {
+	config := testConfig{Host: "localhost", Pool: testPool{MaxConns: 25}}
+	require.NoError(t, Apply(&config))
+	assert.Equal(t, testConfig{Host: "localhost", Pool: testPool{MaxConns: 25}}, config)
+}
+
+func TestApplyErrors(t *testing.T) {
+	t.Setenv("TEST_MAX_CONNS", "many")
+	t.Setenv("TEST_ENABLED", "yes please")
+	t.Setenv("TEST_PASSWORD", "inline")
+	t.Setenv("TEST_PASSWORD_FILE", "/does/not/matter")
+
+	err := Apply(&testConfig{})
+	require.Error(t, err)
+	// どの環境変数が誤っているかをまとめて返す
+	assert.ErrorContains(t, err, `TEST_MAX_CONNS: invalid integer "many"`)
+	assert.ErrorContains(t, err, `TEST_ENABLED: invalid boolean "yes please"`)
+	assert.ErrorContains(t, err, "TEST_PASSWORD and TEST_PASSWORD_FILE are both set")
+	assert.NotContains(t, err.Error(), "inline")
+}
+
+func TestApplyMissingFile(t *testing.T) {
+	t.Setenv("TEST_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, Apply(&testConfig{}), "TEST_PASSWORD_FILE")
+}
@@ -0,0 +1,93 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go-banking-api/pkg/logger"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusUnavailable  = "unavailable"
+	StatusShuttingDown = "shutting_down"
+	StatusError        = "error"
+)
+
+// Check は依存先が使えない場合にエラーを返す
+type Check func(ctx context.Context) error
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+func (r Report) Ready() bool {
+	return r.Status == StatusOK
+}
+
+// Readiness は登録した依存先をまとめて確認する。シャットダウンを始めた後は確認せずに準備できていないと答える。
+type Readiness struct {
+	timeout      time.Duration
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// NewReadiness は依存先ごとに timeout で打ち切って確認する Readiness を返す
+func NewReadiness(timeout time.Duration) *Readiness {
+	return &Readiness{timeout: timeout}
+}
+
+// Add は name の依存先として check を登録する。確認を始める前に呼び出すこと。
+func (r *Readiness) Add(name string, check Check) {
+	r.checks = append(r.checks, namedCheck{name: name, check: check})
+}
+
+// ShutDown は以降の確認を失敗させ、ロードバランサーに新しいリクエストを振り分けないよう知らせる
+func (r *Readiness) ShutDown() {
+	r.shuttingDown.Store(true)
+}
+
+// Check は登録した依存先を並行して確認する。失敗の詳細は外部に返さずログに残す。
+func (r *Readiness) Check(ctx context.Context) Report {
+	report := Report{Status: StatusOK, Components: map[string]ComponentStatus{}}
+	if r.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+		return report
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range r.checks {
+		wg.Add(1)
+		go func(c namedCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+			status := ComponentStatus{Status: StatusOK}
+			if err := c.check(checkCtx); err != nil {
+				logger.WarnContext(ctx, "readiness check failed", "component", c.name, "error", err)
+				status.Status = StatusError
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			report.Components[c.name] = status
+			if status.Status != StatusOK {
+				report.Status = StatusUnavailable
+			}
+		}(c)
+	}
+	wg.Wait()
+	return report
+}
@@ -0,0 +1,63 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-banking-api/pkg/health"
+)
+
+func TestReadinessAllOK(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Add("database", func(context.Context) error { return nil })
+	readiness.Add("migrations", func(context.Context) error { return nil })
+
+	report := readiness.Check(context.Background())
+	assert.True(t, report.Ready())
+	assert.Equal(t, map[string]health.ComponentStatus{
+		"database":   {Status: health.StatusOK},
+		"migrations": {Status: health.StatusOK},
+	}, report.Components)
+}
+
+func TestReadinessComponentFails(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	readiness.Add("database", func(context.Context) error { return errors.New("connection refused") })
+	readiness.Add("migrations", func(context.Context) error { return nil })
+
+	report := readiness.Check(context.Background())
+	assert.False(t, report.Ready())
+	assert.Equal(t, health.StatusUnavailable, report.Status)
+	assert.Equal(t, health.StatusError, report.Components["database"].Status)
+	assert.Equal(t, health.StatusOK, report.Components["migrations"].Status)
+}
+
+func TestReadinessTimeout(t *testing.T) {
+	readiness := health.NewReadiness(10 * time.Millisecond)
+	readiness.Add("database", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	report := readiness.Check(context.Background())
+	assert.False(t, report.Ready())
+	assert.Equal(t, health.StatusError, report.Components["database"].Status)
+}
+
+func TestReadinessShutDown(t *testing.T) {
+	readiness := health.NewReadiness(time.Second)
+	called := false
+	readiness.Add("database", func(context.Context) error {
+		called = true
+		return nil
+	})
+
+	readiness.ShutDown()
+	report := readiness.Check(context.Background())
+	assert.Equal(t, health.StatusShuttingDown, report.Status)
+	assert.False(t, called)
+}
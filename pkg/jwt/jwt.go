@@ -0,0 +1,120 @@
+// Package jwt は ID トークンの署名（RS256）とクライアントが署名したリクエストオブジェクトの検証に使う JWS、
+// 公開鍵の JWK を扱う。
+package jwt
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const AlgorithmRS256 = "RS256"
+
+// minKeyBits より短い鍵は受け付けない
+const minKeyBits = 2048
+
+// JWK は RSA または楕円曲線の公開鍵（RFC 7517）
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	KeyID     string `json:"kid,omitempty"`
+	// N / E は RSA の鍵、Curve / X / Y は楕円曲線の鍵の値
+	N     string `json:"n,omitempty"`
+	E     string `json:"e,omitempty"`
+	Curve string `json:"crv,omitempty"`
+	X     string `json:"x,omitempty"`
+	Y     string `json:"y,omitempty"`
+}
+
+type Signer struct {
+	key   *rsa.PrivateKey
+	keyID string
+}
+
+// NewSigner は PEM（PKCS #1 または PKCS #8）の RSA 秘密鍵で署名する Signer を返す。
+// kid は公開鍵の JWK Thumbprint（RFC 7638）とし、鍵を入れ替えると変わる。
+func NewSigner(pemBytes []byte) (*Signer, error) {
+	key, err := parseRSAPrivateKey(pemBytes)
+	if err != nil {
+		return nil, err
+	}
+	if key.N.BitLen() < minKeyBits {
+		return nil, fmt.Errorf("jwt: RSA key must be at least %d bits", minKeyBits)
+	}
+	signer := &Signer{key: key}
+	signer.keyID = thumbprint(signer.PublicKey())
+	return signer, nil
+}
+
+func parseRSAPrivateKey(pemBytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("jwt: no PEM data found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("jwt: not an RSA private key")
+	}
+	return key, nil
+}
+
+// Sign は claims を JSON にして RS256 で署名した JWS Compact Serialization を返す
+func (s *Signer) Sign(claims any) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": AlgorithmRS256, "typ": "JWT", "kid": s.keyID})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encode(header) + "." + encode(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + encode(signature), nil
+}
+
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// PublicKey は署名の検証に使う公開鍵を返す
+func (s *Signer) PublicKey() JWK {
+	return JWK{
+		KeyType:   "RSA",
+		Use:       "sig",
+		Algorithm: AlgorithmRS256,
+		KeyID:     s.keyID,
+		N:         encode(s.key.N.Bytes()),
+		E:         encode(big.NewInt(int64(s.key.E)).Bytes()),
+	}
+}
+
+// thumbprint は必須のメンバーだけを辞書順に並べた JSON の SHA-256（RFC 7638）
+func thumbprint(key JWK) string {
+	digest := sha256.Sum256([]byte(`{"e":"` + key.E + `","kty":"RSA","n":"` + key.N + `"}`))
+	return encode(digest[:])
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,88 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateKey(t *testing.T, bits int) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	return key
+}
+
+func pkcs8PEM(t *testing.T, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestSign(t *testing.T) {
+	key := generateKey(t, 2048)
+	signer, err := NewSigner(pkcs8PEM(t, key))
+	require.NoError(t, err)
+
+	token, err := signer.Sign(map[string]any{"sub": "subject", "exp": 1700000000})
+	require.NoError(t, err)
+
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	var header map[string]string
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(headerJSON, &header))
+	assert.Equal(t, map[string]string{"alg": "RS256", "typ": "JWT", "kid": signer.KeyID()}, header)
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"sub":"subject","exp":1700000000}`, string(payload))
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+}
+
+func TestPublicKey(t *testing.T) {
+	key := generateKey(t, 2048)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	signer, err := NewSigner(pkcs1)
+	require.NoError(t, err)
+
+	jwk := signer.PublicKey()
+	assert.Equal(t, "RSA", jwk.KeyType)
+	assert.Equal(t, "sig", jwk.Use)
+	assert.Equal(t, "RS256", jwk.Algorithm)
+	assert.Equal(t, "AQAB", jwk.E)
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	require.NoError(t, err)
+	assert.Equal(t, 0, new(big.Int).SetBytes(n).Cmp(key.N))
+
+	// 同じ鍵なら形式によらず kid は変わらない
+	other, err := NewSigner(pkcs8PEM(t, key))
+	require.NoError(t, err)
+	assert.Equal(t, signer.KeyID(), other.KeyID())
+	assert.Equal(t, jwk.KeyID, signer.KeyID())
+}
+
+func TestNewSignerErrors(t *testing.T) {
+	_, err := NewSigner([]byte("not a key"))
+	assert.ErrorContains(t, err, "no PEM data")
+
+	_, err = NewSigner(pkcs8PEM(t, generateKey(t, 1024)))
+	assert.ErrorContains(t, err, "at least 2048 bits")
+}
@@ -0,0 +1,169 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+const (
+	AlgorithmPS256 = "PS256"
+	AlgorithmES256 = "ES256"
+)
+
+// ErrInvalidToken は理由を付けてラップして返す
+var ErrInvalidToken = errors.New("jwt: invalid token")
+
+// JWKSet は公開鍵の集合（RFC 7517 の JWK Set）
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// ParseJWKSet は JWK Set の JSON を読み込む。署名の検証に使えない鍵が含まれる場合はエラーを返す。
+func ParseJWKSet(data []byte) (*JWKSet, error) {
+	var set JWKSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("jwt: invalid JWK Set: %w", err)
+	}
+	if set.Keys == nil {
+		return nil, errors.New("jwt: invalid JWK Set: keys is required")
+	}
+	for i, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			return nil, fmt.Errorf("jwt: key %d: use must be sig", i)
+		}
+		if _, err := key.publicKey(); err != nil {
+			return nil, fmt.Errorf("jwt: key %d: %w", i, err)
+		}
+	}
+	return &set, nil
+}
+
+func (k JWK) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid n: %w", err)
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid e")
+		}
+		if n.BitLen() < minKeyBits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minKeyBits)
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Curve != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid x or y")
+		}
+		key, err := ecdsa.ParseUncompressedPublicKey(elliptic.P256(), append(append([]byte{4}, x...), y...))
+		if err != nil {
+			return nil, err
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) == 0 {
+		return nil, errors.New("empty value")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// Verify は JWS Compact Serialization の token の署名を keys のいずれかで検証し、ペイロードを返す。
+// alg が algorithms に含まれない場合（none を含む）は検証しない。ヘッダーに kid がある場合はその鍵だけを使う。
+func Verify(token string, keys []JWK, algorithms []string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed JWS", ErrInvalidToken)
+	}
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+	var header struct {
+		Algorithm string   `json:"alg"`
+		KeyID     string   `json:"kid"`
+		Critical  []string `json:"crit"`
+	}
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, fmt.Errorf("%w: malformed header", ErrInvalidToken)
+	}
+	if !slices.Contains(algorithms, header.Algorithm) {
+		return nil, fmt.Errorf("%w: unsupported alg %q", ErrInvalidToken, header.Algorithm)
+	}
+	// 拡張ヘッダーは扱わないため、crit がある場合は検証できない（RFC 7515 4.1.11）
+	if header.Critical != nil {
+		return nil, fmt.Errorf("%w: unsupported crit header", ErrInvalidToken)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", ErrInvalidToken)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidToken)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	for _, key := range keys {
+		if header.KeyID != "" && key.KeyID != header.KeyID {
+			continue
+		}
+		if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+			continue
+		}
+		if verifySignature(key, header.Algorithm, digest[:], signature) {
+			return payload, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: signature verification failed", ErrInvalidToken)
+}
+
+// verifySignature は SHA-256 の digest に対する署名を検証する。鍵の種類が alg と合わない場合は false を返す。
+func verifySignature(key JWK, algorithm string, digest []byte, signature []byte) bool {
+	publicKey, err := key.publicKey()
+	if err != nil {
+		return false
+	}
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch algorithm {
+		case AlgorithmRS256:
+			return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest, signature) == nil
+		case AlgorithmPS256:
+			return rsa.VerifyPSS(publicKey, crypto.SHA256, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil
+		}
+	case *ecdsa.PublicKey:
+		// JWS の ES256 の署名は ASN.1 ではなく r と s を 32 バイトずつ連結したもの（RFC 7518 3.4）
+		if algorithm == AlgorithmES256 && len(signature) == 64 {
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+			return ecdsa.Verify(publicKey, digest, r, s)
+		}
+	}
+	return false
+}
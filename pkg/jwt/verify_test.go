@@ -0,0 +1,148 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signJWS は RS256 以外の署名を作るためのテスト用のヘルパー
+func signJWS(t *testing.T, header map[string]any, payload string, key crypto.Signer) string {
+	t.Helper()
+	headerJSON, err := json.Marshal(header)
+	require.NoError(t, err)
+	signingInput := encode(headerJSON) + "." + encode([]byte(payload))
+	digest := sha256.Sum256([]byte(signingInput))
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPSS(rand.Reader, key, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		require.NoError(t, err)
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signingInput + "." + encode(signature)
+}
+
+func rsaJWK(key *rsa.PrivateKey, kid string) JWK {
+	return JWK{KeyType: "RSA", KeyID: kid, N: encode(key.N.Bytes()), E: encode(big.NewInt(int64(key.E)).Bytes())}
+}
+
+func ecJWK(t *testing.T, key *ecdsa.PrivateKey, kid string) JWK {
+	t.Helper()
+	point, err := key.PublicKey.Bytes()
+	require.NoError(t, err)
+	return JWK{KeyType: "EC", KeyID: kid, Curve: "P-256", X: encode(point[1:33]), Y: encode(point[33:])}
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey := generateKey(t, 2048)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keys := []JWK{rsaJWK(rsaKey, "rsa-1"), ecJWK(t, ecKey, "ec-1")}
+	algorithms := []string{AlgorithmPS256, AlgorithmES256}
+
+	payload, err := Verify(signJWS(t, map[string]any{"alg": "PS256", "kid": "rsa-1"}, `{"iss":"client"}`, rsaKey), keys, algorithms)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iss":"client"}`, string(payload))
+
+	// kid がない場合はすべての鍵を試す
+	payload, err = Verify(signJWS(t, map[string]any{"alg": "ES256"}, `{"iss":"client"}`, ecKey), keys, algorithms)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"iss":"client"}`, string(payload))
+
+	// Signer の RS256 も algorithms に含めれば検証できる
+	signer, err := NewSigner(pkcs8PEM(t, rsaKey))
+	require.NoError(t, err)
+	token, err := signer.Sign(map[string]string{"sub": "subject"})
+	require.NoError(t, err)
+	_, err = Verify(token, []JWK{signer.PublicKey()}, []string{AlgorithmRS256})
+	assert.NoError(t, err)
+}
+
+func TestVerifyErrors(t *testing.T) {
+	rsaKey := generateKey(t, 2048)
+	otherKey := generateKey(t, 2048)
+	keys := []JWK{rsaJWK(rsaKey, "rsa-1")}
+	algorithms := []string{AlgorithmPS256, AlgorithmES256}
+	valid := signJWS(t, map[string]any{"alg": "PS256", "kid": "rsa-1"}, `{"iss":"client"}`, rsaKey)
+	signer, err := NewSigner(pkcs8PEM(t, rsaKey))
+	require.NoError(t, err)
+	rs256, err := signer.Sign(map[string]string{"iss": "client"})
+	require.NoError(t, err)
+
+	tests := map[string]string{
+		"malformed":      "a.b",
+		"alg none":       encode([]byte(`{"alg":"none"}`)) + "." + encode([]byte(`{"iss":"client"}`)) + ".",
+		"alg not listed": rs256,
+		"unknown kid":    signJWS(t, map[string]any{"alg": "PS256", "kid": "rsa-2"}, `{"iss":"client"}`, rsaKey),
+		"other key":      signJWS(t, map[string]any{"alg": "PS256"}, `{"iss":"client"}`, otherKey),
+		"tampered":       valid[:len(valid)-4] + "AAAA",
+		"crit":           signJWS(t, map[string]any{"alg": "PS256", "crit": []string{"exp"}}, `{"iss":"client"}`, rsaKey),
+		"wrong key alg":  signJWS(t, map[string]any{"alg": "ES256", "kid": "rsa-1"}, `{"iss":"client"}`, rsaKey),
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Verify(token, keys, algorithms)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestParseJWKSet(t *testing.T) {
+	rsaKey := generateKey(t, 2048)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	data, err := json.Marshal(JWKSet{Keys: []JWK{rsaJWK(rsaKey, "rsa-1"), ecJWK(t, ecKey, "ec-1")}})
+	require.NoError(t, err)
+
+	set, err := ParseJWKSet(data)
+	require.NoError(t, err)
+	require.Len(t, set.Keys, 2)
+	assert.Equal(t, "ec-1", set.Keys[1].KeyID)
+
+	set, err = ParseJWKSet([]byte(`{"keys":[]}`))
+	require.NoError(t, err)
+	assert.Empty(t, set.Keys)
+}
+
+func TestParseJWKSetErrors(t *testing.T) {
+	smallKey := generateKey(t, 1024)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	point, err := ecKey.PublicKey.Bytes()
+	require.NoError(t, err)
+	p384 := JWK{KeyType: "EC", Curve: "P-384", X: encode(point[1:49]), Y: encode(point[49:])}
+	offCurve := JWK{KeyType: "EC", Curve: "P-256", X: encode(make([]byte, 32)), Y: encode(make([]byte, 32))}
+
+	tests := map[string]any{
+		"not json":   "keys",
+		"no keys":    map[string]any{},
+		"small RSA":  JWKSet{Keys: []JWK{rsaJWK(smallKey, "small")}},
+		"P-384":      JWKSet{Keys: []JWK{p384}},
+		"off curve":  JWKSet{Keys: []JWK{offCurve}},
+		"oct":        JWKSet{Keys: []JWK{{KeyType: "oct"}}},
+		"encryption": JWKSet{Keys: []JWK{{KeyType: "RSA", Use: "enc", N: base64.RawURLEncoding.EncodeToString(smallKey.N.Bytes()), E: "AQAB"}}},
+		"missing e":  JWKSet{Keys: []JWK{{KeyType: "RSA", N: encode(generateKey(t, 2048).N.Bytes())}}},
+	}
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(value)
+			require.NoError(t, err)
+			_, err = ParseJWKSet(data)
+			assert.ErrorContains(t, err, "jwt: ")
+		})
+	}
+}
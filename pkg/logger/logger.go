@@ -1,8 +1,10 @@
 package logger
 
 import (
+	"context"
 	"os"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -11,18 +13,47 @@ var (
 	zapSugaredLogger *zap.SugaredLogger
 )
 
+// Config はログの出力先などの設定
+type Config struct {
+	// File を指定すると標準エラー出力に加えてファイルにも書き出す
+	File string `yaml:"file" env:"APP_LOG_FILE"`
+	// SubjectHashKey は HashSubject の鍵。未設定なら起動ごとにランダムな鍵を使う。
+	SubjectHashKey string `yaml:"subject_hash_key" env:"LOG_SUBJECT_HASH_KEY" secret:"true"`
+}
+
+// init では設定ファイルを読み込む前のログのために環境変数だけで組み立てる
 func init() {
+	if err := Setup(os.Getenv("APP_ENV") == "development", Config{
+		File:           os.Getenv("APP_LOG_FILE"),
+		SubjectHashKey: os.Getenv("LOG_SUBJECT_HASH_KEY"),
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// Setup は config でロガーを作り直す。他のゴルーチンがログを書き出す前に呼び出すこと。
+func Setup(development bool, config Config) error {
 	cfg := zap.NewProductionConfig()
-	logFile := os.Getenv("APP_LOG_FILE")
-	if logFile != "" {
-		cfg.OutputPaths = []string{"stderr", logFile}
+	if development {
+		cfg = zap.NewDevelopmentConfig()
+	}
+	if config.File != "" {
+		cfg.OutputPaths = []string{"stderr", config.File}
 	}
 
-	ZapLogger = zap.Must(cfg.Build())
-	if os.Getenv("APP_ENV") == "development" {
-		ZapLogger = zap.Must(zap.NewDevelopment())
+	// どの出力先にもトークンや個人情報を書き出さないよう、常に伏せ字処理を挟む
+	built, err := cfg.Build(zap.WrapCore(NewRedactingCore))
+	if err != nil {
+		return err
 	}
+	key, err := subjectHashKeyFrom(config.SubjectHashKey)
+	if err != nil {
+		return err
+	}
+	ZapLogger = built
 	zapSugaredLogger = ZapLogger.Sugar()
+	subjectHashKey = key
+	return nil
 }
 
 func Sync() {
@@ -55,3 +86,51 @@ func Fatal(msg string, keysAndValues ...interface{}) {
 func Panic(msg string, keysAndValues ...interface{}) {
 	zapSugaredLogger.Panicw(msg, keysAndValues...)
 }
+
+type fieldsKey struct{}
+
+// With は ctx 以降のログに付与する項目を追加したコンテキストを返す
+func With(ctx context.Context, fields ...zap.Field) context.Context {
+	current, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	merged := make([]zap.Field, 0, len(current)+len(fields))
+	merged = append(merged, current...)
+	merged = append(merged, fields...)
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// Fields は ctx に紐づくログ項目を返す。With で追加した項目と、スパンがあればトレース ID を含む。
+func Fields(ctx context.Context) []zap.Field {
+	fields, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return fields
+	}
+	return append(fields[:len(fields):len(fields)],
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+	)
+}
+
+func withContext(ctx context.Context) *zap.SugaredLogger {
+	fields := Fields(ctx)
+	if len(fields) == 0 {
+		return zapSugaredLogger
+	}
+	return zapSugaredLogger.Desugar().With(fields...).Sugar()
+}
+
+func InfoContext(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	withContext(ctx).Infow(msg, keysAndValues...)
+}
+
+func DebugContext(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	withContext(ctx).Debugw(msg, keysAndValues...)
+}
+
+func WarnContext(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	withContext(ctx).Warnw(msg, keysAndValues...)
+}
+
+func ErrorContext(ctx context.Context, msg string, keysAndValues ...interface{}) {
+	withContext(ctx).Errorw(msg, keysAndValues...)
+}
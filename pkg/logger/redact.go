@@ -0,0 +1,180 @@
+package logger
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const Redacted = "[REDACTED]"
+
+// 項目名で値ごと伏せるもの（小文字にして "_" と "-" を除いた名前で比較する）
+var sensitiveKeys = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"setcookie":     true,
+	"token":         true,
+	"accesstoken":   true,
+	"refreshtoken":  true,
+	"idtoken":       true,
+	"usercode":      true,
+	"devicecode":    true,
+	"code":          true,
+	"codeverifier":  true,
+	"secret":        true,
+	"clientsecret":  true,
+	"password":      true,
+	"email":         true,
+	"phone":         true,
+	"accountnumber": true,
+	"balance":       true,
+	"namekana":      true,
+	"namekanji":     true,
+	"birthdate":     true,
+}
+
+// 自前で採番した ID など、形で検出すると誤って伏せてしまうもの
+var safeKeys = map[string]bool{
+	"request_id": true,
+	"trace_id":   true,
+	"span_id":    true,
+	"subject":    true,
+	"time":       true,
+}
+
+type redactPattern struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// 文字列中から値の形で検出して伏せるもの。上から順に適用する。
+var redactPatterns = []redactPattern{
+	// Authorization ヘッダーの資格情報
+	{regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9\-._~+/]+=*`), "$1 " + Redacted},
+	// クエリ文字列やフォームの key=value
+	{regexp.MustCompile(`(?i)\b((?:access|refresh|id)_?token|token|client_?secret|password|(?:user|device)_?code|code(?:_?verifier)?)=[^&\s"]+`), "$1=" + Redacted},
+	// JSON の "key": "value"
+	{regexp.MustCompile(`(?i)"((?:access|refresh|id)_?token|client_?secret|password|(?:user|device)_?code|code(?:_?verifier)?)"\s*:\s*"[^"]*"`), `"$1":"` + Redacted + `"`},
+	// ユーザーコードや認可の ID を含むパス（/device-verifications/{userCode} と /authorization-verifications/{authorizationId}）
+	{regexp.MustCompile(`(/(?:device|authorization)-verifications/)[^/?#\s"]+`), "${1}" + Redacted},
+	// JWT
+	{regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`), Redacted},
+	// 発行するトークンのようなランダム文字列（トレース ID の 32 文字より長いもの）
+	{regexp.MustCompile(`\b[A-Za-z0-9_-]{40,}\b`), Redacted},
+	{regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`), Redacted},
+	// 電話番号（国内表記と +81 表記）
+	{regexp.MustCompile(`(?:\+81[-\s]?|\b0)\d{1,4}-?\d{1,4}-?\d{4}\b`), Redacted},
+	// 口座番号（7 桁）
+	{regexp.MustCompile(`\b\d{7}\b`), Redacted},
+}
+
+// Redact は文字列中のトークン・メールアドレス・電話番号・口座番号を伏せる
+func Redact(value string) string {
+	for _, p := range redactPatterns {
+		value = p.pattern.ReplaceAllString(value, p.replacement)
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	key = strings.NewReplacer("_", "", "-", "").Replace(key)
+	return sensitiveKeys[key]
+}
+
+// NewRedactingCore は書き出す前にメッセージと項目を伏せる Core でラップする
+func NewRedactingCore(core zapcore.Core) zapcore.Core {
+	return redactingCore{Core: core}
+}
+
+type redactingCore struct {
+	zapcore.Core
+}
+
+func (c redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+func (c redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = Redact(entry.Message)
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		redacted[i] = redactField(field)
+	}
+	return redacted
+}
+
+func redactField(field zapcore.Field) zapcore.Field {
+	if isSensitiveKey(field.Key) {
+		return zap.String(field.Key, Redacted)
+	}
+	if safeKeys[field.Key] {
+		return field
+	}
+	switch field.Type {
+	case zapcore.StringType:
+		return zap.String(field.Key, Redact(field.String))
+	case zapcore.ByteStringType:
+		return zap.String(field.Key, Redact(string(field.Interface.([]byte))))
+	case zapcore.ErrorType:
+		return zap.String(field.Key, Redact(field.Interface.(error).Error()))
+	case zapcore.StringerType:
+		return zap.String(field.Key, Redact(field.Interface.(interface{ String() string }).String()))
+	case zapcore.ObjectMarshalerType:
+		encoder := zapcore.NewMapObjectEncoder()
+		if err := field.Interface.(zapcore.ObjectMarshaler).MarshalLogObject(encoder); err != nil {
+			return zap.String(field.Key, Redacted)
+		}
+		return zap.Any(field.Key, redactValue(encoder.Fields))
+	case zapcore.ReflectType:
+		// 構造体などは JSON に変換してから項目名と値で伏せる
+		data, err := json.Marshal(field.Interface)
+		if err != nil {
+			return zap.String(field.Key, Redacted)
+		}
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return zap.String(field.Key, Redacted)
+		}
+		return zap.Any(field.Key, redactValue(value))
+	}
+	return field
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return Redact(v)
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				redacted[key] = Redacted
+				continue
+			}
+			redacted[key] = redactValue(item)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = redactValue(item)
+		}
+		return redacted
+	}
+	return value
+}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+var subjectHashKey []byte
+
+// 未設定なら起動ごとにランダムな鍵を使う（再起動をまたいだ突き合わせはできない）
+func subjectHashKeyFrom(configured string) ([]byte, error) {
+	if configured != "" {
+		return []byte(configured), nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// HashSubject は CIF 番号などの利用者を特定できる値を、ログに出せるよう鍵付きハッシュにする
+func HashSubject(subject string) string {
+	mac := hmac.New(sha256.New, subjectHashKey)
+	mac.Write([]byte(subject))
+	return hex.EncodeToString(mac.Sum(nil))[:16]
+}
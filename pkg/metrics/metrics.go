@@ -0,0 +1,153 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "banking"
+
+// Registry は /metrics で公開するメトリクスの登録先
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests by OpenAPI operationId, method and status code.",
+	}, []string{"operation", "method", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by OpenAPI operationId, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "method", "status"})
+
+	tokensIssuedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tokens_issued_total",
+		Help:      "Number of access tokens issued by grant type.",
+	}, []string{"grant_type"})
+
+	tokenRefreshFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_refresh_failures_total",
+		Help:      "Number of failed token refreshes by reason.",
+	}, []string{"reason"})
+
+	tokenExchangeFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_exchange_failures_total",
+		Help:      "Number of failed exchanges of authorization codes and device codes by grant type and reason.",
+	}, []string{"grant_type", "reason"})
+
+	tokenValidationFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_validation_failures_total",
+		Help:      "Number of rejected access tokens by reason.",
+	}, []string{"reason"})
+
+	clientAuthFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "client_auth_failures_total",
+		Help:      "Number of failed client authentications by reason.",
+	}, []string{"reason"})
+
+	rateLimitedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "Number of requests rejected by the rate limiter by scope.",
+	}, []string{"scope"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		tokensIssuedTotal,
+		tokenRefreshFailuresTotal,
+		tokenExchangeFailuresTotal,
+		tokenValidationFailuresTotal,
+		clientAuthFailuresTotal,
+		rateLimitedTotal,
+	)
+}
+
+// Handler は Registry を Prometheus のテキスト形式で返す http.Handler
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+func ObserveHTTPRequest(operation string, method string, status string, duration time.Duration) {
+	httpRequestsTotal.WithLabelValues(operation, method, status).Inc()
+	httpRequestDuration.WithLabelValues(operation, method, status).Observe(duration.Seconds())
+}
+
+func TokenIssued(grantType string) {
+	tokensIssuedTotal.WithLabelValues(grantType).Inc()
+}
+
+func TokenRefreshFailed(reason string) {
+	tokenRefreshFailuresTotal.WithLabelValues(reason).Inc()
+}
+
+func TokenExchangeFailed(grantType string, reason string) {
+	tokenExchangeFailuresTotal.WithLabelValues(grantType, reason).Inc()
+}
+
+func TokenValidationFailed(reason string) {
+	tokenValidationFailuresTotal.WithLabelValues(reason).Inc()
+}
+
+func ClientAuthFailed(reason string) {
+	clientAuthFailuresTotal.WithLabelValues(reason).Inc()
+}
+
+func RateLimited(scope string) {
+	rateLimitedTotal.WithLabelValues(scope).Inc()
+}
+
+// RegisterDBStats は DB コネクションプールの統計を name ラベル付きで公開する
+func RegisterDBStats(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// CacheStats はキャッシュのヒット数・ミス数などを返す
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Size      int
+}
+
+// RegisterCache は stats が返すキャッシュの統計を name ラベル付きで公開する
+func RegisterCache(name string, stats func() CacheStats) error {
+	labels := prometheus.Labels{"cache": name}
+	for _, collector := range []prometheus.Collector{
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace, Name: "cache_hits_total", Help: "Number of cache hits.", ConstLabels: labels,
+		}, func() float64 { return float64(stats().Hits) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace, Name: "cache_misses_total", Help: "Number of cache misses.", ConstLabels: labels,
+		}, func() float64 { return float64(stats().Misses) }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: namespace, Name: "cache_evictions_total", Help: "Number of entries evicted from the cache.", ConstLabels: labels,
+		}, func() float64 { return float64(stats().Evictions) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace, Name: "cache_entries", Help: "Number of entries in the cache.", ConstLabels: labels,
+		}, func() float64 { return float64(stats().Size) }),
+	} {
+		if err := Registry.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
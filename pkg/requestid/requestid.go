@@ -0,0 +1,16 @@
+package requestid
+
+import "context"
+
+type key struct{}
+
+// With は ctx にリクエスト ID を設定する
+func With(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, key{}, requestID)
+}
+
+// FromContext は ctx のリクエスト ID を返す。設定されていなければ空文字列。
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(key{}).(string)
+	return requestID
+}
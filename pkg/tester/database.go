@@ -0,0 +1,67 @@
+package tester
+
+import (
+	"context"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/database/migration"
+)
+
+// OpenSQLiteDB は path の SQLite ファイルを開いてマイグレーションを適用する。
+// conformance.NewGormBackend に渡せるよう、後片付けの関数（SQLite では nil）も返す。
+func OpenSQLiteDB(path string) (*gorm.DB, func() error, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	return migrate(db, nil)
+}
+
+// OpenMySQLDB は MySQL のコンテナを起動してマイグレーションを適用し、コンテナを止める関数とともに返す
+func OpenMySQLDB() (*gorm.DB, func() error, error) {
+	ctx := context.Background()
+	container, err := startMySQLContainer(ctx, database.NewConfigMySQL())
+	if err != nil {
+		return nil, nil, err
+	}
+	terminate := func() error { return container.Terminate(ctx) }
+	db, err := database.NewDatabaseSQLFactory(database.InstanceMySQL)
+	if err != nil {
+		_ = terminate()
+		return nil, nil, err
+	}
+	return migrate(db, terminate)
+}
+
+// OpenPostgresDB は PostgreSQL のコンテナを起動してマイグレーションを適用し、コンテナを止める関数とともに返す
+func OpenPostgresDB() (*gorm.DB, func() error, error) {
+	ctx := context.Background()
+	container, err := startPostgresContainer(ctx, database.NewConfigPostgres())
+	if err != nil {
+		return nil, nil, err
+	}
+	terminate := func() error { return container.Terminate(ctx) }
+	db, err := database.NewDatabaseSQLFactory(database.InstancePostgres)
+	if err != nil {
+		_ = terminate()
+		return nil, nil, err
+	}
+	return migrate(db, terminate)
+}
+
+func migrate(db *gorm.DB, terminate func() error) (*gorm.DB, func() error, error) {
+	migrator, err := migration.NewMigrator(db)
+	if err == nil {
+		_, err = migrator.Up()
+	}
+	if err != nil {
+		if terminate != nil {
+			_ = terminate()
+		}
+		return nil, nil, err
+	}
+	return db, terminate, nil
+}
@@ -11,8 +11,8 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 	"gorm.io/gorm"
 
-	"go-banking-api/entity"
 	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/database/migration"
 	"go-banking-api/pkg"
 )
 
@@ -27,6 +27,14 @@ func (suite *DBMySQLSuite) SetupTestContainers() (err error) {
 	configs := database.NewConfigMySQL()
 	pkg.WaitForPort(configs.Database, configs.Port, 10*time.Second)
 	suite.ctx = context.Background()
+	suite.mySQLContainer, err = startMySQLContainer(suite.ctx, configs)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	return nil
+}
+
+func startMySQLContainer(ctx context.Context, configs *database.Config) (testcontainers.Container, error) {
 	req := testcontainers.ContainerRequest{
 		Image: "mysql:8",
 		Env: map[string]string{
@@ -38,15 +46,10 @@ func (suite *DBMySQLSuite) SetupTestContainers() (err error) {
 		ExposedPorts: []string{fmt.Sprintf("%s:3306/tcp", configs.Port)},
 		WaitingFor:   wait.ForLog("port: 3306  MySQL Community Server"),
 	}
-
-	suite.mySQLContainer, err = testcontainers.GenericContainer(suite.ctx, testcontainers.GenericContainerRequest{
+	return testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: req,
 		Started:          true,
 	})
-	if err != nil {
-		log.Fatal(err.Error())
-	}
-	return nil
 }
 
 func (suite *DBMySQLSuite) SetupSuite() {
@@ -56,10 +59,10 @@ func (suite *DBMySQLSuite) SetupSuite() {
 	db, err := database.NewDatabaseSQLFactory(database.InstanceMySQL)
 	suite.Assert().Nil(err)
 	suite.DB = db
-	for _, model := range entity.NewDomains() {
-		err = suite.DB.AutoMigrate(model)
-		suite.Assert().Nil(err)
-	}
+	migrator, err := migration.NewMigrator(suite.DB)
+	suite.Require().NoError(err)
+	_, err = migrator.Up()
+	suite.Require().NoError(err)
 }
 
 func (suite *DBMySQLSuite) TearDownSuite() {
@@ -0,0 +1,71 @@
+package tester
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+	"gorm.io/gorm"
+
+	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/database/migration"
+	"go-banking-api/pkg"
+)
+
+type DBPostgresSuite struct {
+	suite.Suite
+	postgresContainer testcontainers.Container
+	ctx               context.Context
+	DB                *gorm.DB
+}
+
+func (suite *DBPostgresSuite) SetupTestContainers() (err error) {
+	configs := database.NewConfigPostgres()
+	pkg.WaitForPort(configs.Host, configs.Port, 10*time.Second)
+	suite.ctx = context.Background()
+	suite.postgresContainer, err = startPostgresContainer(suite.ctx, configs)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	return nil
+}
+
+func startPostgresContainer(ctx context.Context, configs *database.Config) (testcontainers.Container, error) {
+	req := testcontainers.ContainerRequest{
+		Image: "postgres:16",
+		Env: map[string]string{
+			"POSTGRES_DB":       configs.Database,
+			"POSTGRES_USER":     configs.User,
+			"POSTGRES_PASSWORD": configs.Password,
+		},
+		ExposedPorts: []string{fmt.Sprintf("%s:5432/tcp", configs.Port)},
+		WaitingFor:   wait.ForLog("database system is ready to accept connections").WithOccurrence(2),
+	}
+	return testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+}
+
+func (suite *DBPostgresSuite) SetupSuite() {
+	err := suite.SetupTestContainers()
+	suite.Assert().Nil(err)
+
+	db, err := database.NewDatabaseSQLFactory(database.InstancePostgres)
+	suite.Assert().Nil(err)
+	suite.DB = db
+	migrator, err := migration.NewMigrator(suite.DB)
+	suite.Require().NoError(err)
+	_, err = migrator.Up()
+	suite.Require().NoError(err)
+}
+
+func (suite *DBPostgresSuite) TearDownSuite() {
+	if suite.postgresContainer != nil {
+		_ = suite.postgresContainer.Terminate(suite.ctx)
+	}
+}
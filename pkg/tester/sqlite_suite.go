@@ -7,8 +7,8 @@ import (
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
-	"go-banking-api/entity"
 	"go-banking-api/infrastructure/database"
+	"go-banking-api/infrastructure/database/migration"
 )
 
 type DBSQLiteSuite struct {
@@ -26,10 +26,10 @@ func (suite *DBSQLiteSuite) SetupSuite() {
 	suite.Assert().Nil(err)
 	suite.DB = db
 
-	for _, model := range entity.NewDomains() {
-		err := suite.DB.AutoMigrate(model)
-		suite.Assert().Nil(err)
-	}
+	migrator, err := migration.NewMigrator(suite.DB)
+	suite.Require().NoError(err)
+	_, err = migrator.Up()
+	suite.Require().NoError(err)
 }
 
 func (suite *DBSQLiteSuite) TearDownSuite() {
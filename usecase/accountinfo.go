@@ -1,10 +1,15 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"go-banking-api/adapter/gateway"
 	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tracing"
 
 	"gorm.io/gorm"
 )
@@ -26,33 +31,55 @@ type AccountInfo struct {
 }
 
 type AccountInfoUsecase interface {
-	Get(cifNo int) (*AccountInfo, error)
+	// Get は clientID からの cifNo の口座情報の参照として監査ログに記録する。
+	// 顧客が clientID に同意した口座でなければ ErrAccountNotFound を返す。
+	Get(ctx context.Context, clientID string, cifNo int) (*AccountInfo, error)
 }
 
 type accountInfoUsecase struct {
 	customerRepository gateway.CustomerRepository
 	accountRepository  gateway.AccountRepository
+	consentRepository  gateway.ConsentRepository
+	auditRepository    gateway.AuditRepository
+	clock              pkg.Clock
 }
 
 func NewAccountInfoUsecase(
 	customerRepository gateway.CustomerRepository,
 	accountRepository gateway.AccountRepository,
+	consentRepository gateway.ConsentRepository,
+	auditRepository gateway.AuditRepository,
+	clock pkg.Clock,
 ) *accountInfoUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
 	return &accountInfoUsecase{
 		customerRepository: customerRepository,
 		accountRepository:  accountRepository,
+		consentRepository:  consentRepository,
+		auditRepository:    auditRepository,
+		clock:              clock,
 	}
 }
 
-func (a *accountInfoUsecase) Get(cifNo int) (*AccountInfo, error) {
-	customer, err := a.customerRepository.Get(cifNo)
+func (a *accountInfoUsecase) Get(ctx context.Context, clientID string, cifNo int) (_ *AccountInfo, err error) {
+	ctx, span := tracing.Start(ctx, "AccountInfoUsecase.Get")
+	defer func() {
+		if err != nil {
+			recordAuditEvent(ctx, a.auditRepository, newAuditEvent(ctx, a.clock, entity.AuditOperationAccountRead, clientID, cifNo, err))
+		}
+		tracing.End(span, err)
+	}()
+
+	customer, err := a.customerRepository.Get(ctx, cifNo)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrAccountNotFound
 		}
 		return nil, err
 	}
-	account, err := a.accountRepository.Get(cifNo)
+	account, err := a.accountRepository.Get(ctx, cifNo)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrAccountNotFound
@@ -62,6 +89,13 @@ func (a *accountInfoUsecase) Get(cifNo int) (*AccountInfo, error) {
 	if !account.IsActive() {
 		return nil, ErrAccountInactive
 	}
+	if err := a.checkConsentedAccount(ctx, clientID, cifNo, account.Id); err != nil {
+		return nil, err
+	}
+	// 記録できない場合は顧客情報を返さない
+	if err := a.auditRepository.Append(ctx, newAuditEvent(ctx, a.clock, entity.AuditOperationAccountRead, clientID, cifNo, nil)); err != nil {
+		return nil, err
+	}
 	return &AccountInfo{
 		NameKana:      customer.NameKana,
 		NameKanji:     customer.NameKanji,
@@ -73,3 +107,22 @@ func (a *accountInfoUsecase) Get(cifNo int) (*AccountInfo, error) {
 		Balance:       account.Balance,
 	}, nil
 }
+
+// checkConsentedAccount は同意が有効で、accountID が同意した口座に含まれることを確認する。
+// 期限切れや取り消した同意、同意の後に開設した口座は、同意し直すまで参照させない。口座の有無がわからないよう ErrAccountNotFound を返す。
+func (a *accountInfoUsecase) checkConsentedAccount(ctx context.Context, clientID string, cifNo int, accountID int) error {
+	consent, err := a.consentRepository.Get(ctx, cifNo, clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: no consent", ErrAccountNotFound)
+		}
+		return err
+	}
+	if !consent.IsActive(a.clock.Now()) {
+		return fmt.Errorf("%w: consent is %s", ErrAccountNotFound, consent.Status(a.clock.Now()))
+	}
+	if !slices.Contains(consent.AccountIDList(), accountID) {
+		return fmt.Errorf("%w: not covered by the consent", ErrAccountNotFound)
+	}
+	return nil
+}
@@ -1,13 +1,17 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
 
 	"go-banking-api/entity"
+	"go-banking-api/pkg"
 )
 
 type mockCustomerRepository struct {
@@ -26,7 +30,7 @@ func NewMockAccountRepository() *mockAccountRepository {
 	return &mockAccountRepository{}
 }
 
-func (m *mockCustomerRepository) Get(cifNo int) (*entity.Customer, error) {
+func (m *mockCustomerRepository) Get(_ context.Context, cifNo int) (*entity.Customer, error) {
 	args := m.Called(cifNo)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -34,7 +38,7 @@ func (m *mockCustomerRepository) Get(cifNo int) (*entity.Customer, error) {
 	return args.Get(0).(*entity.Customer), args.Error(1)
 }
 
-func (m *mockAccountRepository) Get(cifNo int) (*entity.Account, error) {
+func (m *mockAccountRepository) Get(_ context.Context, cifNo int) (*entity.Account, error) {
 	args := m.Called(cifNo)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -42,6 +46,14 @@ func (m *mockAccountRepository) Get(cifNo int) (*entity.Account, error) {
 	return args.Get(0).(*entity.Account), args.Error(1)
 }
 
+func (m *mockAccountRepository) List(_ context.Context, cifNo int) ([]entity.Account, error) {
+	args := m.Called(cifNo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Account), args.Error(1)
+}
+
 type AccountInfoUseCaseSuite struct {
 	suite.Suite
 	accountInfoUseCase *accountInfoUsecase
@@ -65,12 +77,15 @@ func (suite *AccountInfoUseCaseSuite) TestGet() {
 	balance := int64(10000)
 	mockCustomerRepository := NewMockCustomerRepository()
 	mockAccountRepository := NewMockAccountRepository()
-	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository)
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, nil)
 	mockCustomerRepository.On("Get", 1).Return(&entity.Customer{
 		NameKana:  nameKana,
 		NameKanji: nameKanji,
 	}, nil)
 	mockAccountRepository.On("Get", 1).Return(&entity.Account{
+		Id:            10,
 		Status:        status,
 		BranchCode:    branchCode,
 		AccountNumber: accountNumber,
@@ -78,8 +93,9 @@ func (suite *AccountInfoUseCaseSuite) TestGet() {
 		Currency:      currency,
 		Balance:       balance,
 	}, nil)
+	mockConsentRepository.On("Get", 1, "client-1").Return(&entity.Consent{AccountIDs: "10 11", ExpiresAt: time.Now().Add(time.Hour)}, nil)
 
-	accountInfo, err := suite.accountInfoUseCase.Get(1)
+	accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", 1)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(&AccountInfo{
 		NameKana:      nameKana,
@@ -98,11 +114,13 @@ func (suite *AccountInfoUseCaseSuite) TestGetCustomerRepositoryError() {
 	expectedErr := errors.New("customer error")
 	mockCustomerRepository := NewMockCustomerRepository()
 	mockAccountRepository := NewMockAccountRepository()
-	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository)
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, nil)
 
 	mockCustomerRepository.On("Get", 1).Return(nil, expectedErr)
 
-	accountInfo, err := suite.accountInfoUseCase.Get(1)
+	accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", 1)
 	suite.Assert().Nil(accountInfo)
 	suite.Assert().Equal(expectedErr, err)
 }
@@ -111,7 +129,9 @@ func (suite *AccountInfoUseCaseSuite) TestGetAccountRepositoryError() {
 	expectedErr := errors.New("account error")
 	mockCustomerRepository := NewMockCustomerRepository()
 	mockAccountRepository := NewMockAccountRepository()
-	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository)
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, nil)
 
 	mockCustomerRepository.On("Get", 1).Return(&entity.Customer{
 		NameKana:  "Taro Tanaka",
@@ -119,7 +139,7 @@ func (suite *AccountInfoUseCaseSuite) TestGetAccountRepositoryError() {
 	}, nil)
 	mockAccountRepository.On("Get", 1).Return(nil, expectedErr)
 
-	accountInfo, err := suite.accountInfoUseCase.Get(1)
+	accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", 1)
 	suite.Assert().Nil(accountInfo)
 	suite.Assert().Equal(expectedErr, err)
 }
@@ -127,7 +147,9 @@ func (suite *AccountInfoUseCaseSuite) TestGetAccountRepositoryError() {
 func (suite *AccountInfoUseCaseSuite) TestGetAccountNotActive() {
 	mockCustomerRepository := NewMockCustomerRepository()
 	mockAccountRepository := NewMockAccountRepository()
-	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository)
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, nil)
 
 	mockCustomerRepository.On("Get", 1).Return(&entity.Customer{
 		NameKana:  "Taro Tanaka",
@@ -137,7 +159,52 @@ func (suite *AccountInfoUseCaseSuite) TestGetAccountNotActive() {
 		Status: entity.AccountStatusClosed,
 	}, nil)
 
-	accountInfo, err := suite.accountInfoUseCase.Get(1)
+	accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", 1)
 	suite.Assert().Nil(accountInfo)
 	suite.Assert().ErrorIs(err, ErrAccountInactive)
 }
+
+func (suite *AccountInfoUseCaseSuite) TestGetAccountNotConsented() {
+	mockCustomerRepository := NewMockCustomerRepository()
+	mockAccountRepository := NewMockAccountRepository()
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, nil)
+
+	mockCustomerRepository.On("Get", 1).Return(&entity.Customer{NameKana: "Taro Tanaka"}, nil)
+	mockCustomerRepository.On("Get", 2).Return(&entity.Customer{NameKana: "Hanako Tanaka"}, nil)
+	mockAccountRepository.On("Get", 1).Return(&entity.Account{Id: 10, Status: entity.AccountStatusActive}, nil)
+	mockAccountRepository.On("Get", 2).Return(&entity.Account{Id: 20, Status: entity.AccountStatusActive}, nil)
+	// 同意の後に開設した口座は含まない
+	mockConsentRepository.On("Get", 1, "client-1").Return(&entity.Consent{AccountIDs: "11", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	mockConsentRepository.On("Get", 2, "client-1").Return(nil, gorm.ErrRecordNotFound)
+
+	for _, cifNo := range []int{1, 2} {
+		accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", cifNo)
+		suite.Assert().Nil(accountInfo)
+		suite.Assert().ErrorIs(err, ErrAccountNotFound)
+	}
+}
+
+func (suite *AccountInfoUseCaseSuite) TestGetConsentNotActive() {
+	now := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+	mockCustomerRepository := NewMockCustomerRepository()
+	mockAccountRepository := NewMockAccountRepository()
+	mockConsentRepository := NewMockConsentRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.accountInfoUseCase = NewAccountInfoUsecase(mockCustomerRepository, mockAccountRepository, mockConsentRepository, mockAuditRepository, pkg.FixedClock{T: now})
+
+	for _, cifNo := range []int{1, 2} {
+		mockCustomerRepository.On("Get", cifNo).Return(&entity.Customer{NameKana: "Taro Tanaka"}, nil)
+		mockAccountRepository.On("Get", cifNo).Return(&entity.Account{Id: 10, Status: entity.AccountStatusActive}, nil)
+	}
+	// 期限切れや取り消した同意では、同意した口座でも参照させない
+	mockConsentRepository.On("Get", 1, "client-1").Return(&entity.Consent{AccountIDs: "10", ExpiresAt: now}, nil)
+	mockConsentRepository.On("Get", 2, "client-1").Return(&entity.Consent{AccountIDs: "10", ExpiresAt: now.Add(time.Hour), RevokedAt: &now}, nil)
+
+	for _, cifNo := range []int{1, 2} {
+		accountInfo, err := suite.accountInfoUseCase.Get(context.Background(), "client-1", cifNo)
+		suite.Assert().Nil(accountInfo)
+		suite.Assert().ErrorIs(err, ErrAccountNotFound)
+	}
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/requestid"
+	"go-banking-api/pkg/tracing"
+)
+
+const (
+	AuditReadScope = "read:audit_log"
+	// AuditListDefaultLimit は limit を指定しない場合の件数（OpenAPI の limit のデフォルト値と合わせる）
+	AuditListDefaultLimit = 100
+
+	auditListMaxLimit   = 1000
+	auditVerifyPageSize = 1000
+	// audit_events.client_id の長さ
+	auditClientIDMaxLength = 255
+)
+
+var (
+	ErrAuditChainBroken    = errors.New("audit chain is broken")
+	ErrAuditAnchorNotFound = errors.New("audit anchor hash not found")
+	ErrInvalidAuditFilter  = errors.New("invalid audit filter")
+)
+
+// AuditVerification はハッシュチェーンの検証結果
+type AuditVerification struct {
+	Count    int
+	LastID   int64
+	LastHash string
+}
+
+type AuditUsecase interface {
+	// List は filter に一致する監査ログを返し、clientID による閲覧自体も監査ログに記録する
+	List(ctx context.Context, clientID string, filter gateway.AuditFilter) ([]entity.AuditEvent, error)
+	// Verify は先頭からハッシュチェーンをたどって改ざんがないことを確認する。
+	// anchorHash を指定した場合は、そのハッシュのイベントがチェーンに含まれていることも確認する。
+	Verify(ctx context.Context, anchorHash string) (*AuditVerification, error)
+}
+
+type auditUsecase struct {
+	auditRepository gateway.AuditRepository
+	clock           pkg.Clock
+}
+
+func NewAuditUsecase(auditRepository gateway.AuditRepository, clock pkg.Clock) *auditUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &auditUsecase{
+		auditRepository: auditRepository,
+		clock:           clock,
+	}
+}
+
+func (a *auditUsecase) List(ctx context.Context, clientID string, filter gateway.AuditFilter) (_ []entity.AuditEvent, err error) {
+	ctx, span := tracing.Start(ctx, "AuditUsecase.List")
+	defer func() { tracing.End(span, err) }()
+
+	if filter.Limit == 0 {
+		filter.Limit = AuditListDefaultLimit
+	}
+	if filter.Limit < 0 || filter.Limit > auditListMaxLimit || filter.AfterID < 0 || filter.CifNo < 0 {
+		return nil, ErrInvalidAuditFilter
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, ErrInvalidAuditFilter
+	}
+
+	// 記録できない場合は監査ログを返さない
+	if err := a.auditRepository.Append(ctx, newAuditEvent(ctx, a.clock, entity.AuditOperationAuditRead, clientID, filter.CifNo, nil)); err != nil {
+		return nil, err
+	}
+	return a.auditRepository.List(ctx, filter)
+}
+
+func (a *auditUsecase) Verify(ctx context.Context, anchorHash string) (_ *AuditVerification, err error) {
+	ctx, span := tracing.Start(ctx, "AuditUsecase.Verify")
+	defer func() { tracing.End(span, err) }()
+
+	result := &AuditVerification{}
+	anchorFound := anchorHash == ""
+	for {
+		events, err := a.auditRepository.List(ctx, gateway.AuditFilter{AfterID: result.LastID, Limit: auditVerifyPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.PrevHash != result.LastHash {
+				return result, fmt.Errorf("%w: event %d does not follow event %d", ErrAuditChainBroken, event.ID, result.LastID)
+			}
+			if event.ComputeHash() != event.Hash {
+				return result, fmt.Errorf("%w: event %d has been modified", ErrAuditChainBroken, event.ID)
+			}
+			result.Count++
+			result.LastID = event.ID
+			result.LastHash = event.Hash
+			if event.Hash == anchorHash {
+				anchorFound = true
+			}
+		}
+		if len(events) < auditVerifyPageSize {
+			break
+		}
+	}
+
+	// 末尾のイベントを削除された場合はチェーンだけでは検出できないため、外部に控えたハッシュと照合する
+	if !anchorFound {
+		return result, ErrAuditAnchorNotFound
+	}
+	return result, nil
+}
+
+// newAuditEvent は ctx のリクエスト ID を付けた監査イベントを作る。err が nil なら成功として扱う。
+func newAuditEvent(ctx context.Context, clock pkg.Clock, operation entity.AuditOperation, clientID string, cifNo int, err error) *entity.AuditEvent {
+	if len(clientID) > auditClientIDMaxLength {
+		clientID = clientID[:auditClientIDMaxLength]
+	}
+	event := &entity.AuditEvent{
+		OccurredAt: clock.Now(),
+		Operation:  operation,
+		Outcome:    entity.AuditOutcomeSuccess,
+		ClientID:   clientID,
+		CifNo:      cifNo,
+		RequestID:  requestid.FromContext(ctx),
+	}
+	if err != nil {
+		event.Outcome = entity.AuditOutcomeFailure
+		event.Reason = failureReason(err)
+	}
+	return event
+}
+
+// recordAuditEvent は失敗やロックなどのイベントをベストエフォートで記録する。記録できなくても元の処理を優先し、ログに残すだけにする。
+func recordAuditEvent(ctx context.Context, auditRepository gateway.AuditRepository, event *entity.AuditEvent) {
+	if err := auditRepository.Append(ctx, event); err != nil {
+		logger.ErrorContext(ctx, "failed to record audit event", "operation", event.Operation, "error", err)
+	}
+}
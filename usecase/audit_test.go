@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/requestid"
+)
+
+// mockAuditRepository は追記されたイベントをメモリに保持する
+type mockAuditRepository struct {
+	events     []entity.AuditEvent
+	lastFilter gateway.AuditFilter
+	err        error
+}
+
+func NewMockAuditRepository() *mockAuditRepository {
+	return &mockAuditRepository{}
+}
+
+func (m *mockAuditRepository) Append(_ context.Context, event *entity.AuditEvent) error {
+	if m.err != nil {
+		return m.err
+	}
+	prevHash := ""
+	if len(m.events) > 0 {
+		prevHash = m.events[len(m.events)-1].Hash
+	}
+	event.ID = int64(len(m.events) + 1)
+	event.Chain(prevHash)
+	m.events = append(m.events, *event)
+	return nil
+}
+
+func (m *mockAuditRepository) List(_ context.Context, filter gateway.AuditFilter) ([]entity.AuditEvent, error) {
+	m.lastFilter = filter
+	var events []entity.AuditEvent
+	for _, event := range m.events {
+		if event.ID > filter.AfterID && (filter.Limit == 0 || len(events) < filter.Limit) {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+type AuditUsecaseSuite struct {
+	suite.Suite
+	clock           pkg.Clock
+	auditRepository *mockAuditRepository
+	auditUsecase    *auditUsecase
+}
+
+func TestAuditUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditUsecaseSuite))
+}
+
+func (suite *AuditUsecaseSuite) SetupTest() {
+	suite.clock = pkg.FixedClock{T: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.auditRepository = NewMockAuditRepository()
+	suite.auditUsecase = NewAuditUsecase(suite.auditRepository, suite.clock)
+}
+
+func (suite *AuditUsecaseSuite) appendEvents(n int) {
+	for i := 0; i < n; i++ {
+		event := newAuditEvent(context.Background(), suite.clock, entity.AuditOperationAccountRead, "client-1", 1, nil)
+		suite.Require().NoError(suite.auditRepository.Append(context.Background(), event))
+	}
+}
+
+func (suite *AuditUsecaseSuite) TestListRecordsAccess() {
+	suite.appendEvents(2)
+	ctx := requestid.With(context.Background(), "req-1")
+
+	events, err := suite.auditUsecase.List(ctx, "compliance", gateway.AuditFilter{CifNo: 1})
+	suite.Require().NoError(err)
+	suite.Assert().Len(events, 3)
+	suite.Assert().Equal(AuditListDefaultLimit, suite.auditRepository.lastFilter.Limit)
+
+	access := suite.auditRepository.events[2]
+	suite.Assert().Equal(entity.AuditOperationAuditRead, access.Operation)
+	suite.Assert().Equal(entity.AuditOutcomeSuccess, access.Outcome)
+	suite.Assert().Equal("compliance", access.ClientID)
+	suite.Assert().Equal(1, access.CifNo)
+	suite.Assert().Equal("req-1", access.RequestID)
+}
+
+func (suite *AuditUsecaseSuite) TestListInvalidFilter() {
+	from := suite.clock.Now()
+	for _, filter := range []gateway.AuditFilter{
+		{Limit: auditListMaxLimit + 1},
+		{Limit: -1},
+		{AfterID: -1},
+		{From: from, To: from},
+	} {
+		_, err := suite.auditUsecase.List(context.Background(), "compliance", filter)
+		suite.Assert().ErrorIs(err, ErrInvalidAuditFilter)
+	}
+	suite.Assert().Empty(suite.auditRepository.events)
+}
+
+func (suite *AuditUsecaseSuite) TestListAuditFailure() {
+	suite.auditRepository.err = errors.New("append error")
+
+	events, err := suite.auditUsecase.List(context.Background(), "compliance", gateway.AuditFilter{})
+	suite.Assert().Nil(events)
+	suite.Assert().EqualError(err, "append error")
+}
+
+func (suite *AuditUsecaseSuite) TestVerify() {
+	suite.appendEvents(3)
+
+	result, err := suite.auditUsecase.Verify(context.Background(), "")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(3, result.Count)
+	suite.Assert().Equal(int64(3), result.LastID)
+	suite.Assert().Equal(suite.auditRepository.events[2].Hash, result.LastHash)
+}
+
+func (suite *AuditUsecaseSuite) TestVerifyEmpty() {
+	result, err := suite.auditUsecase.Verify(context.Background(), "")
+	suite.Require().NoError(err)
+	suite.Assert().Zero(result.Count)
+}
+
+func (suite *AuditUsecaseSuite) TestVerifyModified() {
+	suite.appendEvents(3)
+	suite.auditRepository.events[1].Outcome = entity.AuditOutcomeFailure
+
+	result, err := suite.auditUsecase.Verify(context.Background(), "")
+	suite.Assert().ErrorIs(err, ErrAuditChainBroken)
+	suite.Assert().ErrorContains(err, "event 2 has been modified")
+	suite.Assert().Equal(1, result.Count)
+}
+
+func (suite *AuditUsecaseSuite) TestVerifyDeleted() {
+	suite.appendEvents(3)
+	suite.auditRepository.events = append(suite.auditRepository.events[:1], suite.auditRepository.events[2])
+
+	_, err := suite.auditUsecase.Verify(context.Background(), "")
+	suite.Assert().ErrorIs(err, ErrAuditChainBroken)
+	suite.Assert().ErrorContains(err, "event 3 does not follow event 1")
+}
+
+func (suite *AuditUsecaseSuite) TestVerifyAnchor() {
+	suite.appendEvents(3)
+	anchor := suite.auditRepository.events[2].Hash
+
+	_, err := suite.auditUsecase.Verify(context.Background(), anchor)
+	suite.Assert().NoError(err)
+
+	// 末尾を削除するとチェーン自体は正しいままだが、控えたハッシュが見つからない
+	suite.auditRepository.events = suite.auditRepository.events[:2]
+	_, err = suite.auditUsecase.Verify(context.Background(), anchor)
+	suite.Assert().ErrorIs(err, ErrAuditAnchorNotFound)
+}
+
+func (suite *AuditUsecaseSuite) TestNewAuditEventTruncatesClientID() {
+	clientID := string(make([]byte, auditClientIDMaxLength+10))
+	event := newAuditEvent(context.Background(), suite.clock, entity.AuditOperationClientAuthenticate, clientID, 0, ErrInvalidClient)
+	suite.Assert().Len(event.ClientID, auditClientIDMaxLength)
+	suite.Assert().Equal(entity.AuditOutcomeFailure, event.Outcome)
+	suite.Assert().Equal("invalid_client", event.Reason)
+}
+
+func (suite *AuditUsecaseSuite) TestAccountInfoGetRecordsRead() {
+	customerRepository := NewMockCustomerRepository()
+	accountRepository := NewMockAccountRepository()
+	customerRepository.On("Get", 1).Return(&entity.Customer{}, nil)
+	customerRepository.On("Get", 2).Return(nil, gorm.ErrRecordNotFound)
+	accountRepository.On("Get", 1).Return(&entity.Account{Id: 1, Status: entity.AccountStatusActive}, nil)
+	consentRepository := NewMockConsentRepository()
+	consentRepository.On("Get", 1, "client-1").Return(&entity.Consent{AccountIDs: "1", ExpiresAt: suite.clock.Now().Add(time.Hour)}, nil)
+	accountInfoUsecase := NewAccountInfoUsecase(customerRepository, accountRepository, consentRepository, suite.auditRepository, suite.clock)
+
+	_, err := accountInfoUsecase.Get(requestid.With(context.Background(), "req-1"), "client-1", 1)
+	suite.Require().NoError(err)
+	_, err = accountInfoUsecase.Get(context.Background(), "client-1", 2)
+	suite.Require().ErrorIs(err, ErrAccountNotFound)
+
+	suite.Require().Len(suite.auditRepository.events, 2)
+	success := suite.auditRepository.events[0]
+	suite.Assert().Equal(entity.AuditOperationAccountRead, success.Operation)
+	suite.Assert().Equal(entity.AuditOutcomeSuccess, success.Outcome)
+	suite.Assert().Equal("client-1", success.ClientID)
+	suite.Assert().Equal(1, success.CifNo)
+	suite.Assert().Equal("req-1", success.RequestID)
+	suite.Assert().True(suite.clock.Now().Equal(success.OccurredAt))
+	failure := suite.auditRepository.events[1]
+	suite.Assert().Equal(entity.AuditOutcomeFailure, failure.Outcome)
+	suite.Assert().Equal("account_not_found", failure.Reason)
+	suite.Assert().Equal(2, failure.CifNo)
+}
+
+func (suite *AuditUsecaseSuite) TestAccountInfoGetFailsWithoutAudit() {
+	customerRepository := NewMockCustomerRepository()
+	accountRepository := NewMockAccountRepository()
+	customerRepository.On("Get", 1).Return(&entity.Customer{}, nil)
+	accountRepository.On("Get", 1).Return(&entity.Account{Id: 1, Status: entity.AccountStatusActive}, nil)
+	consentRepository := NewMockConsentRepository()
+	consentRepository.On("Get", 1, "client-1").Return(&entity.Consent{AccountIDs: "1", ExpiresAt: suite.clock.Now().Add(time.Hour)}, nil)
+	suite.auditRepository.err = errors.New("append error")
+	accountInfoUsecase := NewAccountInfoUsecase(customerRepository, accountRepository, consentRepository, suite.auditRepository, suite.clock)
+
+	accountInfo, err := accountInfoUsecase.Get(context.Background(), "client-1", 1)
+	suite.Assert().Nil(accountInfo)
+	suite.Assert().EqualError(err, "append error")
+}
+
+func (suite *AuditUsecaseSuite) TestRefreshRecordsIssuance() {
+	tokenRepository := NewMockTokenRepository()
+	tokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(&entity.Token{ClientID: "client-1", CifNo: 1}, nil)
+	tokenRepository.On("GetByRefreshToken", "refresh-token-2").Return(&entity.Token{ClientID: "client-2", CifNo: 2}, nil)
+	tokenRepository.On("UpdateByRefreshToken", "refresh-token-1", mock.AnythingOfType("string"), mock.AnythingOfType("string"), suite.clock.Now().Add(accessTokenTTL)).Return(nil)
+	consentRepository := NewMockConsentRepository()
+	consentRepository.On("Get", 1, "client-1").Return(&entity.Consent{ExpiresAt: suite.clock.Now().Add(time.Hour)}, nil)
+	tokenUsecase := NewTokenUsecase(tokenRepository, suite.auditRepository, NewMockTransactionManager(tokenRepository, suite.auditRepository, consentRepository), nil, suite.clock)
+
+	_, err := tokenUsecase.Refresh(context.Background(), "refresh-token-1", refreshClient("client-1"))
+	suite.Require().NoError(err)
+	_, err = tokenUsecase.Refresh(context.Background(), "refresh-token-2", refreshClient("client-1"))
+	suite.Require().ErrorIs(err, ErrInvalidRefreshToken)
+
+	suite.Require().Len(suite.auditRepository.events, 2)
+	suite.Assert().Equal(entity.AuditOperationTokenRefresh, suite.auditRepository.events[0].Operation)
+	suite.Assert().Equal(entity.AuditOutcomeSuccess, suite.auditRepository.events[0].Outcome)
+	suite.Assert().Equal(1, suite.auditRepository.events[0].CifNo)
+	suite.Assert().Equal(entity.AuditOutcomeFailure, suite.auditRepository.events[1].Outcome)
+	suite.Assert().Equal("invalid_refresh_token", suite.auditRepository.events[1].Reason)
+	suite.Assert().Equal("client-1", suite.auditRepository.events[1].ClientID)
+	suite.Assert().Equal(2, suite.auditRepository.events[1].CifNo)
+}
+
+func (suite *AuditUsecaseSuite) TestClientAuthenticateRecordsFailure() {
+	clientRepository := NewMockClientRepository()
+	clientRepository.On("Get", "unknown").Return(nil, gorm.ErrRecordNotFound)
+	clientUsecase := newTestClientUsecase(clientRepository, suite.auditRepository, suite.clock)
+
+	_, err := clientUsecase.Authenticate(context.Background(), "unknown", "secret", "192.0.2.1")
+	suite.Require().ErrorIs(err, ErrInvalidClient)
+
+	suite.Require().Len(suite.auditRepository.events, 1)
+	event := suite.auditRepository.events[0]
+	suite.Assert().Equal(entity.AuditOperationClientAuthenticate, event.Operation)
+	suite.Assert().Equal(entity.AuditOutcomeFailure, event.Outcome)
+	suite.Assert().Equal("invalid_client", event.Reason)
+	suite.Assert().Equal("unknown", event.ClientID)
+}
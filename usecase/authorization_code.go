@@ -0,0 +1,375 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// authorizationTTL は顧客が同意画面で承認・拒否するまでの期限
+	authorizationTTL = 10 * time.Minute
+	// authorizationCodeTTL は承認してからトークンと交換するまでの期限。RFC 6749 4.1.2 では最大 10 分とされている。
+	authorizationCodeTTL = time.Minute
+	// codeVerifierCharset は RFC 7636 4.1 の code_verifier に使える文字
+	codeVerifierCharset   = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-._~"
+	codeVerifierMinLength = 43
+	codeVerifierMaxLength = 128
+)
+
+var (
+	// ErrAuthorizationNotFound は期限切れの場合や、承認・拒否済みの場合も返す
+	ErrAuthorizationNotFound     = errors.New("authorization not found")
+	ErrAuthorizationCodeRequired = errors.New("authorization code is required")
+	// ErrInvalidAuthorizationCode は使用済みの場合や他のクライアントの認可コードの場合、
+	// redirect_uri や code_verifier が一致しない場合も理由を付けてラップして返す
+	ErrInvalidAuthorizationCode = errors.New("invalid authorization code")
+	// ErrAuthorizationCodeReused は交換済みの認可コードが再び送られた場合に返す。漏えいしたものとして、交換で発行したトークンを失効させる。
+	ErrAuthorizationCodeReused = fmt.Errorf("%w: already exchanged", ErrInvalidAuthorizationCode)
+)
+
+// AuthorizationCodeDetail は顧客が承認する前に同意画面に表示する認可リクエスト
+type AuthorizationCodeDetail struct {
+	entity.AuthorizationCode
+	ClientName string
+}
+
+// AuthorizationCodeUsecase は認可コードフロー。クライアントは PAR で送った認可リクエストの request_uri で顧客を
+// /authorize に誘導し、顧客が銀行のアプリの同意画面で承認すると、redirect_uri で受け取った認可コードをトークンと交換する。
+type AuthorizationCodeUsecase interface {
+	// Authorize は clientID が PAR で送った requestURI の認可リクエストから、顧客の承認を待つ認可を作成する。
+	// request_uri は一度しか使えない。
+	Authorize(ctx context.Context, clientID string, requestURI string) (*entity.AuthorizationCode, error)
+	// Get は同意画面に表示する id の認可リクエストを返す
+	Get(ctx context.Context, id string) (*AuthorizationCodeDetail, error)
+	// Approve は cifNo の顧客が承認したものとし、要求されたスコープと顧客のすべての口座について同意を記録する。
+	// 返した認可の Code をクライアントに渡す。
+	Approve(ctx context.Context, cifNo int, id string) (*entity.AuthorizationCode, error)
+	// Deny は cifNo の顧客が拒否したものとする。返した認可の Code はクライアントに渡さないこと。
+	Deny(ctx context.Context, cifNo int, id string) (*entity.AuthorizationCode, error)
+	// Exchange は認証済みの client が送った認可コードを、redirect_uri と PKCE の code_verifier を確認してトークンと交換する。
+	// 認可コードは二度は使えない。再び送られた場合は、顧客がそのクライアントに発行したトークンを失効させる（RFC 6749 4.1.2）。
+	Exchange(ctx context.Context, client *entity.Client, code string, redirectURI string, codeVerifier string) (*entity.Token, error)
+}
+
+type authorizationCodeUsecase struct {
+	transactionManager TransactionManager
+	// auditRepository は交換の失敗をトランザクションの外で記録する
+	auditRepository            gateway.AuditRepository
+	pushedAuthorizationUsecase PushedAuthorizationUsecase
+	idTokenIssuer              IDTokenIssuer
+	clock                      pkg.Clock
+}
+
+func NewAuthorizationCodeUsecase(
+	transactionManager TransactionManager,
+	auditRepository gateway.AuditRepository,
+	pushedAuthorizationUsecase PushedAuthorizationUsecase,
+	idTokenIssuer IDTokenIssuer,
+	clock pkg.Clock,
+) *authorizationCodeUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &authorizationCodeUsecase{
+		transactionManager:         transactionManager,
+		auditRepository:            auditRepository,
+		pushedAuthorizationUsecase: pushedAuthorizationUsecase,
+		idTokenIssuer:              idTokenIssuer,
+		clock:                      clock,
+	}
+}
+
+func (a *authorizationCodeUsecase) Authorize(ctx context.Context, clientID string, requestURI string) (_ *entity.AuthorizationCode, err error) {
+	ctx, span := tracing.Start(ctx, "AuthorizationCodeUsecase.Authorize")
+	defer func() { tracing.End(span, err) }()
+
+	// PAR で検証済みのパラメーターだけを使う。FAPI のクライアントの署名付きリクエストオブジェクトも PAR で検証している。
+	pushed, err := a.pushedAuthorizationUsecase.Resolve(ctx, clientID, requestURI)
+	if err != nil {
+		return nil, err
+	}
+	id, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	code, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	now := a.clock.Now()
+	authorization := &entity.AuthorizationCode{
+		ID:                  id,
+		Code:                code,
+		ClientID:            pushed.ClientID,
+		RedirectURI:         pushed.RedirectURI,
+		Scope:               pushed.Scope,
+		State:               pushed.State,
+		Nonce:               pushed.Nonce,
+		CodeChallenge:       pushed.CodeChallenge,
+		CodeChallengeMethod: pushed.CodeChallengeMethod,
+		Status:              entity.AuthorizationCodeStatusPending,
+		CreatedAt:           now.UTC(),
+		ExpiresAt:           now.Add(authorizationTTL).UTC(),
+	}
+
+	err = a.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		// PAR の後に無効にしたクライアントや、認可コードフローを許可しなくなったクライアントには認可させない
+		client, err := repos.Client().Get(ctx, clientID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRequestURI
+			}
+			return err
+		}
+		if client.IsDisabled() || !client.AllowsGrantType(entity.GrantTypeAuthorizationCode) {
+			return ErrInvalidRequestURI
+		}
+		// 再利用を検出する期間が過ぎた交換済みの認可コードもここで消える
+		if _, err := repos.AuthorizationCode().DeleteExpired(ctx, now); err != nil {
+			return err
+		}
+		return repos.AuthorizationCode().Create(ctx, authorization)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+func (a *authorizationCodeUsecase) Get(ctx context.Context, id string) (_ *AuthorizationCodeDetail, err error) {
+	ctx, span := tracing.Start(ctx, "AuthorizationCodeUsecase.Get")
+	defer func() { tracing.End(span, err) }()
+
+	var detail *AuthorizationCodeDetail
+	err = a.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, client, err := a.pendingAuthorization(ctx, repos, id)
+		if err != nil {
+			return err
+		}
+		detail = &AuthorizationCodeDetail{AuthorizationCode: *authorization, ClientName: client.ClientName}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+func (a *authorizationCodeUsecase) Approve(ctx context.Context, cifNo int, id string) (_ *entity.AuthorizationCode, err error) {
+	ctx, span := tracing.Start(ctx, "AuthorizationCodeUsecase.Approve")
+	defer func() { tracing.End(span, err) }()
+
+	var authorization *entity.AuthorizationCode
+	err = a.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		var client *entity.Client
+		if authorization, client, err = a.pendingAuthorization(ctx, repos, id); err != nil {
+			return err
+		}
+		authorization.Status = entity.AuthorizationCodeStatusApproved
+		authorization.CifNo = cifNo
+		authorization.ExpiresAt = a.clock.Now().Add(authorizationCodeTTL).UTC()
+		if err := a.updateStatus(ctx, repos, authorization); err != nil {
+			return err
+		}
+		return grantConsent(ctx, repos, a.clock, client, authorization.Scope, cifNo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+func (a *authorizationCodeUsecase) Deny(ctx context.Context, cifNo int, id string) (_ *entity.AuthorizationCode, err error) {
+	ctx, span := tracing.Start(ctx, "AuthorizationCodeUsecase.Deny")
+	defer func() { tracing.End(span, err) }()
+
+	var authorization *entity.AuthorizationCode
+	err = a.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if authorization, _, err = a.pendingAuthorization(ctx, repos, id); err != nil {
+			return err
+		}
+		// 拒否したものは交換できないため、次に期限切れのものを削除するときに削除する
+		authorization.Status = entity.AuthorizationCodeStatusDenied
+		authorization.CifNo = cifNo
+		authorization.ExpiresAt = a.clock.Now().UTC()
+		return a.updateStatus(ctx, repos, authorization)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+// pendingAuthorization は id の承認待ちの認可と、その発行先のクライアントを返す
+func (a *authorizationCodeUsecase) pendingAuthorization(ctx context.Context, repos gateway.Repositories, id string) (*entity.AuthorizationCode, *entity.Client, error) {
+	if id == "" {
+		return nil, nil, ErrAuthorizationNotFound
+	}
+	authorization, err := repos.AuthorizationCode().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrAuthorizationNotFound
+		}
+		return nil, nil, err
+	}
+	if authorization.Status != entity.AuthorizationCodeStatusPending || authorization.IsExpired(a.clock.Now()) {
+		return nil, nil, ErrAuthorizationNotFound
+	}
+	// 無効にしたクライアントには承認させない
+	client, err := repos.Client().Get(ctx, authorization.ClientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrAuthorizationNotFound
+		}
+		return nil, nil, err
+	}
+	if client.IsDisabled() {
+		return nil, nil, ErrAuthorizationNotFound
+	}
+	return authorization, client, nil
+}
+
+func (a *authorizationCodeUsecase) updateStatus(ctx context.Context, repos gateway.Repositories, authorization *entity.AuthorizationCode) error {
+	// 同時に承認・拒否された場合は、先に更新したほうだけを成功させる
+	err := repos.AuthorizationCode().UpdateStatus(ctx, authorization.ID, authorization.Status, authorization.CifNo, authorization.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAuthorizationNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *authorizationCodeUsecase) Exchange(ctx context.Context, client *entity.Client, code string, redirectURI string, codeVerifier string) (_ *entity.Token, err error) {
+	ctx, span := tracing.Start(ctx, "AuthorizationCodeUsecase.Exchange")
+	// cifNo は承認済みの認可コードが見つかった場合のみ監査ログに残す
+	cifNo := 0
+	defer func() {
+		if err != nil {
+			metrics.TokenExchangeFailed(entity.GrantTypeAuthorizationCode, failureReason(err))
+			recordAuditEvent(ctx, a.auditRepository, newAuditEvent(ctx, a.clock, entity.AuditOperationTokenIssue, client.ClientID, cifNo, err))
+		}
+		tracing.End(span, err)
+	}()
+
+	if !client.AllowsGrantType(entity.GrantTypeAuthorizationCode) {
+		return nil, ErrUnauthorizedClient
+	}
+	if code == "" {
+		return nil, ErrAuthorizationCodeRequired
+	}
+	accessToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	now := a.clock.Now()
+
+	// 再利用されたときに失効させたトークンは残さないよう、トランザクションをコミットしてから reuseErr で返す
+	var token *entity.Token
+	var reuseErr error
+	err = a.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, err := repos.AuthorizationCode().GetByCode(ctx, code)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidAuthorizationCode
+			}
+			return err
+		}
+		if authorization.ClientID != client.ClientID {
+			logger.WarnContext(ctx, "authorization code presented by another client")
+			return ErrInvalidAuthorizationCode
+		}
+		cifNo = authorization.CifNo
+		if authorization.Status == entity.AuthorizationCodeStatusExchanged {
+			revoked, err := repos.Token().DeleteByCustomerAndClient(ctx, authorization.CifNo, client.ClientID)
+			if err != nil {
+				return err
+			}
+			logger.WarnContext(ctx, "authorization code reused", "revoked_tokens", revoked)
+			reuseErr = ErrAuthorizationCodeReused
+			return nil
+		}
+		// 承認前の認可コードはクライアントに渡していないため、承認済みでなければ漏えいしたものとして扱う
+		if authorization.Status != entity.AuthorizationCodeStatusApproved || authorization.IsExpired(now) {
+			return ErrInvalidAuthorizationCode
+		}
+		if redirectURI != authorization.RedirectURI {
+			return fmt.Errorf("%w: redirect_uri does not match the authorization request", ErrInvalidAuthorizationCode)
+		}
+		if !verifyCodeChallenge(codeVerifier, authorization.CodeChallenge) {
+			return fmt.Errorf("%w: code_verifier does not match the code_challenge", ErrInvalidAuthorizationCode)
+		}
+		// 承認後に顧客が同意を取り消した場合は交換させない
+		if err := checkConsent(ctx, repos, a.clock, authorization.CifNo, client.ClientID); err != nil {
+			if errors.Is(err, ErrConsentExpired) {
+				return fmt.Errorf("%w: %w", ErrInvalidAuthorizationCode, err)
+			}
+			return err
+		}
+		// 同時に交換された場合は、先に更新したほうだけを成功させる
+		if err := repos.AuthorizationCode().MarkExchanged(ctx, authorization.ID, now.Add(accessTokenTTL).UTC()); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidAuthorizationCode
+			}
+			return err
+		}
+		token = &entity.Token{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			Scopes:       authorization.Scope,
+			ExpiresAt:    now.Add(accessTokenTTL),
+			CifNo:        authorization.CifNo,
+			ClientID:     client.ClientID,
+		}
+		if a.idTokenIssuer != nil && token.HasScope(OpenIDScope) {
+			if token.IDToken, err = a.idTokenIssuer.Issue(authorization.CifNo, client.ClientID, authorization.Nonce); err != nil {
+				return err
+			}
+		}
+		if err := repos.Token().Create(ctx, token); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, a.clock, entity.AuditOperationTokenIssue, client.ClientID, authorization.CifNo, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reuseErr != nil {
+		return nil, reuseErr
+	}
+	metrics.TokenIssued("authorization_code")
+	logger.InfoContext(ctx, "token issued for authorization code")
+	return token, nil
+}
+
+// verifyCodeChallenge は code_verifier の SHA-256 を base64url にした値が S256 の code_challenge と一致するかどうか（RFC 7636 4.6）
+func verifyCodeChallenge(codeVerifier string, codeChallenge string) bool {
+	if len(codeVerifier) < codeVerifierMinLength || len(codeVerifier) > codeVerifierMaxLength {
+		return false
+	}
+	if strings.Trim(codeVerifier, codeVerifierCharset) != "" {
+		return false
+	}
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return subtle.ConstantTimeCompare([]byte(base64.RawURLEncoding.EncodeToString(sum[:])), []byte(codeChallenge)) == 1
+}
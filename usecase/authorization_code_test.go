@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+)
+
+// testCodeVerifier は RFC 7636 の最短の 43 文字
+const testCodeVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+
+// testS256CodeChallenge は testCodeVerifier の S256（RFC 7636 Appendix B）
+const testS256CodeChallenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+type AuthorizationCodeUsecaseSuite struct {
+	suite.Suite
+	clock                      *lockoutClock
+	store                      *inmemory.Store
+	client                     *entity.Client
+	signer                     *stubIDTokenSigner
+	pushedAuthorizationUsecase *pushedAuthorizationUsecase
+	authorizationCodeUsecase   *authorizationCodeUsecase
+}
+
+func TestAuthorizationCodeUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(AuthorizationCodeUsecaseSuite))
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) SetupTest() {
+	suite.clock = &lockoutClock{now: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.client = &entity.Client{
+		ClientID:     "client-1",
+		ClientName:   "Test Client",
+		Scope:        "openid read:account_and_transactions",
+		RedirectURIs: testRedirectURI,
+		GrantTypes:   entity.GrantTypeRefreshToken + " " + entity.GrantTypeAuthorizationCode,
+	}
+	suite.Require().NoError(suite.store.AddClient(*suite.client))
+	transactionManager := inmemory.NewTransactionManager(suite.store)
+	suite.signer = &stubIDTokenSigner{}
+	idTokenIssuer := NewIDTokenIssuer(OIDCConfig{Issuer: testIssuer, SubjectSecret: "subject-secret"}, suite.signer, suite.clock)
+	suite.pushedAuthorizationUsecase = NewPushedAuthorizationUsecase(transactionManager, testIssuer, suite.clock)
+	suite.authorizationCodeUsecase = NewAuthorizationCodeUsecase(transactionManager, suite.store.Audit(), suite.pushedAuthorizationUsecase, idTokenIssuer, suite.clock)
+}
+
+// authorize は PAR で認可リクエストを送り、その request_uri で認可を作成する
+func (suite *AuthorizationCodeUsecaseSuite) authorize() *entity.AuthorizationCode {
+	pushed, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{
+		ResponseType:        ResponseTypeCode,
+		RedirectURI:         testRedirectURI,
+		Scope:               "openid read:account_and_transactions",
+		State:               "state-1",
+		Nonce:               "nonce-1",
+		CodeChallenge:       testS256CodeChallenge,
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	})
+	suite.Require().NoError(err)
+	authorization, err := suite.authorizationCodeUsecase.Authorize(context.Background(), "client-1", pushed.RequestURI)
+	suite.Require().NoError(err)
+	return authorization
+}
+
+// approve は顧客が承認した認可コードを返す
+func (suite *AuthorizationCodeUsecaseSuite) approve() string {
+	approved, err := suite.authorizationCodeUsecase.Approve(context.Background(), inmemory.SeedCifNo, suite.authorize().ID)
+	suite.Require().NoError(err)
+	return approved.Code
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) exchange(code string) (*entity.Token, error) {
+	return suite.authorizationCodeUsecase.Exchange(context.Background(), suite.client, code, testRedirectURI, testCodeVerifier)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestApprove() {
+	ctx := context.Background()
+	authorization := suite.authorize()
+	suite.Equal(entity.AuthorizationCodeStatusPending, authorization.Status)
+	suite.Equal(suite.clock.now.Add(10*time.Minute), authorization.ExpiresAt)
+
+	detail, err := suite.authorizationCodeUsecase.Get(ctx, authorization.ID)
+	suite.Require().NoError(err)
+	suite.Equal("Test Client", detail.ClientName)
+	suite.Equal([]string{"openid", "read:account_and_transactions"}, detail.Scopes())
+
+	// 承認前の認可コードは交換できない
+	_, err = suite.exchange(authorization.Code)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+
+	approved, err := suite.authorizationCodeUsecase.Approve(ctx, inmemory.SeedCifNo, authorization.ID)
+	suite.Require().NoError(err)
+	suite.Equal(testRedirectURI, approved.RedirectURI)
+	suite.Equal("state-1", approved.State)
+	// 承認済みのものは承認し直せない
+	_, err = suite.authorizationCodeUsecase.Deny(ctx, inmemory.SeedCifNo, authorization.ID)
+	suite.ErrorIs(err, ErrAuthorizationNotFound)
+
+	token, err := suite.exchange(approved.Code)
+	suite.Require().NoError(err)
+	suite.Equal(inmemory.SeedCifNo, token.CifNo)
+	suite.Equal("openid read:account_and_transactions", token.Scopes)
+	suite.Equal("signed-id-token", token.IDToken)
+	suite.Require().Len(suite.signer.claims, 1)
+	suite.Equal("nonce-1", suite.signer.claims[0].Nonce)
+
+	// 認可コードは一度しか使えない
+	_, err = suite.exchange(approved.Code)
+	suite.ErrorIs(err, ErrAuthorizationCodeReused)
+
+	events, err := suite.store.Audit().List(ctx, gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	var operations []string
+	for _, event := range events {
+		operations = append(operations, string(event.Operation)+" "+string(event.Outcome))
+	}
+	// 承認前の交換と再利用は失敗として記録する
+	suite.Equal([]string{"token.issue failure", "consent.grant success", "token.issue success", "token.issue failure"}, operations)
+	suite.Equal("invalid_authorization_code", events[0].Reason)
+	suite.Equal("authorization_code_reused", events[3].Reason)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestExchangeReuseRevokesTokens() {
+	ctx := context.Background()
+	code := suite.approve()
+	token, err := suite.exchange(code)
+	suite.Require().NoError(err)
+
+	// 再利用されたら、交換で発行したトークンを失効させる
+	_, err = suite.exchange(code)
+	suite.ErrorIs(err, ErrAuthorizationCodeReused)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	_, err = suite.store.Token().Get(ctx, token.AccessToken)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+	_, err = suite.store.Token().GetByRefreshToken(ctx, token.RefreshToken)
+	suite.ErrorIs(err, gorm.ErrRecordNotFound)
+
+	// 再利用を検知できるのはアクセストークンの有効期間まで
+	suite.clock.now = suite.clock.now.Add(accessTokenTTL)
+	suite.authorize()
+	_, err = suite.exchange(code)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	suite.NotErrorIs(err, ErrAuthorizationCodeReused)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestAuthorizeRequestURIOnce() {
+	pushed, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{
+		ResponseType:        ResponseTypeCode,
+		RedirectURI:         testRedirectURI,
+		Scope:               "openid",
+		CodeChallenge:       testS256CodeChallenge,
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	})
+	suite.Require().NoError(err)
+
+	// 他のクライアントの request_uri は使えない
+	_, err = suite.authorizationCodeUsecase.Authorize(context.Background(), inmemory.SeedClientID, pushed.RequestURI)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+	_, err = suite.authorizationCodeUsecase.Authorize(context.Background(), "client-1", pushed.RequestURI)
+	suite.Require().NoError(err)
+	_, err = suite.authorizationCodeUsecase.Authorize(context.Background(), "client-1", pushed.RequestURI)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestDeny() {
+	authorization := suite.authorize()
+
+	denied, err := suite.authorizationCodeUsecase.Deny(context.Background(), inmemory.SeedCifNo, authorization.ID)
+	suite.Require().NoError(err)
+	suite.Equal(entity.AuthorizationCodeStatusDenied, denied.Status)
+
+	_, err = suite.exchange(authorization.Code)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	_, err = suite.authorizationCodeUsecase.Get(context.Background(), authorization.ID)
+	suite.ErrorIs(err, ErrAuthorizationNotFound)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestExchangeInvalid() {
+	ctx := context.Background()
+	code := suite.approve()
+
+	_, err := suite.authorizationCodeUsecase.Exchange(ctx, suite.client, code, "https://client.example.com/other", testCodeVerifier)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	_, err = suite.authorizationCodeUsecase.Exchange(ctx, suite.client, code, testRedirectURI, testCodeVerifier+"x")
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	_, err = suite.authorizationCodeUsecase.Exchange(ctx, suite.client, code, testRedirectURI, "")
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	_, err = suite.authorizationCodeUsecase.Exchange(ctx, suite.client, "", testRedirectURI, testCodeVerifier)
+	suite.ErrorIs(err, ErrAuthorizationCodeRequired)
+
+	// 他のクライアントは交換できない
+	other := &entity.Client{ClientID: "client-2", GrantTypes: entity.GrantTypeAuthorizationCode}
+	_, err = suite.authorizationCodeUsecase.Exchange(ctx, other, code, testRedirectURI, testCodeVerifier)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+	// 認可コードフローを許可していないクライアント
+	_, err = suite.authorizationCodeUsecase.Exchange(ctx, &entity.Client{ClientID: "client-1"}, code, testRedirectURI, testCodeVerifier)
+	suite.ErrorIs(err, ErrUnauthorizedClient)
+
+	// 失敗した交換も監査ログに残す
+	events, err := suite.store.Audit().List(ctx, gateway.AuditFilter{Operation: entity.AuditOperationTokenIssue})
+	suite.Require().NoError(err)
+	var reasons []string
+	for _, event := range events {
+		suite.Equal(entity.AuditOutcomeFailure, event.Outcome)
+		reasons = append(reasons, event.Reason)
+	}
+	suite.Equal([]string{
+		"invalid_authorization_code",
+		"invalid_authorization_code",
+		"invalid_authorization_code",
+		"authorization_code_required",
+		"invalid_authorization_code",
+		"unauthorized_client",
+	}, reasons)
+
+	// 失敗した交換では認可コードを消費しない
+	_, err = suite.exchange(code)
+	suite.NoError(err)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestExchangeExpired() {
+	code := suite.approve()
+	suite.clock.now = suite.clock.now.Add(authorizationCodeTTL + time.Second)
+
+	_, err := suite.exchange(code)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+}
+
+func (suite *AuthorizationCodeUsecaseSuite) TestExchangeConsentRevoked() {
+	code := suite.approve()
+	suite.Require().NoError(suite.store.Consent().DeleteByClientID(context.Background(), "client-1"))
+
+	_, err := suite.exchange(code)
+	suite.ErrorIs(err, ErrInvalidAuthorizationCode)
+}
@@ -1,11 +1,17 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"sync"
+	"time"
 
 	"go-banking-api/adapter/gateway"
 	"go-banking-api/entity"
 	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/pkg/tracing"
 
 	"gorm.io/gorm"
 )
@@ -14,21 +20,75 @@ var (
 	ErrClientIDRequired     = errors.New("client id is required")
 	ErrClientSecretRequired = errors.New("client secret is required")
 	ErrInvalidClient        = errors.New("invalid client")
+	// ErrClientLocked は呼び出し元には ErrInvalidClient と同じ応答を返すこと
+	ErrClientLocked = errors.New("client authentication is locked")
+	// ErrClientDisabled も ErrInvalidClient と同じ応答を返すこと
+	ErrClientDisabled  = errors.New("client is disabled")
+	ErrLockoutNotFound = errors.New("lockout not found")
+)
+
+// DefaultLockoutPolicy はクライアント ID と接続元 IP の組・接続元 IP ごとに 5 回続けて失敗すると 1 分ロックし、以降は倍にしていく
+var DefaultLockoutPolicy = entity.LockoutPolicy{
+	Threshold:       5,
+	LockDuration:    time.Minute,
+	MaxLockDuration: time.Hour,
+	ResetAfter:      15 * time.Minute,
+	DelayStep:       100 * time.Millisecond,
+	// API のタイムアウト（2 秒）に収まるようにする
+	MaxDelay: time.Second,
+}
+
+// 監査ログの reason に記録するロックの対象
+const (
+	lockoutReasonClientID         = "client_id"
+	lockoutReasonClientIDSourceIP = "client_id_source_ip"
+	lockoutReasonSourceIP         = "source_ip"
 )
 
 type ClientUsecase interface {
-	Authenticate(clientID string, clientSecret string) (*entity.Client, error)
+	// Authenticate は clientID と clientSecret を検証する。
+	// 失敗はクライアント ID と sourceIP の組、sourceIP ごとに数え、一定回数を超えるとロックする。
+	// クライアント ID だけの失敗回数は応答を遅らせるのに使い、他人が本来のクライアントをロックできないようにする。
+	Authenticate(ctx context.Context, clientID string, clientSecret string, sourceIP string) (*entity.Client, error)
+	// UnlockClient / UnlockSourceIP は管理者がロックを解除する。記録がない場合は ErrLockoutNotFound を返す。
+	UnlockClient(ctx context.Context, clientID string) error
+	UnlockSourceIP(ctx context.Context, sourceIP string) error
 }
 
 type clientUsecase struct {
-	clientRepository gateway.ClientRepository
+	clientRepository  gateway.ClientRepository
+	lockoutRepository gateway.AuthLockoutRepository
+	auditRepository   gateway.AuditRepository
+	policy            entity.LockoutPolicy
+	clock             pkg.Clock
+	// sleep はテストで差し替える
+	sleep func(ctx context.Context, d time.Duration)
 }
 
-func NewClientUsecase(clientRepository gateway.ClientRepository) *clientUsecase {
-	return &clientUsecase{clientRepository: clientRepository}
+func NewClientUsecase(clientRepository gateway.ClientRepository, lockoutRepository gateway.AuthLockoutRepository, auditRepository gateway.AuditRepository, policy entity.LockoutPolicy, clock pkg.Clock) *clientUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &clientUsecase{
+		clientRepository:  clientRepository,
+		lockoutRepository: lockoutRepository,
+		auditRepository:   auditRepository,
+		policy:            policy,
+		clock:             clock,
+		sleep:             sleepContext,
+	}
 }
 
-func (c *clientUsecase) Authenticate(clientID string, clientSecret string) (*entity.Client, error) {
+func (c *clientUsecase) Authenticate(ctx context.Context, clientID string, clientSecret string, sourceIP string) (_ *entity.Client, err error) {
+	ctx, span := tracing.Start(ctx, "ClientUsecase.Authenticate")
+	defer func() {
+		if err != nil {
+			metrics.ClientAuthFailed(failureReason(err))
+			recordAuditEvent(ctx, c.auditRepository, newAuditEvent(ctx, c.clock, entity.AuditOperationClientAuthenticate, clientID, 0, err))
+		}
+		tracing.End(span, err)
+	}()
+
 	if clientID == "" {
 		return nil, ErrClientIDRequired
 	}
@@ -36,17 +96,194 @@ func (c *clientUsecase) Authenticate(clientID string, clientSecret string) (*ent
 		return nil, ErrClientSecretRequired
 	}
 
-	client, err := c.clientRepository.Get(clientID)
-	if err != nil {
+	// 存在しないクライアント ID も同じように数えてロックし、ID の有無を区別できないようにする
+	keys := lockoutKeys(clientID, sourceIP)
+	var resetKeys []string
+	for _, key := range keys {
+		lockout, err := c.lockoutRepository.Get(ctx, key.key)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrInvalidClient
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !key.throttleOnly && lockout.IsLocked(c.clock.Now()) {
+			return nil, ErrClientLocked
+		}
+		if key.reason != lockoutReasonSourceIP {
+			resetKeys = append(resetKeys, key.key)
 		}
-		return nil, err
 	}
 
-	if !pkg.CompareHash(client.ClientSecret, clientSecret) {
-		return nil, ErrInvalidClient
+	client, err := c.clientRepository.Get(ctx, clientID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if !c.matchSecret(client, clientSecret) {
+		return nil, c.registerFailure(ctx, clientID, keys)
+	}
+	// 応答はシークレット誤りと同じにし、監査ログの reason（client_disabled）でだけ区別する
+	if client.IsDisabled() {
+		return nil, ErrClientDisabled
 	}
 
+	// 成功したらクライアント ID の失敗回数は数え直す。接続元 IP は他のクライアント ID を試せるため戻さない。
+	for _, key := range resetKeys {
+		if err := c.lockoutRepository.Delete(ctx, key); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
 	return client, nil
 }
+
+// matchSecret はローテーション中の複数のシークレットのいずれかと一致するかを確認する。
+// client が nil の場合は一致しない。応答時間から client_id の存在や有効なシークレットの数がわからないよう、
+// 不足分はダミーのハッシュで埋めて常に MaxActiveClientSecrets 回以上 bcrypt で比較し、一致しても途中で打ち切らない。
+func (c *clientUsecase) matchSecret(client *entity.Client, clientSecret string) bool {
+	var secrets []entity.ClientSecret
+	if client != nil {
+		secrets = client.ActiveSecrets(c.clock.Now())
+	}
+	matched := false
+	for i := range max(len(secrets), MaxActiveClientSecrets) {
+		if i >= len(secrets) {
+			pkg.CompareHash(dummySecretHash(), clientSecret)
+			continue
+		}
+		if pkg.CompareHash(secrets[i].SecretHash, clientSecret) {
+			matched = true
+		}
+	}
+	return matched
+}
+
+// registerFailure は失敗を数え、ロックした場合は監査ログに残し、失敗回数に応じて応答を遅らせる
+func (c *clientUsecase) registerFailure(ctx context.Context, clientID string, keys []lockoutKey) error {
+	failures := 0
+	for _, key := range keys {
+		policy := c.policy
+		if key.throttleOnly {
+			policy.Threshold = 0
+		}
+		lockout, locked, err := c.lockoutRepository.RecordFailure(ctx, key.key, c.clock.Now(), policy)
+		if err != nil {
+			return err
+		}
+		if lockout.Failures > failures {
+			failures = lockout.Failures
+		}
+		if locked {
+			logger.WarnContext(ctx, "client authentication locked", "lockout", key.reason, "failures", lockout.Failures, "locked_until", lockout.LockedUntil)
+			event := newAuditEvent(ctx, c.clock, entity.AuditOperationClientLockout, clientID, 0, nil)
+			event.Reason = key.reason
+			recordAuditEvent(ctx, c.auditRepository, event)
+		}
+	}
+	c.sleep(ctx, c.policy.Delay(failures))
+	return ErrInvalidClient
+}
+
+// UnlockClient はクライアント ID の失敗回数と、すべての接続元 IP との組のロックを消す
+func (c *clientUsecase) UnlockClient(ctx context.Context, clientID string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientUsecase.Unlock")
+	defer func() { tracing.End(span, err) }()
+
+	if err := deleteClientLockouts(ctx, c.lockoutRepository, clientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLockoutNotFound
+		}
+		return err
+	}
+	event := newAuditEvent(ctx, c.clock, entity.AuditOperationClientUnlock, clientID, 0, nil)
+	event.Reason = lockoutReasonClientID
+	return c.auditRepository.Append(ctx, event)
+}
+
+func (c *clientUsecase) UnlockSourceIP(ctx context.Context, sourceIP string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientUsecase.Unlock")
+	defer func() { tracing.End(span, err) }()
+
+	if err := c.lockoutRepository.Delete(ctx, sourceIPLockoutKey(sourceIP)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLockoutNotFound
+		}
+		return err
+	}
+	event := newAuditEvent(ctx, c.clock, entity.AuditOperationClientUnlock, "", 0, nil)
+	event.Reason = lockoutReasonSourceIP
+	return c.auditRepository.Append(ctx, event)
+}
+
+// deleteClientLockouts は clientID に関するロックの記録をすべて消す。記録がなかった場合は gorm.ErrRecordNotFound を返す。
+func deleteClientLockouts(ctx context.Context, lockoutRepository gateway.AuthLockoutRepository, clientID string) error {
+	deleted := false
+	if err := lockoutRepository.Delete(ctx, clientLockoutKey(clientID)); err == nil {
+		deleted = true
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err := lockoutRepository.DeleteByPrefix(ctx, clientSourceIPLockoutKeyPrefix(clientID)); err == nil {
+		deleted = true
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if !deleted {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+type lockoutKey struct {
+	key    string
+	reason string
+	// throttleOnly のキーは失敗回数を応答の遅延にだけ使い、ロックしない
+	throttleOnly bool
+}
+
+// lockoutKeys は失敗を数えるキーを返す
+func lockoutKeys(clientID string, sourceIP string) []lockoutKey {
+	keys := []lockoutKey{
+		{key: clientSourceIPLockoutKeyPrefix(clientID) + sourceIP, reason: lockoutReasonClientIDSourceIP},
+		// クライアント ID だけでロックすると、誰でも本来のクライアントをロックできてしまう
+		{key: clientLockoutKey(clientID), reason: lockoutReasonClientID, throttleOnly: true},
+	}
+	if sourceIP != "" {
+		keys = append(keys, lockoutKey{key: sourceIPLockoutKey(sourceIP), reason: lockoutReasonSourceIP})
+	}
+	return keys
+}
+
+func clientLockoutKey(clientID string) string {
+	if len(clientID) > auditClientIDMaxLength {
+		clientID = clientID[:auditClientIDMaxLength]
+	}
+	return "client:" + clientID
+}
+
+func clientSourceIPLockoutKeyPrefix(clientID string) string {
+	return clientLockoutKey(clientID) + "|ip:"
+}
+
+func sourceIPLockoutKey(sourceIP string) string {
+	return "ip:" + sourceIP
+}
+
+var dummySecretHash = sync.OnceValue(func() string {
+	hash, err := pkg.HashString("dummy-client-secret")
+	if err != nil {
+		panic(err)
+	}
+	return hash
+})
+
+func sleepContext(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
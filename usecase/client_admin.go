@@ -0,0 +1,432 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/jwt"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+const AccountReadScope = "read:account_and_transactions"
+
+// ClientScopes はクライアントに許可できるスコープ
+var ClientScopes = append([]string{AccountReadScope, AuditReadScope, ConsentManageScope}, OIDCScopes...)
+
+// ClientGrantTypes はクライアントに許可できるグラントタイプ
+var ClientGrantTypes = []string{entity.GrantTypeRefreshToken, entity.GrantTypeAuthorizationCode, entity.GrantTypeDeviceCode}
+
+var (
+	ErrClientNotFound      = errors.New("client not found")
+	ErrClientAlreadyExists = errors.New("client already exists")
+	ErrInvalidClientScope  = errors.New("invalid client scope")
+	ErrInvalidClientInput  = errors.New("invalid client input")
+	ErrCustomerNotFound    = errors.New("customer not found")
+	// ErrInvalidClientJWKS と ErrInvalidClientGrantType は理由を付けてラップして返す
+	ErrInvalidClientJWKS      = errors.New("invalid client jwks")
+	ErrInvalidClientGrantType = errors.New("invalid client grant type")
+)
+
+// clientJWKSMaxLength はクライアントの公開鍵（JWK Set の JSON）の長さの上限
+const clientJWKSMaxLength = 8000
+
+// NewClient は登録するクライアントの内容。ClientID が空の場合は生成する。
+type NewClient struct {
+	ClientID           string
+	ClientName         string
+	Scopes             []string
+	RateLimitPerMinute int
+	RateLimitBurst     int
+}
+
+// ClientAdminUsecase は管理者によるクライアントの登録・変更。変更はすべて監査ログに記録する。
+type ClientAdminUsecase interface {
+	// CreateClient は生成したシークレットのハッシュを保存し、平文のシークレットは戻り値でのみ返す
+	CreateClient(ctx context.Context, newClient NewClient) (*entity.Client, string, error)
+	ListClients(ctx context.Context) ([]entity.Client, error)
+	UpdateScopes(ctx context.Context, clientID string, scopes []string) error
+	// UpdateGrantTypes はクライアントに許可するグラントタイプを置き換える。デバイス認可グラントを使うクライアントに設定する。
+	UpdateGrantTypes(ctx context.Context, clientID string, grantTypes []string) error
+	// UpdateRedirectURIs は PAR で受け付ける redirect_uri を登録する
+	UpdateRedirectURIs(ctx context.Context, clientID string, redirectURIs []string) error
+	// UpdateJWKS はリクエストオブジェクトの署名を検証する公開鍵（JWK Set の JSON）を登録する。鍵が空の場合は削除する。
+	UpdateJWKS(ctx context.Context, clientID string, jwks []byte) error
+	// SetFAPI は fapi が true の場合、署名付きのリクエストオブジェクトでない認可リクエストを拒否するようにする
+	SetFAPI(ctx context.Context, clientID string, fapi bool) error
+	// SetSandbox は sandbox が false の場合、テスト用でない顧客からも同意を得られるようにする。既存の同意には影響しない。
+	SetSandbox(ctx context.Context, clientID string, sandbox bool) error
+	// DisableClient は認証できないようにし、発行済みのトークンを失効させて件数を返す
+	DisableClient(ctx context.Context, clientID string) (int64, error)
+	EnableClient(ctx context.Context, clientID string) error
+	// DeleteClient は発行済みのトークンとともに削除し、失効させたトークンの件数を返す
+	DeleteClient(ctx context.Context, clientID string) (int64, error)
+	// IssueToken は clientID から cifNo の口座情報を参照するためのトークンを発行する（接続試験用）。
+	// 顧客がクライアントのすべてのスコープとすべての口座に同意したものとして記録する。
+	IssueToken(ctx context.Context, clientID string, cifNo int) (*entity.Token, error)
+}
+
+type clientAdminUsecase struct {
+	transactionManager TransactionManager
+	clock              pkg.Clock
+}
+
+func NewClientAdminUsecase(transactionManager TransactionManager, clock pkg.Clock) *clientAdminUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &clientAdminUsecase{
+		transactionManager: transactionManager,
+		clock:              clock,
+	}
+}
+
+func (c *clientAdminUsecase) CreateClient(ctx context.Context, newClient NewClient) (_ *entity.Client, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.CreateClient")
+	defer func() { tracing.End(span, err) }()
+
+	if newClient.ClientID == "" {
+		if newClient.ClientID, err = generateToken(); err != nil {
+			return nil, "", err
+		}
+	}
+	if len(newClient.ClientID) > auditClientIDMaxLength || strings.TrimSpace(newClient.ClientName) == "" ||
+		newClient.RateLimitPerMinute < 0 || newClient.RateLimitBurst < 0 {
+		return nil, "", ErrInvalidClientInput
+	}
+	scope, err := clientScope(newClient.Scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret, clientSecret, err := newClientSecret(c.clock)
+	if err != nil {
+		return nil, "", err
+	}
+	client := &entity.Client{
+		ClientID:           newClient.ClientID,
+		Secrets:            []entity.ClientSecret{*clientSecret},
+		ClientName:         newClient.ClientName,
+		Scope:              scope,
+		RateLimitPerMinute: newClient.RateLimitPerMinute,
+		RateLimitBurst:     newClient.RateLimitBurst,
+		CreatedAt:          clientSecret.CreatedAt,
+		// 登録エンドポイントを使わないクライアントも、メタデータは DB の既定値と揃える
+		GrantTypes:              entity.GrantTypeRefreshToken,
+		TokenEndpointAuthMethod: entity.TokenEndpointAuthClientSecretBasic,
+	}
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().Create(ctx, client); err != nil {
+			if errors.Is(err, gorm.ErrDuplicatedKey) {
+				return ErrClientAlreadyExists
+			}
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientCreate, client.ClientID, 0, nil))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return client, secret, nil
+}
+
+func (c *clientAdminUsecase) ListClients(ctx context.Context) (_ []entity.Client, err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.ListClients")
+	defer func() { tracing.End(span, err) }()
+
+	var clients []entity.Client
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		clients, err = repos.Client().List(ctx)
+		return err
+	})
+	return clients, err
+}
+
+func (c *clientAdminUsecase) UpdateScopes(ctx context.Context, clientID string, scopes []string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.UpdateScopes")
+	defer func() { tracing.End(span, err) }()
+
+	scope, err := clientScope(scopes)
+	if err != nil {
+		return err
+	}
+	// 発行済みのトークンのスコープは変えない。狭めた場合に即座に反映させるには無効化して発行し直すこと。
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().UpdateScope(ctx, clientID, scope); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) UpdateGrantTypes(ctx context.Context, clientID string, grantTypes []string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.UpdateGrantTypes")
+	defer func() { tracing.End(span, err) }()
+
+	joined, err := clientGrantTypes(grantTypes)
+	if err != nil {
+		return err
+	}
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		client, err := repos.Client().Get(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		client.GrantTypes = joined
+		if err := repos.Client().UpdateMetadata(ctx, client); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) UpdateRedirectURIs(ctx context.Context, clientID string, redirectURIs []string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.UpdateRedirectURIs")
+	defer func() { tracing.End(span, err) }()
+
+	// 動的クライアント登録と同じ基準で検証する
+	for _, redirectURI := range redirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return err
+		}
+	}
+	joined := strings.Join(redirectURIs, " ")
+	if len(joined) > redirectURIsMaxLength {
+		return fmt.Errorf("%w: redirect_uris must be at most %d characters in total", ErrInvalidRedirectURI, redirectURIsMaxLength)
+	}
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		client, err := repos.Client().Get(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		client.RedirectURIs = joined
+		if err := repos.Client().UpdateMetadata(ctx, client); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) UpdateJWKS(ctx context.Context, clientID string, jwks []byte) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.UpdateJWKS")
+	defer func() { tracing.End(span, err) }()
+
+	set, err := jwt.ParseJWKSet(jwks)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidClientJWKS, err)
+	}
+	// 読み込めた項目だけを保存し、x5c などの扱わない項目は捨てる
+	var normalized string
+	if len(set.Keys) > 0 {
+		b, err := json.Marshal(set)
+		if err != nil {
+			return err
+		}
+		normalized = string(b)
+	}
+	if len(normalized) > clientJWKSMaxLength {
+		return fmt.Errorf("%w: must be at most %d characters", ErrInvalidClientJWKS, clientJWKSMaxLength)
+	}
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().UpdateJWKS(ctx, clientID, normalized); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) SetFAPI(ctx context.Context, clientID string, fapi bool) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.SetFAPI")
+	defer func() { tracing.End(span, err) }()
+
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().SetFAPI(ctx, clientID, fapi); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) SetSandbox(ctx context.Context, clientID string, sandbox bool) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.SetSandbox")
+	defer func() { tracing.End(span, err) }()
+
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().SetSandbox(ctx, clientID, sandbox); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) DisableClient(ctx context.Context, clientID string) (_ int64, err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.DisableClient")
+	defer func() { tracing.End(span, err) }()
+
+	now := c.clock.Now()
+	var revoked int64
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().SetDisabledAt(ctx, clientID, &now); err != nil {
+			return clientNotFound(err)
+		}
+		if revoked, err = repos.Token().DeleteByClientID(ctx, clientID); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientDisable, clientID, 0, nil))
+	})
+	return revoked, err
+}
+
+func (c *clientAdminUsecase) EnableClient(ctx context.Context, clientID string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.EnableClient")
+	defer func() { tracing.End(span, err) }()
+
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().SetDisabledAt(ctx, clientID, nil); err != nil {
+			return clientNotFound(err)
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientEnable, clientID, 0, nil))
+	})
+}
+
+func (c *clientAdminUsecase) DeleteClient(ctx context.Context, clientID string) (_ int64, err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.DeleteClient")
+	defer func() { tracing.End(span, err) }()
+
+	var revoked int64
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if revoked, err = deleteClient(ctx, repos, clientID); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientDelete, clientID, 0, nil))
+	})
+	return revoked, err
+}
+
+// deleteClient は発行済みのトークン・同意・認可リクエスト・ロックの記録とともにクライアントを削除し、
+// 失効させたトークンの件数を返す
+func deleteClient(ctx context.Context, repos gateway.Repositories, clientID string) (int64, error) {
+	revoked, err := repos.Token().DeleteByClientID(ctx, clientID)
+	if err != nil {
+		return 0, err
+	}
+	if err := repos.Consent().DeleteByClientID(ctx, clientID); err != nil {
+		return 0, err
+	}
+	if err := repos.PushedAuthorization().DeleteByClientID(ctx, clientID); err != nil {
+		return 0, err
+	}
+	if err := repos.DeviceAuthorization().DeleteByClientID(ctx, clientID); err != nil {
+		return 0, err
+	}
+	if err := repos.AuthorizationCode().DeleteByClientID(ctx, clientID); err != nil {
+		return 0, err
+	}
+	if err := repos.Client().Delete(ctx, clientID); err != nil {
+		return 0, clientNotFound(err)
+	}
+	// 同じ ID で登録し直した場合に以前のロックが残らないようにする
+	if err := deleteClientLockouts(ctx, repos.AuthLockout(), clientID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, err
+	}
+	return revoked, nil
+}
+
+func (c *clientAdminUsecase) IssueToken(ctx context.Context, clientID string, cifNo int) (_ *entity.Token, err error) {
+	ctx, span := tracing.Start(ctx, "ClientAdminUsecase.IssueToken")
+	defer func() { tracing.End(span, err) }()
+
+	accessToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var token *entity.Token
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		client, err := repos.Client().Get(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		if client.IsDisabled() {
+			return ErrClientDisabled
+		}
+		if _, err := repos.Customer().Get(ctx, cifNo); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrCustomerNotFound
+			}
+			return err
+		}
+		token = &entity.Token{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			Scopes:       client.Scope,
+			ExpiresAt:    c.clock.Now().Add(accessTokenTTL),
+			CifNo:        cifNo,
+			ClientID:     clientID,
+		}
+		if err := grantConsent(ctx, repos, c.clock, client, client.Scope, cifNo); err != nil {
+			return err
+		}
+		if err := repos.Token().Create(ctx, token); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationTokenIssue, clientID, cifNo, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// clientGrantTypes は grantTypes を検証し、重複を除いたスペース区切りの文字列にする
+func clientGrantTypes(grantTypes []string) (string, error) {
+	var unique []string
+	for _, grantType := range grantTypes {
+		if !slices.Contains(ClientGrantTypes, grantType) {
+			return "", fmt.Errorf("%w: unsupported grant_type %q", ErrInvalidClientGrantType, grantType)
+		}
+		if !slices.Contains(unique, grantType) {
+			unique = append(unique, grantType)
+		}
+	}
+	if len(unique) == 0 {
+		return "", fmt.Errorf("%w: at least one grant_type is required", ErrInvalidClientGrantType)
+	}
+	return strings.Join(unique, " "), nil
+}
+
+// clientScope は scopes を検証し、重複を除いたスペース区切りの文字列にする
+func clientScope(scopes []string) (string, error) {
+	var unique []string
+	for _, scope := range scopes {
+		if !slices.Contains(ClientScopes, scope) {
+			return "", ErrInvalidClientScope
+		}
+		if !slices.Contains(unique, scope) {
+			unique = append(unique, scope)
+		}
+	}
+	if len(unique) == 0 {
+		return "", ErrInvalidClientScope
+	}
+	return strings.Join(unique, " "), nil
+}
+
+func clientNotFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrClientNotFound
+	}
+	return err
+}
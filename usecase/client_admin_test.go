@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+type ClientAdminUsecaseSuite struct {
+	suite.Suite
+	clock              pkg.Clock
+	store              *inmemory.Store
+	clientAdminUsecase *clientAdminUsecase
+}
+
+func TestClientAdminUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientAdminUsecaseSuite))
+}
+
+func (suite *ClientAdminUsecaseSuite) SetupTest() {
+	suite.clock = pkg.FixedClock{T: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.clientAdminUsecase = NewClientAdminUsecase(inmemory.NewTransactionManager(suite.store), suite.clock)
+}
+
+func (suite *ClientAdminUsecaseSuite) auditOperations() []entity.AuditOperation {
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	var operations []entity.AuditOperation
+	for _, event := range events {
+		operations = append(operations, event.Operation)
+	}
+	return operations
+}
+
+func (suite *ClientAdminUsecaseSuite) TestCreateClient() {
+	ctx := context.Background()
+	client, secret, err := suite.clientAdminUsecase.CreateClient(ctx, NewClient{
+		ClientName: "partner",
+		Scopes:     []string{AccountReadScope, AccountReadScope},
+	})
+	suite.Require().NoError(err)
+	suite.NotEmpty(client.ClientID)
+	suite.Equal(AccountReadScope, client.Scope)
+
+	stored, err := suite.store.Client().Get(ctx, client.ClientID)
+	suite.Require().NoError(err)
+	// 平文のシークレットは保存しない
+	suite.Require().Len(stored.Secrets, 1)
+	suite.NotEqual(secret, stored.Secrets[0].SecretHash)
+	suite.True(pkg.CompareHash(stored.Secrets[0].SecretHash, secret))
+	suite.False(stored.Sandbox)
+	suite.Equal(suite.clock.Now(), stored.CreatedAt)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationClientCreate}, suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestCreateClientErrors() {
+	ctx := context.Background()
+	_, _, err := suite.clientAdminUsecase.CreateClient(ctx, NewClient{ClientID: inmemory.SeedClientID, ClientName: "dup", Scopes: []string{AccountReadScope}})
+	suite.ErrorIs(err, ErrClientAlreadyExists)
+
+	_, _, err = suite.clientAdminUsecase.CreateClient(ctx, NewClient{ClientName: "partner", Scopes: []string{"write:transfer"}})
+	suite.ErrorIs(err, ErrInvalidClientScope)
+
+	_, _, err = suite.clientAdminUsecase.CreateClient(ctx, NewClient{ClientName: "partner"})
+	suite.ErrorIs(err, ErrInvalidClientScope)
+
+	_, _, err = suite.clientAdminUsecase.CreateClient(ctx, NewClient{ClientName: " ", Scopes: []string{AccountReadScope}})
+	suite.ErrorIs(err, ErrInvalidClientInput)
+	suite.Empty(suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestUpdateScopes() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.clientAdminUsecase.UpdateScopes(ctx, inmemory.SeedClientID, []string{AccountReadScope, AuditReadScope}))
+
+	client, err := suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal([]string{AccountReadScope, AuditReadScope}, client.Scopes())
+
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateScopes(ctx, "unknown", []string{AccountReadScope}), ErrClientNotFound)
+}
+
+func (suite *ClientAdminUsecaseSuite) TestUpdateRedirectURIs() {
+	ctx := context.Background()
+	redirectURIs := []string{"https://client.example.com/callback", "http://localhost:8080/callback"}
+	suite.Require().NoError(suite.clientAdminUsecase.UpdateRedirectURIs(ctx, inmemory.SeedClientID, redirectURIs))
+
+	client, err := suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal(redirectURIs, client.RedirectURIList())
+
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateRedirectURIs(ctx, inmemory.SeedClientID, []string{"http://client.example.com/callback"}), ErrInvalidRedirectURI)
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateRedirectURIs(ctx, "unknown", redirectURIs), ErrClientNotFound)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationClientUpdate}, suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestUpdateGrantTypes() {
+	ctx := context.Background()
+	grantTypes := []string{entity.GrantTypeRefreshToken, entity.GrantTypeDeviceCode, entity.GrantTypeRefreshToken}
+	suite.Require().NoError(suite.clientAdminUsecase.UpdateGrantTypes(ctx, inmemory.SeedClientID, grantTypes))
+
+	client, err := suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal([]string{entity.GrantTypeRefreshToken, entity.GrantTypeDeviceCode}, client.GrantTypeList())
+
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateGrantTypes(ctx, inmemory.SeedClientID, []string{"client_credentials"}), ErrInvalidClientGrantType)
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateGrantTypes(ctx, inmemory.SeedClientID, nil), ErrInvalidClientGrantType)
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateGrantTypes(ctx, "unknown", grantTypes), ErrClientNotFound)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationClientUpdate}, suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestUpdateJWKSAndSetFAPI() {
+	ctx := context.Background()
+	// 扱わない項目は保存しない
+	jwks := `{"keys":[{"kty":"EC","crv":"P-256","kid":"key-1",` +
+		`"x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0","x5c":["ignored"]}]}`
+	suite.Require().NoError(suite.clientAdminUsecase.UpdateJWKS(ctx, inmemory.SeedClientID, []byte(jwks)))
+	suite.Require().NoError(suite.clientAdminUsecase.SetFAPI(ctx, inmemory.SeedClientID, true))
+
+	client, err := suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.JSONEq(`{"keys":[{"kty":"EC","crv":"P-256","kid":"key-1",`+
+		`"x":"f83OJ3D2xF1Bg8vub9tLe1gHMzV76e8Tus9uPHvRVEU","y":"x_FEzRu9m36HLN_tue659LNpXW6pCyStikYjKIWI5a0"}]}`, client.JWKS)
+	suite.True(client.FAPI)
+
+	// 空の JWK Set で削除する
+	suite.Require().NoError(suite.clientAdminUsecase.UpdateJWKS(ctx, inmemory.SeedClientID, []byte(`{"keys":[]}`)))
+	client, err = suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Empty(client.JWKS)
+
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateJWKS(ctx, inmemory.SeedClientID, []byte(`{"keys":[{"kty":"oct"}]}`)), ErrInvalidClientJWKS)
+	suite.ErrorIs(suite.clientAdminUsecase.UpdateJWKS(ctx, "unknown", []byte(`{"keys":[]}`)), ErrClientNotFound)
+	suite.ErrorIs(suite.clientAdminUsecase.SetFAPI(ctx, "unknown", true), ErrClientNotFound)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationClientUpdate, entity.AuditOperationClientUpdate, entity.AuditOperationClientUpdate}, suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestSetSandbox() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.store.AddCustomer(entity.Customer{CifNo: 2, NameKanji: "試験 花子", Sandbox: true}))
+	suite.Require().NoError(suite.clientAdminUsecase.SetSandbox(ctx, inmemory.SeedClientID, true))
+
+	// サンドボックスのクライアントはテスト用の顧客にしか同意を得られない
+	_, err := suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.ErrorIs(err, ErrSandboxCustomerRequired)
+	_, err = suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, 2)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.clientAdminUsecase.SetSandbox(ctx, inmemory.SeedClientID, false))
+	_, err = suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(suite.clientAdminUsecase.SetSandbox(ctx, "unknown", true), ErrClientNotFound)
+}
+
+func (suite *ClientAdminUsecaseSuite) TestDisableAndEnableClient() {
+	ctx := context.Background()
+	revoked, err := suite.clientAdminUsecase.DisableClient(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), revoked)
+
+	client, err := suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.True(client.IsDisabled())
+	_, err = suite.store.Token().GetByRefreshToken(ctx, inmemory.SeedRefreshToken)
+	suite.Error(err)
+
+	_, err = suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.ErrorIs(err, ErrClientDisabled)
+
+	suite.Require().NoError(suite.clientAdminUsecase.EnableClient(ctx, inmemory.SeedClientID))
+	client, err = suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.False(client.IsDisabled())
+
+	_, err = suite.clientAdminUsecase.DisableClient(ctx, "unknown")
+	suite.ErrorIs(err, ErrClientNotFound)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationClientDisable, entity.AuditOperationClientEnable}, suite.auditOperations())
+}
+
+func (suite *ClientAdminUsecaseSuite) TestDeleteClient() {
+	ctx := context.Background()
+	revoked, err := suite.clientAdminUsecase.DeleteClient(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), revoked)
+
+	_, err = suite.store.Client().Get(ctx, inmemory.SeedClientID)
+	suite.Error(err)
+	_, err = suite.store.Consent().Get(ctx, inmemory.SeedCifNo, inmemory.SeedClientID)
+	suite.Error(err)
+
+	_, err = suite.clientAdminUsecase.DeleteClient(ctx, inmemory.SeedClientID)
+	suite.ErrorIs(err, ErrClientNotFound)
+}
+
+func (suite *ClientAdminUsecaseSuite) TestIssueToken() {
+	ctx := context.Background()
+	token, err := suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+	suite.Equal(AccountReadScope, token.Scopes)
+	suite.Equal(suite.clock.Now().Add(accessTokenTTL), token.ExpiresAt)
+
+	stored, err := suite.store.Token().GetByRefreshToken(ctx, token.RefreshToken)
+	suite.Require().NoError(err)
+	suite.Equal(token.AccessToken, stored.AccessToken)
+	suite.Equal([]entity.AuditOperation{entity.AuditOperationConsentGrant, entity.AuditOperationTokenIssue}, suite.auditOperations())
+
+	// 発行し直すと同意の期限を延ばす
+	consent, err := suite.store.Consent().Get(ctx, inmemory.SeedCifNo, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Equal(suite.clock.Now().Add(entity.ConsentTTL), consent.ExpiresAt)
+	suite.Equal([]int{1}, consent.AccountIDList())
+
+	_, err = suite.clientAdminUsecase.IssueToken(ctx, inmemory.SeedClientID, 999)
+	suite.ErrorIs(err, ErrCustomerNotFound)
+	_, err = suite.clientAdminUsecase.IssueToken(ctx, "unknown", inmemory.SeedCifNo)
+	suite.ErrorIs(err, ErrClientNotFound)
+}
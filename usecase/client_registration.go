@@ -0,0 +1,303 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+const (
+	clientNameMaxLength   = 255
+	redirectURIsMaxLength = 2000
+	// registrationAuditReason は設定エンドポイントからの変更を管理コマンドによる変更と区別する
+	registrationAuditReason = "registration"
+)
+
+var (
+	// ErrInvalidClientMetadata と ErrInvalidRedirectURI は理由を付けてラップして返す
+	ErrInvalidClientMetadata = errors.New("invalid client metadata")
+	ErrInvalidRedirectURI    = errors.New("invalid redirect uri")
+	// ErrInvalidRegistrationToken はクライアントが存在しない場合も返し、登録済みの ID を推測させない
+	ErrInvalidRegistrationToken = errors.New("invalid registration access token")
+)
+
+// RegistrationPolicy は動的クライアント登録で受け付けるメタデータの制限
+type RegistrationPolicy struct {
+	// AllowedScopes は登録時に要求できるスコープ。scope を省略した場合はすべてを許可する。
+	AllowedScopes []string
+}
+
+// ClientMetadata は RFC 7591 のクライアントメタデータ。省略した項目は既定値にする。
+type ClientMetadata struct {
+	ClientName              string
+	RedirectURIs            []string
+	GrantTypes              []string
+	TokenEndpointAuthMethod string
+	Scopes                  []string
+}
+
+// RegistrationCredentials は平文の値。ハッシュしか保存しないため、発行したときにだけ返す。
+type RegistrationCredentials struct {
+	ClientSecret            string
+	RegistrationAccessToken string
+}
+
+// ClientRegistrationUsecase は RFC 7591 / 7592 の動的クライアント登録。変更はすべて監査ログに記録する。
+type ClientRegistrationUsecase interface {
+	Register(ctx context.Context, metadata ClientMetadata) (*entity.Client, *RegistrationCredentials, error)
+	// 以下は登録時に発行した登録アクセストークンで認可する
+	Get(ctx context.Context, clientID string, registrationAccessToken string) (*entity.Client, error)
+	// Update はメタデータを metadata で置き換え、登録アクセストークンを発行し直す（RegistrationAccessToken のみ設定する）
+	Update(ctx context.Context, clientID string, registrationAccessToken string, metadata ClientMetadata) (*entity.Client, *RegistrationCredentials, error)
+	// Delete は発行済みのトークンとともにクライアントを削除する
+	Delete(ctx context.Context, clientID string, registrationAccessToken string) error
+}
+
+type clientRegistrationUsecase struct {
+	transactionManager TransactionManager
+	policy             RegistrationPolicy
+	clock              pkg.Clock
+}
+
+func NewClientRegistrationUsecase(transactionManager TransactionManager, policy RegistrationPolicy, clock pkg.Clock) *clientRegistrationUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &clientRegistrationUsecase{
+		transactionManager: transactionManager,
+		policy:             policy,
+		clock:              clock,
+	}
+}
+
+func (c *clientRegistrationUsecase) Register(ctx context.Context, metadata ClientMetadata) (_ *entity.Client, _ *RegistrationCredentials, err error) {
+	ctx, span := tracing.Start(ctx, "ClientRegistrationUsecase.Register")
+	defer func() { tracing.End(span, err) }()
+
+	client, err := c.validateMetadata(metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client.ClientID, err = generateToken(); err != nil {
+		return nil, nil, err
+	}
+	secret, clientSecret, err := newClientSecret(c.clock)
+	if err != nil {
+		return nil, nil, err
+	}
+	client.Secrets = []entity.ClientSecret{*clientSecret}
+	client.CreatedAt = clientSecret.CreatedAt
+	// 認証なしで登録できるため、運用者が set-sandbox で解除するまではテスト用の顧客にしか使わせない
+	client.Sandbox = true
+	registrationAccessToken, err := generateToken()
+	if err != nil {
+		return nil, nil, err
+	}
+	client.RegistrationAccessTokenHash = hashRegistrationAccessToken(registrationAccessToken)
+
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if err := repos.Client().Create(ctx, client); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, c.clock, entity.AuditOperationClientRegister, client.ClientID, 0, nil))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, &RegistrationCredentials{ClientSecret: secret, RegistrationAccessToken: registrationAccessToken}, nil
+}
+
+func (c *clientRegistrationUsecase) Get(ctx context.Context, clientID string, registrationAccessToken string) (_ *entity.Client, err error) {
+	ctx, span := tracing.Start(ctx, "ClientRegistrationUsecase.Get")
+	defer func() { tracing.End(span, err) }()
+
+	var client *entity.Client
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		client, err = getRegisteredClient(ctx, repos, clientID, registrationAccessToken)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *clientRegistrationUsecase) Update(ctx context.Context, clientID string, registrationAccessToken string, metadata ClientMetadata) (_ *entity.Client, _ *RegistrationCredentials, err error) {
+	ctx, span := tracing.Start(ctx, "ClientRegistrationUsecase.Update")
+	defer func() { tracing.End(span, err) }()
+
+	updated, err := c.validateMetadata(metadata)
+	if err != nil {
+		return nil, nil, err
+	}
+	newRegistrationAccessToken, err := generateToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var client *entity.Client
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if _, err := getRegisteredClient(ctx, repos, clientID, registrationAccessToken); err != nil {
+			return err
+		}
+		updated.ClientID = clientID
+		if err := repos.Client().UpdateMetadata(ctx, updated); err != nil {
+			return clientNotFound(err)
+		}
+		if err := repos.Client().SetRegistrationAccessTokenHash(ctx, clientID, hashRegistrationAccessToken(newRegistrationAccessToken)); err != nil {
+			return clientNotFound(err)
+		}
+		if client, err = repos.Client().Get(ctx, clientID); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newRegistrationAuditEvent(ctx, c.clock, entity.AuditOperationClientUpdate, clientID))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, &RegistrationCredentials{RegistrationAccessToken: newRegistrationAccessToken}, nil
+}
+
+func (c *clientRegistrationUsecase) Delete(ctx context.Context, clientID string, registrationAccessToken string) (err error) {
+	ctx, span := tracing.Start(ctx, "ClientRegistrationUsecase.Delete")
+	defer func() { tracing.End(span, err) }()
+
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if _, err := getRegisteredClient(ctx, repos, clientID, registrationAccessToken); err != nil {
+			return err
+		}
+		if _, err := deleteClient(ctx, repos, clientID); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newRegistrationAuditEvent(ctx, c.clock, entity.AuditOperationClientDelete, clientID))
+	})
+}
+
+// validateMetadata は metadata を検証し、省略された項目を既定値にしたクライアントを返す
+func (c *clientRegistrationUsecase) validateMetadata(metadata ClientMetadata) (*entity.Client, error) {
+	clientName := strings.TrimSpace(metadata.ClientName)
+	if clientName == "" || len(clientName) > clientNameMaxLength {
+		return nil, fmt.Errorf("%w: client_name is required and must be at most %d characters", ErrInvalidClientMetadata, clientNameMaxLength)
+	}
+
+	// インプリシットなどは提供していないため、ClientGrantTypes のグラントタイプと Basic 認証だけを受け付ける
+	grantTypes := entity.GrantTypeRefreshToken
+	if len(metadata.GrantTypes) > 0 {
+		joined, err := clientGrantTypes(metadata.GrantTypes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidClientMetadata, err)
+		}
+		grantTypes = joined
+	}
+	authMethod := metadata.TokenEndpointAuthMethod
+	if authMethod == "" {
+		authMethod = entity.TokenEndpointAuthClientSecretBasic
+	}
+	if authMethod != entity.TokenEndpointAuthClientSecretBasic {
+		return nil, fmt.Errorf("%w: unsupported token_endpoint_auth_method %q", ErrInvalidClientMetadata, authMethod)
+	}
+
+	for _, redirectURI := range metadata.RedirectURIs {
+		if err := validateRedirectURI(redirectURI); err != nil {
+			return nil, err
+		}
+	}
+	redirectURIs := strings.Join(metadata.RedirectURIs, " ")
+	if len(redirectURIs) > redirectURIsMaxLength {
+		return nil, fmt.Errorf("%w: redirect_uris must be at most %d characters in total", ErrInvalidRedirectURI, redirectURIsMaxLength)
+	}
+	// 認可コードフローではリダイレクトする先がないと認可できない（RFC 7591 2）
+	if redirectURIs == "" && slices.Contains(strings.Fields(grantTypes), entity.GrantTypeAuthorizationCode) {
+		return nil, fmt.Errorf("%w: redirect_uris is required for the authorization_code grant type", ErrInvalidRedirectURI)
+	}
+
+	scopes := metadata.Scopes
+	if len(scopes) == 0 {
+		scopes = c.policy.AllowedScopes
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(c.policy.AllowedScopes, scope) {
+			return nil, fmt.Errorf("%w: scope %q is not allowed for registered clients", ErrInvalidClientMetadata, scope)
+		}
+	}
+	scope, err := clientScope(scopes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidClientMetadata, err)
+	}
+
+	return &entity.Client{
+		ClientName:              clientName,
+		Scope:                   scope,
+		RedirectURIs:            redirectURIs,
+		GrantTypes:              grantTypes,
+		TokenEndpointAuthMethod: authMethod,
+	}, nil
+}
+
+// validateRedirectURI は https の絶対 URI のみを許可する。開発用にループバックアドレスへの http は認める。
+func validateRedirectURI(redirectURI string) error {
+	parsed, err := url.Parse(redirectURI)
+	if err != nil || !parsed.IsAbs() || parsed.Host == "" || parsed.Fragment != "" || strings.ContainsAny(redirectURI, " \t\r\n") {
+		return fmt.Errorf("%w: %q must be an absolute URI without a fragment", ErrInvalidRedirectURI, redirectURI)
+	}
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if isLoopback(parsed.Hostname()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q must use https", ErrInvalidRedirectURI, redirectURI)
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// getRegisteredClient は登録アクセストークンを検証してクライアントを返す
+func getRegisteredClient(ctx context.Context, repos gateway.Repositories, clientID string, registrationAccessToken string) (*entity.Client, error) {
+	client, err := repos.Client().Get(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidRegistrationToken
+		}
+		return nil, err
+	}
+	// 管理コマンドで登録したクライアントは設定エンドポイントから操作させない
+	if !client.IsDynamicallyRegistered() ||
+		subtle.ConstantTimeCompare([]byte(client.RegistrationAccessTokenHash), []byte(hashRegistrationAccessToken(registrationAccessToken))) != 1 {
+		return nil, ErrInvalidRegistrationToken
+	}
+	return client, nil
+}
+
+// hashRegistrationAccessToken は十分な長さの乱数を前提に、bcrypt ではなく SHA-256 で保存する
+func hashRegistrationAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newRegistrationAuditEvent(ctx context.Context, clock pkg.Clock, operation entity.AuditOperation, clientID string) *entity.AuditEvent {
+	event := newAuditEvent(ctx, clock, operation, clientID, 0, nil)
+	event.Reason = registrationAuditReason
+	return event
+}
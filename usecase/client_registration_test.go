@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+type ClientRegistrationUsecaseSuite struct {
+	suite.Suite
+	clock                     pkg.Clock
+	store                     *inmemory.Store
+	clientRegistrationUsecase *clientRegistrationUsecase
+}
+
+func TestClientRegistrationUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientRegistrationUsecaseSuite))
+}
+
+func (suite *ClientRegistrationUsecaseSuite) SetupTest() {
+	suite.clock = pkg.FixedClock{T: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.clientRegistrationUsecase = NewClientRegistrationUsecase(
+		inmemory.NewTransactionManager(suite.store),
+		RegistrationPolicy{AllowedScopes: []string{AccountReadScope}},
+		suite.clock,
+	)
+}
+
+func (suite *ClientRegistrationUsecaseSuite) register() (*entity.Client, *RegistrationCredentials) {
+	client, credentials, err := suite.clientRegistrationUsecase.Register(context.Background(), ClientMetadata{
+		ClientName:   "sandbox app",
+		RedirectURIs: []string{"https://app.example.com/callback", "http://localhost:8080/callback"},
+	})
+	suite.Require().NoError(err)
+	return client, credentials
+}
+
+func (suite *ClientRegistrationUsecaseSuite) auditEvents() []entity.AuditEvent {
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	return events
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestRegister() {
+	client, credentials := suite.register()
+	suite.NotEmpty(client.ClientID)
+	// 省略した項目は既定値になる
+	suite.Equal(AccountReadScope, client.Scope)
+	suite.Equal(entity.GrantTypeRefreshToken, client.GrantTypes)
+	suite.Equal(entity.TokenEndpointAuthClientSecretBasic, client.TokenEndpointAuthMethod)
+
+	stored, err := suite.store.Client().Get(context.Background(), client.ClientID)
+	suite.Require().NoError(err)
+	suite.Equal([]string{"https://app.example.com/callback", "http://localhost:8080/callback"}, stored.RedirectURIList())
+	// 平文のシークレットと登録アクセストークンは保存しない
+	suite.Require().Len(stored.Secrets, 1)
+	suite.True(pkg.CompareHash(stored.Secrets[0].SecretHash, credentials.ClientSecret))
+	suite.NotEqual(credentials.RegistrationAccessToken, stored.RegistrationAccessTokenHash)
+	suite.True(stored.IsDynamicallyRegistered())
+	// 動的に登録したクライアントはサンドボックスになり、登録日時はシークレットのローテーションで変わらない
+	suite.True(stored.Sandbox)
+	suite.Equal(suite.clock.Now(), stored.CreatedAt)
+
+	events := suite.auditEvents()
+	suite.Require().Len(events, 1)
+	suite.Equal(entity.AuditOperationClientRegister, events[0].Operation)
+	suite.Equal(client.ClientID, events[0].ClientID)
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestRegisterDeviceClient() {
+	client, _, err := suite.clientRegistrationUsecase.Register(context.Background(), ClientMetadata{
+		ClientName: "tv app",
+		GrantTypes: []string{entity.GrantTypeDeviceCode, entity.GrantTypeRefreshToken},
+	})
+	suite.Require().NoError(err)
+	suite.True(client.AllowsGrantType(entity.GrantTypeDeviceCode))
+	suite.Equal([]string{entity.GrantTypeDeviceCode, entity.GrantTypeRefreshToken}, client.GrantTypeList())
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestRegisterInvalidMetadata() {
+	cases := map[string]struct {
+		metadata ClientMetadata
+		err      error
+	}{
+		"no name":               {ClientMetadata{}, ErrInvalidClientMetadata},
+		"scope not allowed":     {ClientMetadata{ClientName: "app", Scopes: []string{AuditReadScope}}, ErrInvalidClientMetadata},
+		"unknown scope":         {ClientMetadata{ClientName: "app", Scopes: []string{"write:account"}}, ErrInvalidClientMetadata},
+		"unsupported grant":     {ClientMetadata{ClientName: "app", GrantTypes: []string{"client_credentials"}}, ErrInvalidClientMetadata},
+		"unsupported auth":      {ClientMetadata{ClientName: "app", TokenEndpointAuthMethod: "none"}, ErrInvalidClientMetadata},
+		"http redirect":         {ClientMetadata{ClientName: "app", RedirectURIs: []string{"http://app.example.com/callback"}}, ErrInvalidRedirectURI},
+		"relative redirect":     {ClientMetadata{ClientName: "app", RedirectURIs: []string{"/callback"}}, ErrInvalidRedirectURI},
+		"fragment in redirect":  {ClientMetadata{ClientName: "app", RedirectURIs: []string{"https://app.example.com/#cb"}}, ErrInvalidRedirectURI},
+		"code without redirect": {ClientMetadata{ClientName: "app", GrantTypes: []string{entity.GrantTypeAuthorizationCode}}, ErrInvalidRedirectURI},
+	}
+	for name, tc := range cases {
+		_, _, err := suite.clientRegistrationUsecase.Register(context.Background(), tc.metadata)
+		suite.ErrorIs(err, tc.err, name)
+	}
+	suite.Empty(suite.auditEvents())
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestGet() {
+	client, credentials := suite.register()
+
+	got, err := suite.clientRegistrationUsecase.Get(context.Background(), client.ClientID, credentials.RegistrationAccessToken)
+	suite.Require().NoError(err)
+	suite.Equal("sandbox app", got.ClientName)
+
+	_, err = suite.clientRegistrationUsecase.Get(context.Background(), client.ClientID, "wrong")
+	suite.ErrorIs(err, ErrInvalidRegistrationToken)
+	_, err = suite.clientRegistrationUsecase.Get(context.Background(), "unknown", credentials.RegistrationAccessToken)
+	suite.ErrorIs(err, ErrInvalidRegistrationToken)
+	// 管理コマンドで登録したクライアントは登録アクセストークンを持たない
+	_, err = suite.clientRegistrationUsecase.Get(context.Background(), inmemory.SeedClientID, "")
+	suite.ErrorIs(err, ErrInvalidRegistrationToken)
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestUpdate() {
+	client, credentials := suite.register()
+
+	updated, newCredentials, err := suite.clientRegistrationUsecase.Update(context.Background(), client.ClientID, credentials.RegistrationAccessToken, ClientMetadata{
+		ClientName: "renamed app",
+		Scopes:     []string{AccountReadScope},
+	})
+	suite.Require().NoError(err)
+	suite.Equal("renamed app", updated.ClientName)
+	// 省略した項目は既定値に置き換える
+	suite.Empty(updated.RedirectURIList())
+	suite.Empty(newCredentials.ClientSecret)
+	suite.NotEqual(credentials.RegistrationAccessToken, newCredentials.RegistrationAccessToken)
+
+	// 登録アクセストークンは発行し直したものだけが使える
+	_, err = suite.clientRegistrationUsecase.Get(context.Background(), client.ClientID, credentials.RegistrationAccessToken)
+	suite.ErrorIs(err, ErrInvalidRegistrationToken)
+	_, err = suite.clientRegistrationUsecase.Get(context.Background(), client.ClientID, newCredentials.RegistrationAccessToken)
+	suite.NoError(err)
+
+	_, _, err = suite.clientRegistrationUsecase.Update(context.Background(), client.ClientID, newCredentials.RegistrationAccessToken, ClientMetadata{
+		ClientName: "app",
+		Scopes:     []string{AuditReadScope},
+	})
+	suite.ErrorIs(err, ErrInvalidClientMetadata)
+
+	events := suite.auditEvents()
+	suite.Require().Len(events, 2)
+	suite.Equal(entity.AuditOperationClientUpdate, events[1].Operation)
+	suite.Equal("registration", events[1].Reason)
+}
+
+func (suite *ClientRegistrationUsecaseSuite) TestDelete() {
+	client, credentials := suite.register()
+
+	suite.ErrorIs(suite.clientRegistrationUsecase.Delete(context.Background(), client.ClientID, "wrong"), ErrInvalidRegistrationToken)
+	suite.Require().NoError(suite.clientRegistrationUsecase.Delete(context.Background(), client.ClientID, credentials.RegistrationAccessToken))
+
+	_, err := suite.store.Client().Get(context.Background(), client.ClientID)
+	suite.Error(err)
+	suite.ErrorIs(suite.clientRegistrationUsecase.Delete(context.Background(), client.ClientID, credentials.RegistrationAccessToken), ErrInvalidRegistrationToken)
+
+	events := suite.auditEvents()
+	suite.Require().Len(events, 2)
+	suite.Equal(entity.AuditOperationClientDelete, events[1].Operation)
+}
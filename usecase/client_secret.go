@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tracing"
+)
+
+// MaxActiveClientSecrets は同時に有効にできるシークレットの数。認証に失敗するたびにこの回数まで bcrypt で比較する。
+const MaxActiveClientSecrets = 2
+
+var (
+	ErrClientSecretNotFound = errors.New("client secret not found")
+	ErrTooManyClientSecrets = errors.New("too many active client secrets")
+	// ErrLastClientSecret は有効なシークレットがなくなり、クライアントが認証できなくなる場合に返す
+	ErrLastClientSecret = errors.New("client must keep at least one active secret")
+	ErrInvalidNotAfter  = errors.New("not_after must not be in the past")
+)
+
+// ClientSecretUsecase はシークレットのローテーション。新しいシークレットを追加し、
+// クライアントが切り替えた後に古いシークレットを失効させる。変更はすべて監査ログに記録する。
+type ClientSecretUsecase interface {
+	List(ctx context.Context, clientID string) ([]entity.ClientSecret, error)
+	// Add は生成したシークレットのハッシュを保存し、平文のシークレットは戻り値でのみ返す
+	Add(ctx context.Context, clientID string) (*entity.ClientSecret, string, error)
+	// Retire は notAfter 以降シークレットを使えなくする。notAfter がゼロ値の場合は直ちに使えなくする。
+	Retire(ctx context.Context, clientID string, secretID int64, notAfter time.Time) (*entity.ClientSecret, error)
+}
+
+type clientSecretUsecase struct {
+	transactionManager TransactionManager
+	clock              pkg.Clock
+}
+
+func NewClientSecretUsecase(transactionManager TransactionManager, clock pkg.Clock) *clientSecretUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &clientSecretUsecase{
+		transactionManager: transactionManager,
+		clock:              clock,
+	}
+}
+
+func (c *clientSecretUsecase) List(ctx context.Context, clientID string) (_ []entity.ClientSecret, err error) {
+	ctx, span := tracing.Start(ctx, "ClientSecretUsecase.List")
+	defer func() { tracing.End(span, err) }()
+
+	var secrets []entity.ClientSecret
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		client, err := repos.Client().Get(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		secrets = client.Secrets
+		return nil
+	})
+	return secrets, err
+}
+
+func (c *clientSecretUsecase) Add(ctx context.Context, clientID string) (_ *entity.ClientSecret, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "ClientSecretUsecase.Add")
+	defer func() { tracing.End(span, err) }()
+
+	plain, secret, err := newClientSecret(c.clock)
+	if err != nil {
+		return nil, "", err
+	}
+	secret.ClientID = clientID
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		// 同時に追加しても上限を超えないよう、数えてから追加するまでクライアントの行をロックする
+		client, err := repos.Client().GetForUpdate(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		if len(client.ActiveSecrets(c.clock.Now())) >= MaxActiveClientSecrets {
+			return ErrTooManyClientSecrets
+		}
+		if err := repos.Client().AddSecret(ctx, secret); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newClientSecretAuditEvent(ctx, c.clock, entity.AuditOperationClientSecretAdd, secret))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return secret, plain, nil
+}
+
+func (c *clientSecretUsecase) Retire(ctx context.Context, clientID string, secretID int64, notAfter time.Time) (_ *entity.ClientSecret, err error) {
+	ctx, span := tracing.Start(ctx, "ClientSecretUsecase.Retire")
+	defer func() { tracing.End(span, err) }()
+
+	now := c.clock.Now()
+	if notAfter.IsZero() {
+		notAfter = now
+	}
+	if notAfter.Before(now) {
+		return nil, ErrInvalidNotAfter
+	}
+
+	var retired *entity.ClientSecret
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		// 同時に失効させて有効なシークレットがなくならないよう、クライアントの行をロックする
+		client, err := repos.Client().GetForUpdate(ctx, clientID)
+		if err != nil {
+			return clientNotFound(err)
+		}
+		remaining := 0
+		for i, secret := range client.Secrets {
+			if secret.ID == secretID {
+				retired = &client.Secrets[i]
+			} else if secret.IsActive(notAfter) {
+				remaining++
+			}
+		}
+		if retired == nil {
+			return ErrClientSecretNotFound
+		}
+		// 失効した後も使えるシークレットが残るようにし、クライアントを締め出さない
+		if remaining == 0 {
+			return ErrLastClientSecret
+		}
+		// 既に期限が近いものを延ばすことはしない
+		if retired.NotAfter != nil && retired.NotAfter.Before(notAfter) {
+			notAfter = *retired.NotAfter
+		}
+		retired.NotAfter = &notAfter
+		if err := repos.Client().SetSecretNotAfter(ctx, clientID, secretID, retired.NotAfter); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newClientSecretAuditEvent(ctx, c.clock, entity.AuditOperationClientSecretRetire, retired))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retired, nil
+}
+
+// newClientSecret は平文のシークレットと、保存するハッシュを返す
+func newClientSecret(clock pkg.Clock) (string, *entity.ClientSecret, error) {
+	plain, err := generateToken()
+	if err != nil {
+		return "", nil, err
+	}
+	hash, err := pkg.HashString(plain)
+	if err != nil {
+		return "", nil, err
+	}
+	return plain, &entity.ClientSecret{SecretHash: hash, CreatedAt: clock.Now()}, nil
+}
+
+// newClientSecretAuditEvent はどのシークレットを変更したかを reason に残す
+func newClientSecretAuditEvent(ctx context.Context, clock pkg.Clock, operation entity.AuditOperation, secret *entity.ClientSecret) *entity.AuditEvent {
+	event := newAuditEvent(ctx, clock, operation, secret.ClientID, 0, nil)
+	event.Reason = "secret_id:" + strconv.FormatInt(secret.ID, 10)
+	return event
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+)
+
+type ClientSecretUsecaseSuite struct {
+	suite.Suite
+	clock               *lockoutClock
+	store               *inmemory.Store
+	clientSecretUsecase *clientSecretUsecase
+	clientUsecase       *clientUsecase
+}
+
+func TestClientSecretUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientSecretUsecaseSuite))
+}
+
+func (suite *ClientSecretUsecaseSuite) SetupTest() {
+	suite.clock = &lockoutClock{now: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.clientSecretUsecase = NewClientSecretUsecase(inmemory.NewTransactionManager(suite.store), suite.clock)
+	suite.clientUsecase = newTestClientUsecase(suite.store.Client(), suite.store.Audit(), suite.clock)
+}
+
+func (suite *ClientSecretUsecaseSuite) authenticate(secret string) error {
+	_, err := suite.clientUsecase.Authenticate(context.Background(), inmemory.SeedClientID, secret, "192.0.2.1")
+	return err
+}
+
+func (suite *ClientSecretUsecaseSuite) auditEvents(operation entity.AuditOperation) []entity.AuditEvent {
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{Operation: operation})
+	suite.Require().NoError(err)
+	return events
+}
+
+func (suite *ClientSecretUsecaseSuite) TestRotate() {
+	ctx := context.Background()
+	secrets, err := suite.clientSecretUsecase.List(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.Require().Len(secrets, 1)
+	oldSecretID := secrets[0].ID
+
+	newSecret, plain, err := suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	suite.NotEqual(oldSecretID, newSecret.ID)
+
+	// 切り替え期間中はどちらのシークレットでも認証できる
+	suite.NoError(suite.authenticate(inmemory.SeedClientSecret))
+	suite.NoError(suite.authenticate(plain))
+
+	notAfter := suite.clock.now.Add(24 * time.Hour)
+	retired, err := suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, oldSecretID, notAfter)
+	suite.Require().NoError(err)
+	suite.Equal(notAfter, *retired.NotAfter)
+	suite.NoError(suite.authenticate(inmemory.SeedClientSecret))
+
+	suite.clock.now = notAfter
+	suite.ErrorIs(suite.authenticate(inmemory.SeedClientSecret), ErrInvalidClient)
+	suite.NoError(suite.authenticate(plain))
+
+	added := suite.auditEvents(entity.AuditOperationClientSecretAdd)
+	suite.Require().Len(added, 1)
+	suite.Equal("secret_id:2", added[0].Reason)
+	suite.Len(suite.auditEvents(entity.AuditOperationClientSecretRetire), 1)
+}
+
+func (suite *ClientSecretUsecaseSuite) TestAddLimitsActiveSecrets() {
+	ctx := context.Background()
+	_, _, err := suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	_, _, err = suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.ErrorIs(err, ErrTooManyClientSecrets)
+
+	// 失効させれば追加できる
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, time.Time{})
+	suite.Require().NoError(err)
+	_, _, err = suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.NoError(err)
+
+	_, _, err = suite.clientSecretUsecase.Add(ctx, "unknown")
+	suite.ErrorIs(err, ErrClientNotFound)
+}
+
+func (suite *ClientSecretUsecaseSuite) TestRetireErrors() {
+	ctx := context.Background()
+	// 最後の有効なシークレットは失効させられない
+	_, err := suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, time.Time{})
+	suite.ErrorIs(err, ErrLastClientSecret)
+
+	newSecret, _, err := suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	// 新しいシークレットも先に失効する場合は認められない
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, newSecret.ID, suite.clock.now.Add(time.Hour))
+	suite.Require().NoError(err)
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, suite.clock.now.Add(2*time.Hour))
+	suite.ErrorIs(err, ErrLastClientSecret)
+
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 99, time.Time{})
+	suite.ErrorIs(err, ErrClientSecretNotFound)
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, suite.clock.now.Add(-time.Second))
+	suite.ErrorIs(err, ErrInvalidNotAfter)
+	suite.Empty(suite.auditEvents(entity.AuditOperationClientSecretRetire)[1:])
+}
+
+func (suite *ClientSecretUsecaseSuite) TestRetireDoesNotExtend() {
+	ctx := context.Background()
+	_, _, err := suite.clientSecretUsecase.Add(ctx, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	soon := suite.clock.now.Add(time.Hour)
+	_, err = suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, soon)
+	suite.Require().NoError(err)
+
+	retired, err := suite.clientSecretUsecase.Retire(ctx, inmemory.SeedClientID, 1, soon.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Equal(soon, *retired.NotAfter)
+}
@@ -1,13 +1,18 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"gorm.io/gorm"
 
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
 	"go-banking-api/entity"
 	"go-banking-api/pkg"
 )
@@ -20,7 +25,7 @@ func NewMockClientRepository() *mockClientRepository {
 	return &mockClientRepository{}
 }
 
-func (m *mockClientRepository) Get(clientID string) (*entity.Client, error) {
+func (m *mockClientRepository) Get(_ context.Context, clientID string) (*entity.Client, error) {
 	args := m.Called(clientID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -28,6 +33,80 @@ func (m *mockClientRepository) Get(clientID string) (*entity.Client, error) {
 	return args.Get(0).(*entity.Client), args.Error(1)
 }
 
+func (m *mockClientRepository) GetForUpdate(ctx context.Context, clientID string) (*entity.Client, error) {
+	return m.Get(ctx, clientID)
+}
+
+func (m *mockClientRepository) Create(_ context.Context, client *entity.Client) error {
+	args := m.Called(client)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) List(_ context.Context) ([]entity.Client, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Client), args.Error(1)
+}
+
+func (m *mockClientRepository) UpdateScope(_ context.Context, clientID string, scope string) error {
+	args := m.Called(clientID, scope)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) UpdateMetadata(_ context.Context, client *entity.Client) error {
+	args := m.Called(client)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) SetRegistrationAccessTokenHash(_ context.Context, clientID string, hash string) error {
+	args := m.Called(clientID, hash)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) UpdateJWKS(_ context.Context, clientID string, jwks string) error {
+	args := m.Called(clientID, jwks)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) SetFAPI(_ context.Context, clientID string, fapi bool) error {
+	args := m.Called(clientID, fapi)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) SetSandbox(_ context.Context, clientID string, sandbox bool) error {
+	args := m.Called(clientID, sandbox)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) SetDisabledAt(_ context.Context, clientID string, disabledAt *time.Time) error {
+	args := m.Called(clientID, disabledAt)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) Delete(_ context.Context, clientID string) error {
+	args := m.Called(clientID)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) AddSecret(_ context.Context, secret *entity.ClientSecret) error {
+	args := m.Called(secret)
+	return args.Error(0)
+}
+
+func (m *mockClientRepository) SetSecretNotAfter(_ context.Context, clientID string, secretID int64, notAfter *time.Time) error {
+	args := m.Called(clientID, secretID, notAfter)
+	return args.Error(0)
+}
+
+// newTestClientUsecase はインメモリのロック記録を使い、応答を遅らせない clientUsecase を返す
+func newTestClientUsecase(clientRepository gateway.ClientRepository, auditRepository gateway.AuditRepository, clock pkg.Clock) *clientUsecase {
+	clientUsecase := NewClientUsecase(clientRepository, inmemory.NewStore().AuthLockout(), auditRepository, DefaultLockoutPolicy, clock)
+	clientUsecase.sleep = func(context.Context, time.Duration) {}
+	return clientUsecase
+}
+
 type ClientUsecaseSuite struct {
 	suite.Suite
 	clientUsecase *clientUsecase
@@ -39,78 +118,282 @@ func TestClientUsecaseSuite(t *testing.T) {
 
 func (suite *ClientUsecaseSuite) TestAuthenticateSuccess() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
 	secretHash, err := pkg.HashString("secret-1")
 	suite.Require().NoError(err)
 
 	mockClientRepository.On("Get", "client-1").Return(&entity.Client{
-		ClientID:     "client-1",
-		ClientSecret: secretHash,
-		ClientName:   "Test Client",
-		Scope:        "read:account_and_transactions",
+		ClientID:   "client-1",
+		Secrets:    []entity.ClientSecret{{SecretHash: secretHash}},
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
 	}, nil)
 
-	client, err := suite.clientUsecase.Authenticate("client-1", "secret-1")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("client-1", client.ClientID)
 }
 
 func (suite *ClientUsecaseSuite) TestAuthenticateMissingClientID() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
-	client, err := suite.clientUsecase.Authenticate("", "secret-1")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "", "secret-1", "192.0.2.1")
 	suite.Assert().Nil(client)
 	suite.Assert().ErrorIs(err, ErrClientIDRequired)
 }
 
 func (suite *ClientUsecaseSuite) TestAuthenticateMissingClientSecret() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
-	client, err := suite.clientUsecase.Authenticate("client-1", "")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "", "192.0.2.1")
 	suite.Assert().Nil(client)
 	suite.Assert().ErrorIs(err, ErrClientSecretRequired)
 }
 
 func (suite *ClientUsecaseSuite) TestAuthenticateNotFound() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
 	mockClientRepository.On("Get", "client-1").Return(nil, gorm.ErrRecordNotFound)
 
-	client, err := suite.clientUsecase.Authenticate("client-1", "secret-1")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
 	suite.Assert().Nil(client)
 	suite.Assert().ErrorIs(err, ErrInvalidClient)
 }
 
 func (suite *ClientUsecaseSuite) TestAuthenticateInvalidSecret() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
 	secretHash, err := pkg.HashString("secret-1")
 	suite.Require().NoError(err)
 
 	mockClientRepository.On("Get", "client-1").Return(&entity.Client{
-		ClientID:     "client-1",
-		ClientSecret: secretHash,
-		ClientName:   "Test Client",
-		Scope:        "read:account_and_transactions",
+		ClientID:   "client-1",
+		Secrets:    []entity.ClientSecret{{SecretHash: secretHash}},
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
 	}, nil)
 
-	client, err := suite.clientUsecase.Authenticate("client-1", "secret-2")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-2", "192.0.2.1")
 	suite.Assert().Nil(client)
 	suite.Assert().ErrorIs(err, ErrInvalidClient)
 }
 
+func (suite *ClientUsecaseSuite) TestAuthenticateRotatedSecrets() {
+	mockClientRepository := NewMockClientRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	clock := &lockoutClock{now: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, clock)
+
+	oldHash, err := pkg.HashString("secret-old")
+	suite.Require().NoError(err)
+	newHash, err := pkg.HashString("secret-new")
+	suite.Require().NoError(err)
+	notAfter := clock.now.Add(time.Hour)
+
+	mockClientRepository.On("Get", "client-1").Return(&entity.Client{
+		ClientID: "client-1",
+		Secrets: []entity.ClientSecret{
+			{ID: 1, SecretHash: oldHash, NotAfter: &notAfter},
+			{ID: 2, SecretHash: newHash},
+		},
+	}, nil)
+
+	// 切り替え期間中はどちらでも認証できる
+	_, err = suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-old", "192.0.2.1")
+	suite.Assert().NoError(err)
+	_, err = suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-new", "192.0.2.1")
+	suite.Assert().NoError(err)
+
+	clock.now = notAfter
+	_, err = suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-old", "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrInvalidClient)
+	_, err = suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-new", "192.0.2.1")
+	suite.Assert().NoError(err)
+}
+
+func (suite *ClientUsecaseSuite) TestAuthenticateDisabled() {
+	mockClientRepository := NewMockClientRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
+
+	secretHash, err := pkg.HashString("secret-1")
+	suite.Require().NoError(err)
+	disabledAt := time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)
+
+	mockClientRepository.On("Get", "client-1").Return(&entity.Client{
+		ClientID:   "client-1",
+		Secrets:    []entity.ClientSecret{{SecretHash: secretHash}},
+		ClientName: "Test Client",
+		Scope:      "read:account_and_transactions",
+		DisabledAt: &disabledAt,
+	}, nil)
+
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
+	suite.Assert().Nil(client)
+	suite.Assert().ErrorIs(err, ErrClientDisabled)
+	suite.Require().Len(mockAuditRepository.events, 1)
+	suite.Assert().Equal("client_disabled", mockAuditRepository.events[0].Reason)
+}
+
 func (suite *ClientUsecaseSuite) TestAuthenticateRepositoryError() {
 	mockClientRepository := NewMockClientRepository()
-	suite.clientUsecase = NewClientUsecase(mockClientRepository)
+	mockAuditRepository := NewMockAuditRepository()
+	suite.clientUsecase = newTestClientUsecase(mockClientRepository, mockAuditRepository, nil)
 
 	mockClientRepository.On("Get", "client-1").Return(nil, errors.New("db error"))
 
-	client, err := suite.clientUsecase.Authenticate("client-1", "secret-1")
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
 	suite.Assert().Nil(client)
 	suite.Assert().Equal("db error", err.Error())
 }
+
+type lockoutClock struct {
+	now time.Time
+}
+
+func (c *lockoutClock) Now() time.Time {
+	return c.now
+}
+
+type ClientLockoutSuite struct {
+	suite.Suite
+	clientRepository *mockClientRepository
+	auditRepository  *mockAuditRepository
+	clock            *lockoutClock
+	clientUsecase    *clientUsecase
+	delays           []time.Duration
+}
+
+func TestClientLockoutSuite(t *testing.T) {
+	suite.Run(t, new(ClientLockoutSuite))
+}
+
+func (suite *ClientLockoutSuite) SetupTest() {
+	secretHash, err := pkg.HashString("secret-1")
+	suite.Require().NoError(err)
+	suite.clientRepository = NewMockClientRepository()
+	suite.clientRepository.On("Get", "client-1").Return(&entity.Client{ClientID: "client-1", Secrets: []entity.ClientSecret{{SecretHash: secretHash}}}, nil)
+	suite.clientRepository.On("Get", mock.Anything).Return(nil, gorm.ErrRecordNotFound)
+	suite.auditRepository = NewMockAuditRepository()
+	suite.clock = &lockoutClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	suite.clientUsecase = NewClientUsecase(suite.clientRepository, inmemory.NewStore().AuthLockout(), suite.auditRepository, DefaultLockoutPolicy, suite.clock)
+	suite.delays = nil
+	suite.clientUsecase.sleep = func(_ context.Context, d time.Duration) {
+		suite.delays = append(suite.delays, d)
+	}
+}
+
+func (suite *ClientLockoutSuite) fail(clientID string, sourceIP string, times int) {
+	for i := 0; i < times; i++ {
+		_, err := suite.clientUsecase.Authenticate(context.Background(), clientID, "wrong-secret", sourceIP)
+		suite.Require().ErrorIs(err, ErrInvalidClient)
+	}
+}
+
+func (suite *ClientLockoutSuite) lockoutEvents() []entity.AuditEvent {
+	var events []entity.AuditEvent
+	for _, event := range suite.auditRepository.events {
+		if event.Operation == entity.AuditOperationClientLockout || event.Operation == entity.AuditOperationClientUnlock {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func (suite *ClientLockoutSuite) TestLocksAfterRepeatedFailures() {
+	suite.fail("client-1", "192.0.2.1", DefaultLockoutPolicy.Threshold)
+
+	// 失敗するほど応答を遅らせる
+	suite.Assert().Equal([]time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 400 * time.Millisecond, 500 * time.Millisecond}, suite.delays)
+
+	// 正しいシークレットでもロック中は失敗する
+	_, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrClientLocked)
+
+	events := suite.lockoutEvents()
+	suite.Require().Len(events, 2)
+	suite.Assert().Equal("client_id_source_ip", events[0].Reason)
+	suite.Assert().Equal("source_ip", events[1].Reason)
+	suite.Assert().Equal("client-1", events[0].ClientID)
+
+	last := suite.auditRepository.events[len(suite.auditRepository.events)-1]
+	suite.Assert().Equal(entity.AuditOperationClientAuthenticate, last.Operation)
+	suite.Assert().Equal("client_locked", last.Reason)
+}
+
+func (suite *ClientLockoutSuite) TestUnknownClientIsLockedTheSameWay() {
+	suite.fail("unknown", "192.0.2.1", DefaultLockoutPolicy.Threshold)
+
+	_, err := suite.clientUsecase.Authenticate(context.Background(), "unknown", "wrong-secret", "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrClientLocked)
+	suite.Assert().Len(suite.lockoutEvents(), 2)
+}
+
+func (suite *ClientLockoutSuite) TestLockExpires() {
+	suite.fail("client-1", "", DefaultLockoutPolicy.Threshold)
+
+	suite.clock.now = suite.clock.now.Add(DefaultLockoutPolicy.LockDuration)
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("client-1", client.ClientID)
+
+	// 成功すると失敗回数を数え直す
+	for _, key := range []string{"client:client-1", "client:client-1|ip:"} {
+		_, err = suite.clientUsecase.lockoutRepository.Get(context.Background(), key)
+		suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound, key)
+	}
+}
+
+func (suite *ClientLockoutSuite) TestOtherSourceIPsCannotLockClient() {
+	for i := 0; i < 2*DefaultLockoutPolicy.Threshold; i++ {
+		suite.fail("client-1", "192.0.2."+strconv.Itoa(i+1), 1)
+	}
+	suite.Assert().Empty(suite.lockoutEvents())
+	// クライアント ID ごとの失敗回数は応答を遅らせるのにだけ使う
+	suite.Assert().Equal(DefaultLockoutPolicy.MaxDelay, suite.delays[len(suite.delays)-1])
+
+	client, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "198.51.100.1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal("client-1", client.ClientID)
+}
+
+func (suite *ClientLockoutSuite) TestLocksSourceIPAcrossClientIDs() {
+	for i := 0; i < DefaultLockoutPolicy.Threshold; i++ {
+		suite.fail("unknown-"+strconv.Itoa(i), "192.0.2.1", 1)
+	}
+
+	_, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrClientLocked)
+
+	_, err = suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.2")
+	suite.Assert().NoError(err)
+}
+
+func (suite *ClientLockoutSuite) TestUnlock() {
+	suite.fail("client-1", "192.0.2.1", DefaultLockoutPolicy.Threshold)
+
+	suite.Require().NoError(suite.clientUsecase.UnlockClient(context.Background(), "client-1"))
+	suite.Require().NoError(suite.clientUsecase.UnlockSourceIP(context.Background(), "192.0.2.1"))
+
+	_, err := suite.clientUsecase.Authenticate(context.Background(), "client-1", "secret-1", "192.0.2.1")
+	suite.Assert().NoError(err)
+
+	events := suite.lockoutEvents()
+	suite.Require().Len(events, 4)
+	suite.Assert().Equal(entity.AuditOperationClientUnlock, events[2].Operation)
+	suite.Assert().Equal("client-1", events[2].ClientID)
+	suite.Assert().Equal(entity.AuditOperationClientUnlock, events[3].Operation)
+	suite.Assert().Equal("source_ip", events[3].Reason)
+
+	suite.Assert().ErrorIs(suite.clientUsecase.UnlockClient(context.Background(), "client-1"), ErrLockoutNotFound)
+}
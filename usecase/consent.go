@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// ConsentManageScope は顧客が自分の同意を一覧・取り消しするためのスコープ（銀行のアプリなどに許可する）
+const ConsentManageScope = "manage:consents"
+
+var (
+	// ErrConsentExpired は同意の期限切れのほか、同意がない場合や取り消した場合にも返す
+	ErrConsentExpired = errors.New("consent expired")
+	// ErrConsentNotFound は他の顧客の同意を指定した場合も返し、存在を推測させない
+	ErrConsentNotFound = errors.New("consent not found")
+	// ErrSandboxCustomerRequired はサンドボックスのクライアントにテスト用でない顧客が同意しようとした場合に返す
+	ErrSandboxCustomerRequired = errors.New("sandbox clients can only be authorized by sandbox customers")
+)
+
+// ConsentDetail は顧客に表示する同意。取り消し済みや期限切れのものも含む。
+type ConsentDetail struct {
+	entity.Consent
+	ClientName string
+	// Accounts は同意したときの口座のうち、現在も存在するもの
+	Accounts []entity.Account
+}
+
+// ConsentUsecase は顧客による同意の確認と取り消し。取り消しは監査ログに記録する。
+type ConsentUsecase interface {
+	List(ctx context.Context, cifNo int) ([]ConsentDetail, error)
+	// Revoke は同意を取り消し、そのクライアントが cifNo のために発行したトークンをすべて失効させる。
+	// 取り消し済みの同意を指定した場合は何もしない。
+	Revoke(ctx context.Context, cifNo int, consentID int64) error
+}
+
+type consentUsecase struct {
+	transactionManager TransactionManager
+	clock              pkg.Clock
+}
+
+func NewConsentUsecase(transactionManager TransactionManager, clock pkg.Clock) *consentUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &consentUsecase{
+		transactionManager: transactionManager,
+		clock:              clock,
+	}
+}
+
+func (c *consentUsecase) List(ctx context.Context, cifNo int) (_ []ConsentDetail, err error) {
+	ctx, span := tracing.Start(ctx, "ConsentUsecase.List")
+	defer func() { tracing.End(span, err) }()
+
+	var details []ConsentDetail
+	err = c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		consents, err := repos.Consent().ListByCifNo(ctx, cifNo)
+		if err != nil {
+			return err
+		}
+		accounts, err := repos.Account().List(ctx, cifNo)
+		if err != nil {
+			return err
+		}
+		details = make([]ConsentDetail, 0, len(consents))
+		for _, consent := range consents {
+			detail := ConsentDetail{Consent: consent, Accounts: []entity.Account{}}
+			// 削除したクライアントの同意はクライアントとともに削除するため、見つからない場合は名前を空にするだけにする
+			client, err := repos.Client().Get(ctx, consent.ClientID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			if client != nil {
+				detail.ClientName = client.ClientName
+			}
+			for _, id := range consent.AccountIDList() {
+				for _, account := range accounts {
+					if account.Id == id {
+						detail.Accounts = append(detail.Accounts, account)
+					}
+				}
+			}
+			details = append(details, detail)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func (c *consentUsecase) Revoke(ctx context.Context, cifNo int, consentID int64) (err error) {
+	ctx, span := tracing.Start(ctx, "ConsentUsecase.Revoke")
+	defer func() { tracing.End(span, err) }()
+
+	return c.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		consent, err := repos.Consent().GetByID(ctx, consentID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrConsentNotFound
+			}
+			return err
+		}
+		if consent.CifNo != cifNo {
+			return ErrConsentNotFound
+		}
+		if consent.RevokedAt != nil {
+			return nil
+		}
+		if err := repos.Consent().SetRevokedAt(ctx, consentID, c.clock.Now()); err != nil {
+			return err
+		}
+		if _, err := repos.Token().DeleteByCustomerAndClient(ctx, cifNo, consent.ClientID); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newConsentAuditEvent(ctx, c.clock, entity.AuditOperationConsentRevoke, consent))
+	})
+}
+
+// grantConsent は cifNo の全口座について、client に scope（スペース区切り）の同意を記録する。
+// 同意済みの場合は期限を延ばし、取り消していた場合は同意し直したものとする。
+func grantConsent(ctx context.Context, repos gateway.Repositories, clock pkg.Clock, client *entity.Client, scope string, cifNo int) error {
+	if client.Sandbox {
+		customer, err := repos.Customer().Get(ctx, cifNo)
+		if err != nil {
+			return err
+		}
+		if !customer.Sandbox {
+			return ErrSandboxCustomerRequired
+		}
+	}
+	accounts, err := repos.Account().List(ctx, cifNo)
+	if err != nil {
+		return err
+	}
+	accountIDs := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		accountIDs = append(accountIDs, strconv.Itoa(account.Id))
+	}
+	now := clock.Now()
+	consent := &entity.Consent{
+		CifNo:      cifNo,
+		ClientID:   client.ClientID,
+		Scope:      scope,
+		AccountIDs: strings.Join(accountIDs, " "),
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(entity.ConsentTTL),
+	}
+	if err := repos.Consent().Save(ctx, consent); err != nil {
+		return err
+	}
+	return repos.Audit().Append(ctx, newConsentAuditEvent(ctx, clock, entity.AuditOperationConsentGrant, consent))
+}
+
+// checkConsent は cifNo が clientID に与えた同意が有効であることを確認する
+func checkConsent(ctx context.Context, repos gateway.Repositories, clock pkg.Clock, cifNo int, clientID string) error {
+	consent, err := repos.Consent().Get(ctx, cifNo, clientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrConsentExpired
+		}
+		return err
+	}
+	if !consent.IsActive(clock.Now()) {
+		return ErrConsentExpired
+	}
+	return nil
+}
+
+func newConsentAuditEvent(ctx context.Context, clock pkg.Clock, operation entity.AuditOperation, consent *entity.Consent) *entity.AuditEvent {
+	event := newAuditEvent(ctx, clock, operation, consent.ClientID, consent.CifNo, nil)
+	event.Reason = "consent_id:" + strconv.FormatInt(consent.ID, 10)
+	return event
+}
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+)
+
+type mockConsentRepository struct {
+	mock.Mock
+}
+
+func NewMockConsentRepository() *mockConsentRepository {
+	return &mockConsentRepository{}
+}
+
+func (m *mockConsentRepository) ListByCifNo(_ context.Context, cifNo int) ([]entity.Consent, error) {
+	args := m.Called(cifNo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Consent), args.Error(1)
+}
+
+func (m *mockConsentRepository) Save(_ context.Context, consent *entity.Consent) error {
+	args := m.Called(consent)
+	return args.Error(0)
+}
+
+func (m *mockConsentRepository) DeleteByClientID(_ context.Context, clientID string) error {
+	args := m.Called(clientID)
+	return args.Error(0)
+}
+
+func (m *mockConsentRepository) Get(_ context.Context, cifNo int, clientID string) (*entity.Consent, error) {
+	args := m.Called(cifNo, clientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Consent), args.Error(1)
+}
+
+func (m *mockConsentRepository) GetByID(_ context.Context, id int64) (*entity.Consent, error) {
+	args := m.Called(id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Consent), args.Error(1)
+}
+
+func (m *mockConsentRepository) SetRevokedAt(_ context.Context, id int64, revokedAt time.Time) error {
+	args := m.Called(id, revokedAt)
+	return args.Error(0)
+}
+
+type ConsentUsecaseSuite struct {
+	suite.Suite
+	clock          *lockoutClock
+	store          *inmemory.Store
+	consentUsecase *consentUsecase
+	tokenUsecase   *tokenUsecase
+}
+
+func TestConsentUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ConsentUsecaseSuite))
+}
+
+func (suite *ConsentUsecaseSuite) SetupTest() {
+	suite.clock = &lockoutClock{now: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	transactionManager := inmemory.NewTransactionManager(suite.store)
+	suite.consentUsecase = NewConsentUsecase(transactionManager, suite.clock)
+	suite.tokenUsecase = NewTokenUsecase(suite.store.Token(), suite.store.Audit(), transactionManager, nil, suite.clock)
+}
+
+func (suite *ConsentUsecaseSuite) auditEvents(operation entity.AuditOperation) []entity.AuditEvent {
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{Operation: operation})
+	suite.Require().NoError(err)
+	return events
+}
+
+func (suite *ConsentUsecaseSuite) TestList() {
+	consents, err := suite.consentUsecase.List(context.Background(), inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+	suite.Require().Len(consents, 1)
+	suite.Equal("Demo Client", consents[0].ClientName)
+	suite.Require().Len(consents[0].Accounts, 1)
+	suite.Equal("1234567", consents[0].Accounts[0].AccountNumber)
+	suite.Equal(entity.ConsentStatusActive, consents[0].Status(suite.clock.Now()))
+
+	// 他の顧客の同意は含めない
+	consents, err = suite.consentUsecase.List(context.Background(), 2)
+	suite.Require().NoError(err)
+	suite.Empty(consents)
+}
+
+func (suite *ConsentUsecaseSuite) TestRevoke() {
+	ctx := context.Background()
+	consent, err := suite.store.Consent().Get(ctx, inmemory.SeedCifNo, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.consentUsecase.Revoke(ctx, inmemory.SeedCifNo, consent.ID))
+
+	revoked, err := suite.store.Consent().GetByID(ctx, consent.ID)
+	suite.Require().NoError(err)
+	suite.Equal(entity.ConsentStatusRevoked, revoked.Status(suite.clock.Now()))
+	// 発行済みのトークンも失効する
+	_, err = suite.store.Token().GetByRefreshToken(ctx, inmemory.SeedRefreshToken)
+	suite.Error(err)
+
+	// 取り消し済みの場合は何もしない
+	suite.NoError(suite.consentUsecase.Revoke(ctx, inmemory.SeedCifNo, consent.ID))
+	events := suite.auditEvents(entity.AuditOperationConsentRevoke)
+	suite.Require().Len(events, 1)
+	suite.Equal(inmemory.SeedClientID, events[0].ClientID)
+	suite.Equal(inmemory.SeedCifNo, events[0].CifNo)
+}
+
+func (suite *ConsentUsecaseSuite) TestRevokeNotFound() {
+	ctx := context.Background()
+	consent, err := suite.store.Consent().Get(ctx, inmemory.SeedCifNo, inmemory.SeedClientID)
+	suite.Require().NoError(err)
+
+	suite.ErrorIs(suite.consentUsecase.Revoke(ctx, inmemory.SeedCifNo, 99), ErrConsentNotFound)
+	// 他の顧客の同意は取り消せない
+	suite.ErrorIs(suite.consentUsecase.Revoke(ctx, 2, consent.ID), ErrConsentNotFound)
+	_, err = suite.store.Token().GetByRefreshToken(ctx, inmemory.SeedRefreshToken)
+	suite.NoError(err)
+}
+
+func (suite *ConsentUsecaseSuite) TestRefreshAfterConsentExpires() {
+	ctx := context.Background()
+	token, err := suite.tokenUsecase.Refresh(ctx, inmemory.SeedRefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.Require().NoError(err)
+
+	suite.clock.now = suite.clock.now.Add(entity.ConsentTTL)
+	_, err = suite.tokenUsecase.Refresh(ctx, token.RefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.ErrorIs(err, ErrConsentExpired)
+
+	// 同意し直せば再び更新できる
+	admin := NewClientAdminUsecase(inmemory.NewTransactionManager(suite.store), suite.clock)
+	_, err = admin.IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+	_, err = suite.tokenUsecase.Refresh(ctx, token.RefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.NoError(err)
+}
@@ -0,0 +1,374 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// deviceCodeTTL は device_code と user_code の有効期間
+	deviceCodeTTL = 10 * time.Minute
+	// devicePollingInterval はポーリングの間隔の初期値（秒）。slow_down を返すたびに 5 秒延ばす（RFC 8628 3.5）。
+	devicePollingInterval          = 5
+	devicePollingIntervalIncrement = 5
+	// userCodeCharset は読み間違えにくいよう母音と紛らわしい文字を除いた 20 文字（RFC 8628 6.1）
+	userCodeCharset = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength  = 8
+	// userCodeMaxAttempts は生成した user_code が使用中のものと重複した場合に生成し直す回数
+	userCodeMaxAttempts = 3
+)
+
+var (
+	// ErrUnauthorizedClient はクライアントに許可していないグラントタイプの場合に返す
+	ErrUnauthorizedClient = errors.New("unauthorized client")
+	ErrDeviceCodeRequired = errors.New("device code is required")
+	// ErrInvalidDeviceCode は使用済みの場合や、他のクライアントの device_code の場合も返す
+	ErrInvalidDeviceCode = errors.New("invalid device code")
+	// 以下の 4 つは RFC 8628 3.5 のポーリングに対するエラー
+	ErrAuthorizationPending = errors.New("authorization pending")
+	ErrSlowDown             = errors.New("slow down")
+	ErrDeviceCodeExpired    = errors.New("device code expired")
+	ErrDeviceAccessDenied   = errors.New("device access denied")
+	// ErrUserCodeNotFound は期限切れの場合や、承認・拒否済みの場合も返す
+	ErrUserCodeNotFound = errors.New("user code not found")
+)
+
+// DeviceAuthorizationDetail は顧客が承認する前に表示する認可リクエスト
+type DeviceAuthorizationDetail struct {
+	entity.DeviceAuthorization
+	ClientName string
+}
+
+// DeviceAuthorizationUsecase はデバイス認可グラント（RFC 8628）。ブラウザのないデバイスの代わりに、
+// 顧客が銀行のアプリでユーザーコードを入力して承認し、デバイスは /token のポーリングでトークンを受け取る。
+type DeviceAuthorizationUsecase interface {
+	// Authorize は認証済みの client のリクエストを保存し、device_code と user_code を設定して返す。
+	// scope が空の場合はクライアントのすべてのスコープを要求したものとする。
+	Authorize(ctx context.Context, client *entity.Client, scope string) (*entity.DeviceAuthorization, error)
+	// Get は顧客が入力した userCode の認可リクエストを返す。ハイフンや空白、大文字・小文字の違いは無視する。
+	Get(ctx context.Context, userCode string) (*DeviceAuthorizationDetail, error)
+	// Approve は cifNo の顧客が承認したものとし、要求されたスコープと顧客のすべての口座について同意を記録する
+	Approve(ctx context.Context, cifNo int, userCode string) error
+	Deny(ctx context.Context, cifNo int, userCode string) error
+	// Exchange は clientID がポーリングした deviceCode を、顧客が承認していればトークンと交換する。
+	// 交換した device_code は削除し、二度は使えない。
+	Exchange(ctx context.Context, client *entity.Client, deviceCode string) (*entity.Token, error)
+}
+
+type deviceAuthorizationUsecase struct {
+	transactionManager TransactionManager
+	// auditRepository は交換の失敗をトランザクションの外で記録する
+	auditRepository gateway.AuditRepository
+	idTokenIssuer   IDTokenIssuer
+	clock           pkg.Clock
+}
+
+func NewDeviceAuthorizationUsecase(transactionManager TransactionManager, auditRepository gateway.AuditRepository, idTokenIssuer IDTokenIssuer, clock pkg.Clock) *deviceAuthorizationUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &deviceAuthorizationUsecase{
+		transactionManager: transactionManager,
+		auditRepository:    auditRepository,
+		idTokenIssuer:      idTokenIssuer,
+		clock:              clock,
+	}
+}
+
+func (d *deviceAuthorizationUsecase) Authorize(ctx context.Context, client *entity.Client, scope string) (_ *entity.DeviceAuthorization, err error) {
+	ctx, span := tracing.Start(ctx, "DeviceAuthorizationUsecase.Authorize")
+	defer func() { tracing.End(span, err) }()
+
+	if !client.AllowsGrantType(entity.GrantTypeDeviceCode) {
+		return nil, ErrUnauthorizedClient
+	}
+	if strings.TrimSpace(scope) == "" {
+		scope = client.Scope
+	}
+	if scope, err = requestedScope(client, scope); err != nil {
+		return nil, err
+	}
+	deviceCode, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	now := d.clock.Now()
+	authorization := &entity.DeviceAuthorization{
+		DeviceCode: deviceCode,
+		ClientID:   client.ClientID,
+		Scope:      scope,
+		Status:     entity.DeviceAuthorizationStatusPending,
+		Interval:   devicePollingInterval,
+		CreatedAt:  now.UTC(),
+		ExpiresAt:  now.Add(deviceCodeTTL).UTC(),
+	}
+
+	for range userCodeMaxAttempts {
+		if authorization.UserCode, err = generateUserCode(); err != nil {
+			return nil, err
+		}
+		err = d.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+			if _, err := repos.DeviceAuthorization().DeleteExpired(ctx, now); err != nil {
+				return err
+			}
+			return repos.DeviceAuthorization().Create(ctx, authorization)
+		})
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+func (d *deviceAuthorizationUsecase) Get(ctx context.Context, userCode string) (_ *DeviceAuthorizationDetail, err error) {
+	ctx, span := tracing.Start(ctx, "DeviceAuthorizationUsecase.Get")
+	defer func() { tracing.End(span, err) }()
+
+	var detail *DeviceAuthorizationDetail
+	err = d.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, client, err := d.pendingAuthorization(ctx, repos, userCode)
+		if err != nil {
+			return err
+		}
+		detail = &DeviceAuthorizationDetail{DeviceAuthorization: *authorization, ClientName: client.ClientName}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+func (d *deviceAuthorizationUsecase) Approve(ctx context.Context, cifNo int, userCode string) (err error) {
+	ctx, span := tracing.Start(ctx, "DeviceAuthorizationUsecase.Approve")
+	defer func() { tracing.End(span, err) }()
+
+	return d.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, client, err := d.pendingAuthorization(ctx, repos, userCode)
+		if err != nil {
+			return err
+		}
+		if err := d.updateStatus(ctx, repos, authorization.DeviceCode, entity.DeviceAuthorizationStatusApproved, cifNo); err != nil {
+			return err
+		}
+		return grantConsent(ctx, repos, d.clock, client, authorization.Scope, cifNo)
+	})
+}
+
+func (d *deviceAuthorizationUsecase) Deny(ctx context.Context, cifNo int, userCode string) (err error) {
+	ctx, span := tracing.Start(ctx, "DeviceAuthorizationUsecase.Deny")
+	defer func() { tracing.End(span, err) }()
+
+	return d.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, _, err := d.pendingAuthorization(ctx, repos, userCode)
+		if err != nil {
+			return err
+		}
+		return d.updateStatus(ctx, repos, authorization.DeviceCode, entity.DeviceAuthorizationStatusDenied, cifNo)
+	})
+}
+
+// pendingAuthorization は userCode の承認待ちの認可リクエストと、その発行先のクライアントを返す
+func (d *deviceAuthorizationUsecase) pendingAuthorization(ctx context.Context, repos gateway.Repositories, userCode string) (*entity.DeviceAuthorization, *entity.Client, error) {
+	userCode = normalizeUserCode(userCode)
+	if len(userCode) != userCodeLength {
+		return nil, nil, ErrUserCodeNotFound
+	}
+	authorization, err := repos.DeviceAuthorization().GetByUserCode(ctx, userCode)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrUserCodeNotFound
+		}
+		return nil, nil, err
+	}
+	if authorization.Status != entity.DeviceAuthorizationStatusPending || authorization.IsExpired(d.clock.Now()) {
+		return nil, nil, ErrUserCodeNotFound
+	}
+	// 無効にしたクライアントには承認させない
+	client, err := repos.Client().Get(ctx, authorization.ClientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrUserCodeNotFound
+		}
+		return nil, nil, err
+	}
+	if client.IsDisabled() {
+		return nil, nil, ErrUserCodeNotFound
+	}
+	return authorization, client, nil
+}
+
+func (d *deviceAuthorizationUsecase) updateStatus(ctx context.Context, repos gateway.Repositories, deviceCode string, status entity.DeviceAuthorizationStatus, cifNo int) error {
+	// 同時に承認・拒否された場合は、先に更新したほうだけを成功させる
+	if err := repos.DeviceAuthorization().UpdateStatus(ctx, deviceCode, status, cifNo); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrUserCodeNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (d *deviceAuthorizationUsecase) Exchange(ctx context.Context, client *entity.Client, deviceCode string) (_ *entity.Token, err error) {
+	ctx, span := tracing.Start(ctx, "DeviceAuthorizationUsecase.Exchange")
+	clientID := client.ClientID
+	// cifNo は承認済みの device_code が見つかった場合のみ監査ログに残す
+	cifNo := 0
+	defer func() {
+		// 承認待ちのポーリングは失敗として数えない
+		if err != nil && !errors.Is(err, ErrAuthorizationPending) && !errors.Is(err, ErrSlowDown) {
+			metrics.TokenExchangeFailed(entity.GrantTypeDeviceCode, failureReason(err))
+			recordAuditEvent(ctx, d.auditRepository, newAuditEvent(ctx, d.clock, entity.AuditOperationTokenIssue, clientID, cifNo, err))
+		}
+		tracing.End(span, err)
+	}()
+
+	// 発行後にデバイス認可グラントを外したクライアントには交換させない
+	if !client.AllowsGrantType(entity.GrantTypeDeviceCode) {
+		return nil, ErrUnauthorizedClient
+	}
+	if deviceCode == "" {
+		return nil, ErrDeviceCodeRequired
+	}
+	accessToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	now := d.clock.Now()
+
+	// ポーリングの記録や期限切れのものの削除は残す必要があるため、ポーリングに対するエラーは
+	// トランザクションをコミットしてから pollErr で返す
+	var token *entity.Token
+	var pollErr error
+	err = d.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		authorization, err := repos.DeviceAuthorization().GetByDeviceCode(ctx, deviceCode)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidDeviceCode
+			}
+			return err
+		}
+		if authorization.ClientID != clientID {
+			logger.WarnContext(ctx, "device code presented by another client")
+			return ErrInvalidDeviceCode
+		}
+		cifNo = authorization.CifNo
+		if authorization.IsExpired(now) {
+			pollErr = ErrDeviceCodeExpired
+			return d.delete(ctx, repos, deviceCode)
+		}
+
+		interval := authorization.Interval
+		if authorization.LastPolledAt != nil && now.Sub(*authorization.LastPolledAt) < authorization.PollingInterval() {
+			interval += devicePollingIntervalIncrement
+			pollErr = ErrSlowDown
+		}
+		if err := repos.DeviceAuthorization().UpdatePolling(ctx, deviceCode, now, interval); err != nil {
+			return err
+		}
+		if pollErr != nil {
+			return nil
+		}
+
+		switch authorization.Status {
+		case entity.DeviceAuthorizationStatusPending:
+			pollErr = ErrAuthorizationPending
+			return nil
+		case entity.DeviceAuthorizationStatusDenied:
+			pollErr = ErrDeviceAccessDenied
+			return d.delete(ctx, repos, deviceCode)
+		}
+
+		// 承認後に顧客が同意を取り消した場合は、拒否されたものとして扱う
+		if err := checkConsent(ctx, repos, d.clock, authorization.CifNo, clientID); err != nil {
+			if !errors.Is(err, ErrConsentExpired) {
+				return err
+			}
+			pollErr = ErrDeviceAccessDenied
+			return d.delete(ctx, repos, deviceCode)
+		}
+		token = &entity.Token{
+			AccessToken:  accessToken,
+			RefreshToken: refreshToken,
+			Scopes:       authorization.Scope,
+			ExpiresAt:    now.Add(accessTokenTTL),
+			CifNo:        authorization.CifNo,
+			ClientID:     clientID,
+		}
+		if d.idTokenIssuer != nil && token.HasScope(OpenIDScope) {
+			if token.IDToken, err = d.idTokenIssuer.Issue(authorization.CifNo, clientID, ""); err != nil {
+				return err
+			}
+		}
+		if err := d.delete(ctx, repos, deviceCode); err != nil {
+			return err
+		}
+		if err := repos.Token().Create(ctx, token); err != nil {
+			return err
+		}
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, d.clock, entity.AuditOperationTokenIssue, clientID, authorization.CifNo, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pollErr != nil {
+		return nil, pollErr
+	}
+	metrics.TokenIssued("device_code")
+	logger.InfoContext(ctx, "token issued for device")
+	return token, nil
+}
+
+// delete は同時にポーリングされた場合に、先に削除したほうだけを成功させる
+func (d *deviceAuthorizationUsecase) delete(ctx context.Context, repos gateway.Repositories, deviceCode string) error {
+	if err := repos.DeviceAuthorization().Delete(ctx, deviceCode); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidDeviceCode
+		}
+		return err
+	}
+	return nil
+}
+
+// normalizeUserCode は顧客が入力した user_code から区切りを除き、大文字にする
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(userCode)))
+}
+
+func generateUserCode() (string, error) {
+	code := make([]byte, userCodeLength)
+	charsetSize := big.NewInt(int64(len(userCodeCharset)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, charsetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = userCodeCharset[n.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,226 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+)
+
+type DeviceAuthorizationUsecaseSuite struct {
+	suite.Suite
+	clock                      *lockoutClock
+	store                      *inmemory.Store
+	client                     *entity.Client
+	deviceAuthorizationUsecase *deviceAuthorizationUsecase
+}
+
+func TestDeviceAuthorizationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(DeviceAuthorizationUsecaseSuite))
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) SetupTest() {
+	suite.clock = &lockoutClock{now: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.client = &entity.Client{
+		ClientID:   "tv-app",
+		ClientName: "TV App",
+		Scope:      "openid read:account_and_transactions",
+		GrantTypes: entity.GrantTypeRefreshToken + " " + entity.GrantTypeDeviceCode,
+	}
+	suite.Require().NoError(suite.store.AddClient(*suite.client))
+	idTokenIssuer := NewIDTokenIssuer(OIDCConfig{Issuer: "https://bank.example.com/api/v1", SubjectSecret: "subject-secret"}, &stubIDTokenSigner{}, suite.clock)
+	suite.deviceAuthorizationUsecase = NewDeviceAuthorizationUsecase(inmemory.NewTransactionManager(suite.store), suite.store.Audit(), idTokenIssuer, suite.clock)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) authorize(scope string) *entity.DeviceAuthorization {
+	authorization, err := suite.deviceAuthorizationUsecase.Authorize(context.Background(), suite.client, scope)
+	suite.Require().NoError(err)
+	return authorization
+}
+
+// poll は前回のポーリングから間隔を空けて Exchange を呼ぶ
+func (suite *DeviceAuthorizationUsecaseSuite) poll(deviceCode string) (*entity.Token, error) {
+	suite.clock.now = suite.clock.now.Add(10 * time.Second)
+	return suite.deviceAuthorizationUsecase.Exchange(context.Background(), suite.client, deviceCode)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestAuthorize() {
+	authorization := suite.authorize("")
+	suite.Len(authorization.UserCode, 8)
+	suite.Empty(strings.Trim(authorization.UserCode, userCodeCharset))
+	suite.NotEmpty(authorization.DeviceCode)
+	// scope を省略した場合はクライアントのすべてのスコープ
+	suite.Equal("openid read:account_and_transactions", authorization.Scope)
+	suite.Equal(5, authorization.Interval)
+	suite.Equal(suite.clock.now.Add(10*time.Minute), authorization.ExpiresAt)
+
+	suite.Equal("read:account_and_transactions", suite.authorize("read:account_and_transactions read:account_and_transactions").Scope)
+	_, err := suite.deviceAuthorizationUsecase.Authorize(context.Background(), suite.client, "read:audit_log")
+	suite.ErrorIs(err, ErrInvalidAuthorizationScope)
+
+	// デバイス認可グラントを許可していないクライアントは使えない
+	client, err := suite.store.Client().Get(context.Background(), inmemory.SeedClientID)
+	suite.Require().NoError(err)
+	_, err = suite.deviceAuthorizationUsecase.Authorize(context.Background(), client, "")
+	suite.ErrorIs(err, ErrUnauthorizedClient)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestApprove() {
+	authorization := suite.authorize("openid read:account_and_transactions")
+	ctx := context.Background()
+
+	_, err := suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrAuthorizationPending)
+
+	// 顧客はハイフン区切りや小文字で入力してもよい
+	userCode := strings.ToLower(authorization.FormattedUserCode())
+	detail, err := suite.deviceAuthorizationUsecase.Get(ctx, userCode)
+	suite.Require().NoError(err)
+	suite.Equal("TV App", detail.ClientName)
+	suite.Equal([]string{"openid", "read:account_and_transactions"}, detail.Scopes())
+	suite.Require().NoError(suite.deviceAuthorizationUsecase.Approve(ctx, inmemory.SeedCifNo, userCode))
+	// 承認済みのものは承認し直せない
+	suite.ErrorIs(suite.deviceAuthorizationUsecase.Deny(ctx, inmemory.SeedCifNo, userCode), ErrUserCodeNotFound)
+
+	consent, err := suite.store.Consent().Get(ctx, inmemory.SeedCifNo, "tv-app")
+	suite.Require().NoError(err)
+	suite.Equal("openid read:account_and_transactions", consent.Scope)
+
+	token, err := suite.poll(authorization.DeviceCode)
+	suite.Require().NoError(err)
+	suite.Equal(inmemory.SeedCifNo, token.CifNo)
+	suite.Equal("openid read:account_and_transactions", token.Scopes)
+	suite.Equal("signed-id-token", token.IDToken)
+	stored, err := suite.store.Token().GetByRefreshToken(ctx, token.RefreshToken)
+	suite.Require().NoError(err)
+	suite.Equal(token.AccessToken, stored.AccessToken)
+
+	// device_code は一度しか使えない
+	_, err = suite.poll(authorization.DeviceCode)
+	suite.ErrorIs(err, ErrInvalidDeviceCode)
+
+	// 承認待ちのポーリングは記録せず、使用済みの device_code での交換は失敗として記録する
+	events, err := suite.store.Audit().List(ctx, gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	var operations []string
+	for _, event := range events {
+		operations = append(operations, string(event.Operation)+" "+string(event.Outcome))
+	}
+	suite.Equal([]string{"consent.grant success", "token.issue success", "token.issue failure"}, operations)
+	suite.Equal("invalid_device_code", events[2].Reason)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestExchangeUnauthorizedClient() {
+	authorization := suite.authorize("")
+	suite.Require().NoError(suite.deviceAuthorizationUsecase.Approve(context.Background(), inmemory.SeedCifNo, authorization.UserCode))
+
+	// 承認後にデバイス認可グラントを外したクライアントは交換できない
+	client := *suite.client
+	client.GrantTypes = entity.GrantTypeRefreshToken
+	_, err := suite.deviceAuthorizationUsecase.Exchange(context.Background(), &client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrUnauthorizedClient)
+
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{Operation: entity.AuditOperationTokenIssue})
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(entity.AuditOutcomeFailure, events[0].Outcome)
+	suite.Equal("unauthorized_client", events[0].Reason)
+
+	_, err = suite.poll(authorization.DeviceCode)
+	suite.NoError(err)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestDeny() {
+	authorization := suite.authorize("")
+	suite.Require().NoError(suite.deviceAuthorizationUsecase.Deny(context.Background(), inmemory.SeedCifNo, authorization.UserCode))
+
+	_, err := suite.poll(authorization.DeviceCode)
+	suite.ErrorIs(err, ErrDeviceAccessDenied)
+	_, err = suite.poll(authorization.DeviceCode)
+	suite.ErrorIs(err, ErrInvalidDeviceCode)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestConsentRevokedBeforeExchange() {
+	authorization := suite.authorize("")
+	ctx := context.Background()
+	suite.Require().NoError(suite.deviceAuthorizationUsecase.Approve(ctx, inmemory.SeedCifNo, authorization.UserCode))
+	consent, err := suite.store.Consent().Get(ctx, inmemory.SeedCifNo, "tv-app")
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.store.Consent().SetRevokedAt(ctx, consent.ID, suite.clock.now))
+
+	_, err = suite.poll(authorization.DeviceCode)
+	suite.ErrorIs(err, ErrDeviceAccessDenied)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestSlowDown() {
+	authorization := suite.authorize("")
+	ctx := context.Background()
+
+	_, err := suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrAuthorizationPending)
+	suite.clock.now = suite.clock.now.Add(4 * time.Second)
+	_, err = suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrSlowDown)
+
+	// slow_down を返したら間隔を 5 秒延ばし、以降はその間隔を守らせる
+	stored, err := suite.store.DeviceAuthorization().GetByDeviceCode(ctx, authorization.DeviceCode)
+	suite.Require().NoError(err)
+	suite.Equal(10, stored.Interval)
+	suite.clock.now = suite.clock.now.Add(9 * time.Second)
+	_, err = suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrSlowDown)
+	suite.clock.now = suite.clock.now.Add(15 * time.Second)
+	_, err = suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrAuthorizationPending)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestExpired() {
+	authorization := suite.authorize("")
+	ctx := context.Background()
+
+	suite.clock.now = suite.clock.now.Add(10 * time.Minute)
+	suite.ErrorIs(suite.deviceAuthorizationUsecase.Approve(ctx, inmemory.SeedCifNo, authorization.UserCode), ErrUserCodeNotFound)
+	_, err := suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrDeviceCodeExpired)
+	// 期限切れを返したものは削除する
+	_, err = suite.store.DeviceAuthorization().GetByDeviceCode(ctx, authorization.DeviceCode)
+	suite.Error(err)
+
+	// 期限の切れたものは次のリクエストを受け付けたときにも削除する
+	expired := suite.authorize("")
+	suite.clock.now = suite.clock.now.Add(10 * time.Minute)
+	suite.authorize("")
+	_, err = suite.store.DeviceAuthorization().GetByDeviceCode(ctx, expired.DeviceCode)
+	suite.Error(err)
+}
+
+func (suite *DeviceAuthorizationUsecaseSuite) TestInvalidCodes() {
+	authorization := suite.authorize("")
+	ctx := context.Background()
+
+	_, err := suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, "")
+	suite.ErrorIs(err, ErrDeviceCodeRequired)
+	_, err = suite.deviceAuthorizationUsecase.Exchange(ctx, suite.client, "unknown")
+	suite.ErrorIs(err, ErrInvalidDeviceCode)
+	// 他のクライアントの device_code は使えない
+	_, err = suite.deviceAuthorizationUsecase.Exchange(ctx, &entity.Client{ClientID: inmemory.SeedClientID, GrantTypes: entity.GrantTypeDeviceCode}, authorization.DeviceCode)
+	suite.ErrorIs(err, ErrInvalidDeviceCode)
+
+	for _, userCode := range []string{"", "BCDF", "BCDF-GHJK-LMNP", "ZZZZ-ZZZZ"} {
+		_, err = suite.deviceAuthorizationUsecase.Get(ctx, userCode)
+		suite.ErrorIs(err, ErrUserCodeNotFound, userCode)
+	}
+
+	// 無効にしたクライアントのものは承認できない
+	_, err = NewClientAdminUsecase(inmemory.NewTransactionManager(suite.store), suite.clock).DisableClient(ctx, "tv-app")
+	suite.Require().NoError(err)
+	suite.ErrorIs(suite.deviceAuthorizationUsecase.Approve(ctx, inmemory.SeedCifNo, authorization.UserCode), ErrUserCodeNotFound)
+}
@@ -0,0 +1,42 @@
+package usecase
+
+import "errors"
+
+// failureReasons はメトリクスの reason ラベルと監査ログの reason に使う値。ここにないエラーは internal_error として数える。
+var failureReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrAccessTokenRequired, "access_token_required"},
+	{ErrInvalidAccessToken, "invalid_access_token"},
+	{ErrTokenExpired, "token_expired"},
+	{ErrInvalidScope, "invalid_scope"},
+	{ErrRefreshTokenRequired, "refresh_token_required"},
+	{ErrInvalidRefreshToken, "invalid_refresh_token"},
+	{ErrConsentExpired, "consent_expired"},
+	{ErrUnauthorizedClient, "unauthorized_client"},
+	{ErrAuthorizationCodeRequired, "authorization_code_required"},
+	// 再利用は交換済みのものが漏えいしたことを示すため、ほかの無効な認可コードと分けて数える
+	{ErrAuthorizationCodeReused, "authorization_code_reused"},
+	{ErrInvalidAuthorizationCode, "invalid_authorization_code"},
+	{ErrDeviceCodeRequired, "device_code_required"},
+	{ErrInvalidDeviceCode, "invalid_device_code"},
+	{ErrDeviceCodeExpired, "device_code_expired"},
+	{ErrDeviceAccessDenied, "access_denied"},
+	{ErrClientIDRequired, "client_id_required"},
+	{ErrClientSecretRequired, "client_secret_required"},
+	{ErrInvalidClient, "invalid_client"},
+	{ErrClientLocked, "client_locked"},
+	{ErrClientDisabled, "client_disabled"},
+	{ErrAccountNotFound, "account_not_found"},
+	{ErrAccountInactive, "account_inactive"},
+}
+
+func failureReason(err error) string {
+	for _, r := range failureReasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return "internal_error"
+}
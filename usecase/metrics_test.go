@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureReason(t *testing.T) {
+	assert.Equal(t, "invalid_refresh_token", failureReason(ErrInvalidRefreshToken))
+	assert.Equal(t, "invalid_client", failureReason(fmt.Errorf("wrapped: %w", ErrInvalidClient)))
+	assert.Equal(t, "internal_error", failureReason(errors.New("db down")))
+}
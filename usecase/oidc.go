@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+// OpenID Connect のスコープ。profile / email / phone / address は UserInfo で返す項目を決める。
+const (
+	OpenIDScope  = "openid"
+	ProfileScope = "profile"
+	EmailScope   = "email"
+	PhoneScope   = "phone"
+	AddressScope = "address"
+)
+
+// OIDCScopes は OpenID Connect のスコープ
+var OIDCScopes = []string{OpenIDScope, ProfileScope, EmailScope, PhoneScope, AddressScope}
+
+// idTokenTTL は ID トークンの有効期間。ログインの確認にだけ使うため短くする。
+const idTokenTTL = 10 * time.Minute
+
+// OIDCConfig は OpenID Connect の設定。Issuer が空の場合は ID トークンを発行しない。
+type OIDCConfig struct {
+	// Issuer は ID トークンの iss。API のベース URL（https://bank.example.com/api/v1 など）にする。
+	Issuer string
+	// SubjectSecret は CIF 番号から sub を導く HMAC の鍵。変えると sub が変わるため、運用開始後は変えないこと。
+	SubjectSecret string
+}
+
+func (c OIDCConfig) Enabled() bool {
+	return c.Issuer != ""
+}
+
+// IDTokenClaims は ID トークンのクレーム。nonce は認可リクエストで指定された場合だけ含める。
+// 顧客は銀行のアプリで認証済みのため、auth_time は含めない。
+type IDTokenClaims struct {
+	Issuer    string `json:"iss"`
+	Subject   string `json:"sub"`
+	Audience  string `json:"aud"`
+	ExpiresAt int64  `json:"exp"`
+	IssuedAt  int64  `json:"iat"`
+	Nonce     string `json:"nonce,omitempty"`
+}
+
+// IDTokenSigner は ID トークンに署名する（pkg/jwt.Signer）
+type IDTokenSigner interface {
+	Sign(claims any) (string, error)
+}
+
+// IDTokenIssuer は ID トークンを発行する。OIDC を設定していない場合、各ユースケースには nil を渡し、openid スコープでも ID トークンを発行しない。
+type IDTokenIssuer interface {
+	// Subject は cifNo の sub を返す。CIF 番号を推測できないよう、OIDCConfig.SubjectSecret の HMAC にする。
+	Subject(cifNo int) string
+	// Issue は cifNo の顧客について clientID 宛ての ID トークンを発行する。nonce が空の場合は含めない。
+	Issue(cifNo int, clientID string, nonce string) (string, error)
+}
+
+type idTokenIssuer struct {
+	config OIDCConfig
+	signer IDTokenSigner
+	clock  pkg.Clock
+}
+
+func NewIDTokenIssuer(config OIDCConfig, signer IDTokenSigner, clock pkg.Clock) *idTokenIssuer {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &idTokenIssuer{
+		config: config,
+		signer: signer,
+		clock:  clock,
+	}
+}
+
+func (i *idTokenIssuer) Subject(cifNo int) string {
+	mac := hmac.New(sha256.New, []byte(i.config.SubjectSecret))
+	mac.Write([]byte(strconv.Itoa(cifNo)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (i *idTokenIssuer) Issue(cifNo int, clientID string, nonce string) (string, error) {
+	now := i.clock.Now()
+	return i.signer.Sign(IDTokenClaims{
+		Issuer:    i.config.Issuer,
+		Subject:   i.Subject(cifNo),
+		Audience:  clientID,
+		ExpiresAt: now.Add(idTokenTTL).Unix(),
+		IssuedAt:  now.Unix(),
+		Nonce:     nonce,
+	})
+}
+
+// UserInfo はアクセストークンのスコープで許可された顧客の情報。許可されていない項目は空にする。
+type UserInfo struct {
+	Subject string
+	// profile
+	Name      string
+	NameKana  string
+	Birthdate string
+	// email
+	Email string
+	// phone
+	PhoneNumber string
+	// address
+	Address *UserInfoAddress
+}
+
+type UserInfoAddress struct {
+	Country       string
+	Region        string
+	Locality      string
+	StreetAddress string
+}
+
+type UserInfoUsecase interface {
+	// Get は token の発行先による顧客情報の参照として監査ログに記録する
+	Get(ctx context.Context, token *entity.Token) (*UserInfo, error)
+}
+
+type userInfoUsecase struct {
+	customerRepository gateway.CustomerRepository
+	auditRepository    gateway.AuditRepository
+	idTokenIssuer      IDTokenIssuer
+	clock              pkg.Clock
+}
+
+func NewUserInfoUsecase(
+	customerRepository gateway.CustomerRepository,
+	auditRepository gateway.AuditRepository,
+	idTokenIssuer IDTokenIssuer,
+	clock pkg.Clock,
+) *userInfoUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &userInfoUsecase{
+		customerRepository: customerRepository,
+		auditRepository:    auditRepository,
+		idTokenIssuer:      idTokenIssuer,
+		clock:              clock,
+	}
+}
+
+func (u *userInfoUsecase) Get(ctx context.Context, token *entity.Token) (_ *UserInfo, err error) {
+	ctx, span := tracing.Start(ctx, "UserInfoUsecase.Get")
+	defer func() {
+		if err != nil {
+			recordAuditEvent(ctx, u.auditRepository, newAuditEvent(ctx, u.clock, entity.AuditOperationUserInfoRead, token.ClientID, token.CifNo, err))
+		}
+		tracing.End(span, err)
+	}()
+
+	customer, err := u.customerRepository.Get(ctx, token.CifNo)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCustomerNotFound
+		}
+		return nil, err
+	}
+	// 記録できない場合は顧客情報を返さない
+	if err := u.auditRepository.Append(ctx, newAuditEvent(ctx, u.clock, entity.AuditOperationUserInfoRead, token.ClientID, token.CifNo, nil)); err != nil {
+		return nil, err
+	}
+
+	userInfo := &UserInfo{Subject: u.idTokenIssuer.Subject(customer.CifNo)}
+	if token.HasScope(ProfileScope) {
+		userInfo.Name = customer.NameKanji
+		userInfo.NameKana = customer.NameKana
+		if !customer.BirthDate.IsZero() {
+			userInfo.Birthdate = customer.BirthDate.Format(time.DateOnly)
+		}
+	}
+	if token.HasScope(EmailScope) {
+		userInfo.Email = customer.Email
+	}
+	if token.HasScope(PhoneScope) {
+		userInfo.PhoneNumber = customer.Phone
+	}
+	if token.HasScope(AddressScope) {
+		userInfo.Address = &UserInfoAddress{
+			Country:       "JP",
+			Region:        customer.Prefecture,
+			Locality:      customer.City,
+			StreetAddress: joinNonEmpty(customer.Town, customer.Street, customer.Building, customer.Room),
+		}
+	}
+	return userInfo, nil
+}
+
+func joinNonEmpty(values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value != "" {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, " ")
+}
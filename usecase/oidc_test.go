@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+// stubIDTokenSigner は署名せず、渡されたクレームを記録する
+type stubIDTokenSigner struct {
+	claims []IDTokenClaims
+	err    error
+}
+
+func (s *stubIDTokenSigner) Sign(claims any) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	s.claims = append(s.claims, claims.(IDTokenClaims))
+	return "signed-id-token", nil
+}
+
+type OIDCUsecaseSuite struct {
+	suite.Suite
+	clock           pkg.Clock
+	store           *inmemory.Store
+	signer          *stubIDTokenSigner
+	idTokenIssuer   *idTokenIssuer
+	userInfoUsecase *userInfoUsecase
+}
+
+func TestOIDCUsecaseTestSuite(t *testing.T) {
+	suite.Run(t, new(OIDCUsecaseSuite))
+}
+
+func (suite *OIDCUsecaseSuite) SetupTest() {
+	suite.clock = pkg.FixedClock{T: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	suite.Require().NoError(inmemory.Seed(suite.store, suite.clock))
+	suite.signer = &stubIDTokenSigner{}
+	suite.idTokenIssuer = NewIDTokenIssuer(OIDCConfig{Issuer: "https://bank.example.com/api/v1", SubjectSecret: "subject-secret"}, suite.signer, suite.clock)
+	suite.userInfoUsecase = NewUserInfoUsecase(suite.store.Customer(), suite.store.Audit(), suite.idTokenIssuer, suite.clock)
+}
+
+func (suite *OIDCUsecaseSuite) TestSubject() {
+	subject := suite.idTokenIssuer.Subject(1)
+	suite.Len(subject, 43)
+	suite.Equal(subject, suite.idTokenIssuer.Subject(1))
+	suite.NotEqual(subject, suite.idTokenIssuer.Subject(2))
+
+	// 鍵が異なれば CIF 番号が同じでも sub は異なる
+	other := NewIDTokenIssuer(OIDCConfig{Issuer: "https://bank.example.com/api/v1", SubjectSecret: "other-secret"}, suite.signer, suite.clock)
+	suite.NotEqual(subject, other.Subject(1))
+}
+
+func (suite *OIDCUsecaseSuite) TestIssue() {
+	idToken, err := suite.idTokenIssuer.Issue(1, "client-1", "")
+	suite.Require().NoError(err)
+	suite.Equal("signed-id-token", idToken)
+	_, err = suite.idTokenIssuer.Issue(1, "client-1", "nonce-1")
+	suite.Require().NoError(err)
+	claims := IDTokenClaims{
+		Issuer:    "https://bank.example.com/api/v1",
+		Subject:   suite.idTokenIssuer.Subject(1),
+		Audience:  "client-1",
+		ExpiresAt: suite.clock.Now().Add(idTokenTTL).Unix(),
+		IssuedAt:  suite.clock.Now().Unix(),
+	}
+	withNonce := claims
+	withNonce.Nonce = "nonce-1"
+	suite.Equal([]IDTokenClaims{claims, withNonce}, suite.signer.claims)
+}
+
+func (suite *OIDCUsecaseSuite) TestUserInfo() {
+	token := &entity.Token{ClientID: inmemory.SeedClientID, CifNo: inmemory.SeedCifNo, Scopes: "openid profile email phone address"}
+	userInfo, err := suite.userInfoUsecase.Get(context.Background(), token)
+	suite.Require().NoError(err)
+	suite.Equal(&UserInfo{
+		Subject:     suite.idTokenIssuer.Subject(inmemory.SeedCifNo),
+		Name:        "田中 太郎",
+		NameKana:    "Tanaka Taro",
+		Birthdate:   "1990-01-01",
+		Email:       "taro.tanaka@example.com",
+		PhoneNumber: "09012345678",
+		Address:     &UserInfoAddress{Country: "JP", Region: "Tokyo", Locality: "Chiyoda", StreetAddress: "Kanda 1-1-1"},
+	}, userInfo)
+
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(entity.AuditOperationUserInfoRead, events[0].Operation)
+	suite.Equal(entity.AuditOutcomeSuccess, events[0].Outcome)
+	suite.Equal(inmemory.SeedClientID, events[0].ClientID)
+}
+
+func (suite *OIDCUsecaseSuite) TestUserInfoOnlyGrantedScopes() {
+	token := &entity.Token{ClientID: inmemory.SeedClientID, CifNo: inmemory.SeedCifNo, Scopes: "openid email"}
+	userInfo, err := suite.userInfoUsecase.Get(context.Background(), token)
+	suite.Require().NoError(err)
+	suite.Equal(&UserInfo{
+		Subject: suite.idTokenIssuer.Subject(inmemory.SeedCifNo),
+		Email:   "taro.tanaka@example.com",
+	}, userInfo)
+}
+
+func (suite *OIDCUsecaseSuite) TestUserInfoCustomerNotFound() {
+	token := &entity.Token{ClientID: inmemory.SeedClientID, CifNo: 999, Scopes: "openid profile"}
+	_, err := suite.userInfoUsecase.Get(context.Background(), token)
+	suite.ErrorIs(err, ErrCustomerNotFound)
+
+	events, err := suite.store.Audit().List(context.Background(), gateway.AuditFilter{})
+	suite.Require().NoError(err)
+	suite.Require().Len(events, 1)
+	suite.Equal(entity.AuditOutcomeFailure, events[0].Outcome)
+}
+
+func (suite *OIDCUsecaseSuite) TestRefreshIssuesIDToken() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.store.Client().UpdateScope(ctx, inmemory.SeedClientID, "openid "+AccountReadScope))
+	issued, err := NewClientAdminUsecase(inmemory.NewTransactionManager(suite.store), suite.clock).IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+	tokenUsecase := NewTokenUsecase(suite.store.Token(), suite.store.Audit(), inmemory.NewTransactionManager(suite.store), suite.idTokenIssuer, suite.clock)
+
+	refreshed, err := tokenUsecase.Refresh(ctx, issued.RefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.Require().NoError(err)
+	suite.Equal("signed-id-token", refreshed.IDToken)
+	suite.Require().Len(suite.signer.claims, 1)
+	suite.Equal(inmemory.SeedClientID, suite.signer.claims[0].Audience)
+
+	// openid スコープのないトークンには発行しない
+	refreshed, err = tokenUsecase.Refresh(ctx, inmemory.SeedRefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.Require().NoError(err)
+	suite.Empty(refreshed.IDToken)
+}
+
+func (suite *OIDCUsecaseSuite) TestRefreshSignError() {
+	ctx := context.Background()
+	suite.Require().NoError(suite.store.Client().UpdateScope(ctx, inmemory.SeedClientID, "openid"))
+	issued, err := NewClientAdminUsecase(inmemory.NewTransactionManager(suite.store), suite.clock).IssueToken(ctx, inmemory.SeedClientID, inmemory.SeedCifNo)
+	suite.Require().NoError(err)
+	suite.signer.err = errors.New("sign error")
+	tokenUsecase := NewTokenUsecase(suite.store.Token(), suite.store.Audit(), inmemory.NewTransactionManager(suite.store), suite.idTokenIssuer, suite.clock)
+
+	_, err = tokenUsecase.Refresh(ctx, issued.RefreshToken, refreshClient(inmemory.SeedClientID))
+	suite.Error(err)
+	// 署名できなかった場合はリフレッシュトークンを入れ替えない
+	_, err = suite.store.Token().GetByRefreshToken(ctx, issued.RefreshToken)
+	suite.NoError(err)
+}
@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/jwt"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+const (
+	ResponseTypeCode        = "code"
+	CodeChallengeMethodS256 = "S256"
+	// requestURIPrefix は RFC 9126 の request_uri の接頭辞
+	requestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+	// pushedAuthorizationRequestTTL は request_uri の有効期間。FAPI 2.0 では 600 秒未満とされている。
+	pushedAuthorizationRequestTTL = time.Minute
+	// requestObjectMaxLifetime はリクエストオブジェクトの nbf から exp までの上限（FAPI 2.0 Message Signing）
+	requestObjectMaxLifetime = time.Hour
+	// authorizationParameterMaxLength は state と nonce の長さの上限
+	authorizationParameterMaxLength = 255
+)
+
+// RequestObjectSigningAlgorithms はリクエストオブジェクトの署名に受け付けるアルゴリズム。FAPI 2.0 で認められていない RS256 は受け付けない。
+var RequestObjectSigningAlgorithms = []string{jwt.AlgorithmPS256, jwt.AlgorithmES256}
+
+var (
+	// 以下の 4 つは理由を付けてラップして返す
+	ErrInvalidAuthorizationRequest = errors.New("invalid authorization request")
+	ErrInvalidRequestObject        = errors.New("invalid request object")
+	ErrUnsupportedResponseType     = errors.New("unsupported response type")
+	ErrInvalidAuthorizationScope   = errors.New("invalid authorization scope")
+	// ErrInvalidRequestURI は期限切れや使用済みの場合、他のクライアントの request_uri の場合も返す
+	ErrInvalidRequestURI = errors.New("invalid request uri")
+)
+
+// AuthorizationRequest は認可リクエストのパラメーター。Request（署名付きのリクエストオブジェクト）がある場合は
+// 他のパラメーターを使わず、リクエストオブジェクトのクレームを使う（RFC 9101）。
+type AuthorizationRequest struct {
+	ClientID            string
+	ResponseType        string
+	RedirectURI         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Request             string
+}
+
+// PushedAuthorizationUsecase は PAR（RFC 9126）。認可エンドポイントに渡すパラメーターを事前に受け取って保存する。
+// 認可コードフローを許可したクライアントだけが使える。
+type PushedAuthorizationUsecase interface {
+	// Push は認証済みの client が送った認可リクエストを検証して保存し、request_uri を設定して返す
+	Push(ctx context.Context, client *entity.Client, request AuthorizationRequest) (*entity.PushedAuthorizationRequest, error)
+	// Resolve は clientID が送った request_uri の認可リクエストを返す。/authorize（AuthorizationCodeUsecase）で使い、request_uri は一度しか使えない。
+	Resolve(ctx context.Context, clientID string, requestURI string) (*entity.PushedAuthorizationRequest, error)
+}
+
+type pushedAuthorizationUsecase struct {
+	transactionManager TransactionManager
+	// issuer はリクエストオブジェクトの aud として受け付ける値
+	issuer string
+	clock  pkg.Clock
+}
+
+func NewPushedAuthorizationUsecase(transactionManager TransactionManager, issuer string, clock pkg.Clock) *pushedAuthorizationUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &pushedAuthorizationUsecase{
+		transactionManager: transactionManager,
+		issuer:             issuer,
+		clock:              clock,
+	}
+}
+
+func (p *pushedAuthorizationUsecase) Push(ctx context.Context, client *entity.Client, request AuthorizationRequest) (_ *entity.PushedAuthorizationRequest, err error) {
+	ctx, span := tracing.Start(ctx, "PushedAuthorizationUsecase.Push")
+	defer func() { tracing.End(span, err) }()
+
+	if !client.AllowsGrantType(entity.GrantTypeAuthorizationCode) {
+		return nil, ErrUnauthorizedClient
+	}
+	now := p.clock.Now()
+	if request.ClientID != "" && request.ClientID != client.ClientID {
+		return nil, fmt.Errorf("%w: client_id does not match the authenticated client", ErrInvalidAuthorizationRequest)
+	}
+	if request.Request != "" {
+		if request, err = p.verifyRequestObject(client, request.Request, now); err != nil {
+			return nil, err
+		}
+	} else if client.FAPI {
+		return nil, fmt.Errorf("%w: the client must send a signed request object", ErrInvalidAuthorizationRequest)
+	}
+
+	pushed, err := validateAuthorizationRequest(client, request)
+	if err != nil {
+		return nil, err
+	}
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+	pushed.RequestURI = requestURIPrefix + token
+	pushed.CreatedAt = now.UTC()
+	pushed.ExpiresAt = now.Add(pushedAuthorizationRequestTTL).UTC()
+
+	err = p.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		// 期限切れの掃除は定期ジョブを持たず、認可やデバイス認可と同じく新たに作るときにまとめて行う
+		if _, err := repos.PushedAuthorization().DeleteExpired(ctx, now); err != nil {
+			return err
+		}
+		return repos.PushedAuthorization().Create(ctx, pushed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pushed, nil
+}
+
+func (p *pushedAuthorizationUsecase) Resolve(ctx context.Context, clientID string, requestURI string) (_ *entity.PushedAuthorizationRequest, err error) {
+	ctx, span := tracing.Start(ctx, "PushedAuthorizationUsecase.Resolve")
+	defer func() { tracing.End(span, err) }()
+
+	var pushed *entity.PushedAuthorizationRequest
+	err = p.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		if pushed, err = repos.PushedAuthorization().Get(ctx, requestURI); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRequestURI
+			}
+			return err
+		}
+		if pushed.ClientID != clientID {
+			logger.WarnContext(ctx, "request_uri presented by another client")
+			return ErrInvalidRequestURI
+		}
+		if pushed.IsExpired(p.clock.Now()) {
+			return ErrInvalidRequestURI
+		}
+		// 同時に使われた場合は、先に削除したほうだけを成功させる
+		if err := repos.PushedAuthorization().Delete(ctx, requestURI); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRequestURI
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pushed, nil
+}
+
+// audience は aud クレーム。文字列と文字列の配列のどちらも受け付ける（RFC 7519 4.1.3）。
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+type requestObjectClaims struct {
+	Issuer              string          `json:"iss"`
+	Audience            audience        `json:"aud"`
+	ExpiresAt           *int64          `json:"exp"`
+	NotBefore           *int64          `json:"nbf"`
+	ClientID            string          `json:"client_id"`
+	ResponseType        string          `json:"response_type"`
+	RedirectURI         string          `json:"redirect_uri"`
+	Scope               string          `json:"scope"`
+	State               string          `json:"state"`
+	Nonce               string          `json:"nonce"`
+	CodeChallenge       string          `json:"code_challenge"`
+	CodeChallengeMethod string          `json:"code_challenge_method"`
+	Request             json.RawMessage `json:"request"`
+	RequestURI          json.RawMessage `json:"request_uri"`
+}
+
+// verifyRequestObject は client が登録した公開鍵でリクエストオブジェクトを検証し、クレームのパラメーターを返す。
+// 有効期間は FAPI 2.0 に合わせて nbf と exp を必須とし、1 時間以内に限る。
+func (p *pushedAuthorizationUsecase) verifyRequestObject(client *entity.Client, requestObject string, now time.Time) (AuthorizationRequest, error) {
+	if client.JWKS == "" {
+		return AuthorizationRequest{}, fmt.Errorf("%w: no keys are registered for the client", ErrInvalidRequestObject)
+	}
+	set, err := jwt.ParseJWKSet([]byte(client.JWKS))
+	if err != nil {
+		return AuthorizationRequest{}, err
+	}
+	payload, err := jwt.Verify(requestObject, set.Keys, RequestObjectSigningAlgorithms)
+	if err != nil {
+		return AuthorizationRequest{}, fmt.Errorf("%w: %w", ErrInvalidRequestObject, err)
+	}
+	var claims requestObjectClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return AuthorizationRequest{}, fmt.Errorf("%w: malformed claims", ErrInvalidRequestObject)
+	}
+
+	switch {
+	case claims.Issuer != client.ClientID:
+		return AuthorizationRequest{}, fmt.Errorf("%w: iss must be the client_id", ErrInvalidRequestObject)
+	case claims.ClientID != client.ClientID:
+		return AuthorizationRequest{}, fmt.Errorf("%w: client_id must be the authenticated client", ErrInvalidRequestObject)
+	case !slices.Contains(claims.Audience, p.issuer):
+		return AuthorizationRequest{}, fmt.Errorf("%w: aud must contain the issuer", ErrInvalidRequestObject)
+	case claims.ExpiresAt == nil || claims.NotBefore == nil:
+		return AuthorizationRequest{}, fmt.Errorf("%w: exp and nbf are required", ErrInvalidRequestObject)
+	case claims.Request != nil || claims.RequestURI != nil:
+		return AuthorizationRequest{}, fmt.Errorf("%w: request and request_uri must not be included", ErrInvalidRequestObject)
+	}
+	expiresAt := time.Unix(*claims.ExpiresAt, 0)
+	notBefore := time.Unix(*claims.NotBefore, 0)
+	switch {
+	case !now.Before(expiresAt):
+		return AuthorizationRequest{}, fmt.Errorf("%w: expired", ErrInvalidRequestObject)
+	case now.Before(notBefore):
+		return AuthorizationRequest{}, fmt.Errorf("%w: not yet valid", ErrInvalidRequestObject)
+	case expiresAt.Sub(notBefore) > requestObjectMaxLifetime:
+		return AuthorizationRequest{}, fmt.Errorf("%w: exp must be at most %s after nbf", ErrInvalidRequestObject, requestObjectMaxLifetime)
+	}
+
+	return AuthorizationRequest{
+		ClientID:            claims.ClientID,
+		ResponseType:        claims.ResponseType,
+		RedirectURI:         claims.RedirectURI,
+		Scope:               claims.Scope,
+		State:               claims.State,
+		Nonce:               claims.Nonce,
+		CodeChallenge:       claims.CodeChallenge,
+		CodeChallengeMethod: claims.CodeChallengeMethod,
+	}, nil
+}
+
+// validateAuthorizationRequest はパラメーターを client の登録内容と照合する。
+// FAPI 2.0 に合わせ、認可コードフローと PKCE（S256）だけを受け付ける。
+func validateAuthorizationRequest(client *entity.Client, request AuthorizationRequest) (*entity.PushedAuthorizationRequest, error) {
+	switch request.ResponseType {
+	case ResponseTypeCode:
+	case "":
+		return nil, fmt.Errorf("%w: response_type is required", ErrInvalidAuthorizationRequest)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedResponseType, request.ResponseType)
+	}
+	// 登録されたものと完全に一致する場合だけ受け付ける
+	if request.RedirectURI == "" || !slices.Contains(client.RedirectURIList(), request.RedirectURI) {
+		return nil, fmt.Errorf("%w: redirect_uri must be one of the registered redirect_uris", ErrInvalidAuthorizationRequest)
+	}
+
+	scope, err := requestedScope(client, request.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.State) > authorizationParameterMaxLength || len(request.Nonce) > authorizationParameterMaxLength {
+		return nil, fmt.Errorf("%w: state and nonce must be at most %d characters", ErrInvalidAuthorizationRequest, authorizationParameterMaxLength)
+	}
+	if request.CodeChallengeMethod != CodeChallengeMethodS256 {
+		return nil, fmt.Errorf("%w: code_challenge_method must be S256", ErrInvalidAuthorizationRequest)
+	}
+	// S256 の code_challenge は SHA-256 の値を base64url にした 43 文字
+	if challenge, err := base64.RawURLEncoding.DecodeString(request.CodeChallenge); err != nil || len(challenge) != 32 {
+		return nil, fmt.Errorf("%w: code_challenge must be a base64url encoded SHA-256 hash", ErrInvalidAuthorizationRequest)
+	}
+
+	return &entity.PushedAuthorizationRequest{
+		ClientID:            client.ClientID,
+		ResponseType:        request.ResponseType,
+		RedirectURI:         request.RedirectURI,
+		Scope:               scope,
+		State:               request.State,
+		Nonce:               request.Nonce,
+		CodeChallenge:       request.CodeChallenge,
+		CodeChallengeMethod: request.CodeChallengeMethod,
+	}, nil
+}
+
+// requestedScope は要求されたスコープ（スペース区切り）が client に許可されていることを確認し、重複を除いて返す
+func requestedScope(client *entity.Client, requested string) (string, error) {
+	var scopes []string
+	for _, scope := range strings.Fields(requested) {
+		if !slices.Contains(client.Scopes(), scope) {
+			return "", fmt.Errorf("%w: scope %q is not allowed for the client", ErrInvalidAuthorizationScope, scope)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return "", fmt.Errorf("%w: scope is required", ErrInvalidAuthorizationScope)
+	}
+	return strings.Join(scopes, " "), nil
+}
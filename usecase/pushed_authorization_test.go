@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-banking-api/adapter/gateway/inmemory"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/jwt"
+)
+
+const (
+	testIssuer      = "https://bank.example.com/api/v1"
+	testRedirectURI = "https://client.example.com/callback"
+)
+
+// testCodeChallenge は code_verifier "verifier" の S256
+var testCodeChallenge = func() string {
+	sum := sha256.Sum256([]byte("verifier"))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}()
+
+type PushedAuthorizationUsecaseSuite struct {
+	suite.Suite
+	key                        *rsa.PrivateKey
+	clock                      pkg.FixedClock
+	store                      *inmemory.Store
+	client                     *entity.Client
+	pushedAuthorizationUsecase *pushedAuthorizationUsecase
+}
+
+func TestPushedAuthorizationUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(PushedAuthorizationUsecaseSuite))
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) SetupSuite() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.Require().NoError(err)
+	suite.key = key
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) SetupTest() {
+	suite.clock = pkg.FixedClock{T: time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC)}
+	suite.store = inmemory.NewStore()
+	jwks, err := json.Marshal(jwt.JWKSet{Keys: []jwt.JWK{{
+		KeyType: "RSA",
+		KeyID:   "key-1",
+		N:       base64.RawURLEncoding.EncodeToString(suite.key.N.Bytes()),
+		E:       base64.RawURLEncoding.EncodeToString(big.NewInt(int64(suite.key.E)).Bytes()),
+	}}})
+	suite.Require().NoError(err)
+	suite.client = &entity.Client{
+		ClientID:     "client-1",
+		ClientName:   "Test Client",
+		Scope:        "openid read:account_and_transactions",
+		RedirectURIs: testRedirectURI,
+		GrantTypes:   entity.GrantTypeRefreshToken + " " + entity.GrantTypeAuthorizationCode,
+		JWKS:         string(jwks),
+	}
+	suite.Require().NoError(suite.store.AddClient(*suite.client))
+	suite.pushedAuthorizationUsecase = NewPushedAuthorizationUsecase(inmemory.NewTransactionManager(suite.store), testIssuer, suite.clock)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) validRequest() AuthorizationRequest {
+	return AuthorizationRequest{
+		ResponseType:        ResponseTypeCode,
+		RedirectURI:         testRedirectURI,
+		Scope:               "openid read:account_and_transactions openid",
+		State:               "state-1",
+		CodeChallenge:       testCodeChallenge,
+		CodeChallengeMethod: CodeChallengeMethodS256,
+	}
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) validClaims() map[string]any {
+	now := suite.clock.Now()
+	return map[string]any{
+		"iss":                   "client-1",
+		"aud":                   testIssuer,
+		"client_id":             "client-1",
+		"nbf":                   now.Unix(),
+		"exp":                   now.Add(5 * time.Minute).Unix(),
+		"response_type":         "code",
+		"redirect_uri":          testRedirectURI,
+		"scope":                 "openid",
+		"nonce":                 "nonce-1",
+		"code_challenge":        testCodeChallenge,
+		"code_challenge_method": "S256",
+	}
+}
+
+// sign は claims を PS256 で署名したリクエストオブジェクトを返す
+func (suite *PushedAuthorizationUsecaseSuite) sign(claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": "PS256", "kid": "key-1"})
+	suite.Require().NoError(err)
+	payload, err := json.Marshal(claims)
+	suite.Require().NoError(err)
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPSS(rand.Reader, suite.key, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	suite.Require().NoError(err)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestPush() {
+	ctx := context.Background()
+	pushed, err := suite.pushedAuthorizationUsecase.Push(ctx, suite.client, suite.validRequest())
+	suite.Require().NoError(err)
+	suite.True(strings.HasPrefix(pushed.RequestURI, "urn:ietf:params:oauth:request_uri:"))
+	suite.Equal("openid read:account_and_transactions", pushed.Scope)
+	suite.Equal("state-1", pushed.State)
+	suite.Equal(suite.clock.Now().Add(time.Minute), pushed.ExpiresAt)
+
+	stored, err := suite.store.PushedAuthorization().Get(ctx, pushed.RequestURI)
+	suite.Require().NoError(err)
+	suite.Equal("client-1", stored.ClientID)
+	suite.Equal(testCodeChallenge, stored.CodeChallenge)
+
+	// 認可コードフローを許可していないクライアントは使えない
+	suite.client.GrantTypes = entity.GrantTypeRefreshToken
+	_, err = suite.pushedAuthorizationUsecase.Push(ctx, suite.client, suite.validRequest())
+	suite.ErrorIs(err, ErrUnauthorizedClient)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestPushRequestObject() {
+	// リクエストオブジェクトがある場合、フォームのパラメーターは使わない
+	request := AuthorizationRequest{ClientID: "client-1", Scope: "read:account_and_transactions", Request: suite.sign(suite.validClaims())}
+	pushed, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, request)
+	suite.Require().NoError(err)
+	suite.Equal("openid", pushed.Scope)
+	suite.Equal("nonce-1", pushed.Nonce)
+
+	// aud は配列でもよい
+	claims := suite.validClaims()
+	claims["aud"] = []string{"https://other.example.com", testIssuer}
+	_, err = suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{Request: suite.sign(claims)})
+	suite.NoError(err)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestPushFAPIClient() {
+	suite.client.FAPI = true
+	_, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, suite.validRequest())
+	suite.ErrorIs(err, ErrInvalidAuthorizationRequest)
+
+	_, err = suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{Request: suite.sign(suite.validClaims())})
+	suite.NoError(err)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestPushInvalidRequest() {
+	tests := map[string]struct {
+		modify func(request *AuthorizationRequest)
+		err    error
+	}{
+		"other client_id":       {func(r *AuthorizationRequest) { r.ClientID = "client-2" }, ErrInvalidAuthorizationRequest},
+		"no response_type":      {func(r *AuthorizationRequest) { r.ResponseType = "" }, ErrInvalidAuthorizationRequest},
+		"token response_type":   {func(r *AuthorizationRequest) { r.ResponseType = "token" }, ErrUnsupportedResponseType},
+		"unregistered redirect": {func(r *AuthorizationRequest) { r.RedirectURI = "https://client.example.com/other" }, ErrInvalidAuthorizationRequest},
+		"no scope":              {func(r *AuthorizationRequest) { r.Scope = " " }, ErrInvalidAuthorizationScope},
+		"scope not allowed":     {func(r *AuthorizationRequest) { r.Scope = "openid read:audit_log" }, ErrInvalidAuthorizationScope},
+		"long state":            {func(r *AuthorizationRequest) { r.State = strings.Repeat("s", 256) }, ErrInvalidAuthorizationRequest},
+		"plain PKCE":            {func(r *AuthorizationRequest) { r.CodeChallengeMethod = "plain" }, ErrInvalidAuthorizationRequest},
+		"no code_challenge":     {func(r *AuthorizationRequest) { r.CodeChallenge = "" }, ErrInvalidAuthorizationRequest},
+	}
+	for name, test := range tests {
+		suite.Run(name, func() {
+			request := suite.validRequest()
+			test.modify(&request)
+			_, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, request)
+			suite.ErrorIs(err, test.err)
+		})
+	}
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestPushInvalidRequestObject() {
+	now := suite.clock.Now()
+	tests := map[string]func(claims map[string]any){
+		"other iss":        func(c map[string]any) { c["iss"] = "client-2" },
+		"no client_id":     func(c map[string]any) { delete(c, "client_id") },
+		"other aud":        func(c map[string]any) { c["aud"] = "https://other.example.com" },
+		"no nbf":           func(c map[string]any) { delete(c, "nbf") },
+		"expired":          func(c map[string]any) { c["exp"] = now.Unix() },
+		"not yet valid":    func(c map[string]any) { c["nbf"] = now.Add(time.Minute).Unix() },
+		"long lifetime":    func(c map[string]any) { c["exp"] = now.Add(61 * time.Minute).Unix() },
+		"nested request":   func(c map[string]any) { c["request_uri"] = "urn:ietf:params:oauth:request_uri:other" },
+		"malformed claims": func(c map[string]any) { c["exp"] = "soon" },
+	}
+	for name, modify := range tests {
+		suite.Run(name, func() {
+			claims := suite.validClaims()
+			modify(claims)
+			_, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{Request: suite.sign(claims)})
+			suite.ErrorIs(err, ErrInvalidRequestObject)
+		})
+	}
+
+	// 署名を検証できないものや、鍵を登録していないクライアントのものは受け付けない
+	requestObject := suite.sign(suite.validClaims())
+	_, err := suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{Request: requestObject + "x"})
+	suite.ErrorIs(err, ErrInvalidRequestObject)
+	suite.client.JWKS = ""
+	_, err = suite.pushedAuthorizationUsecase.Push(context.Background(), suite.client, AuthorizationRequest{Request: requestObject})
+	suite.ErrorIs(err, ErrInvalidRequestObject)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestResolve() {
+	ctx := context.Background()
+	pushed, err := suite.pushedAuthorizationUsecase.Push(ctx, suite.client, suite.validRequest())
+	suite.Require().NoError(err)
+
+	// 他のクライアントからは使えず、使おうとしても消えない
+	_, err = suite.pushedAuthorizationUsecase.Resolve(ctx, "client-2", pushed.RequestURI)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+
+	resolved, err := suite.pushedAuthorizationUsecase.Resolve(ctx, "client-1", pushed.RequestURI)
+	suite.Require().NoError(err)
+	suite.Equal(pushed.Scope, resolved.Scope)
+
+	// 一度しか使えない
+	_, err = suite.pushedAuthorizationUsecase.Resolve(ctx, "client-1", pushed.RequestURI)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+}
+
+func (suite *PushedAuthorizationUsecaseSuite) TestResolveExpired() {
+	ctx := context.Background()
+	pushed, err := suite.pushedAuthorizationUsecase.Push(ctx, suite.client, suite.validRequest())
+	suite.Require().NoError(err)
+
+	suite.pushedAuthorizationUsecase.clock = pkg.FixedClock{T: suite.clock.Now().Add(time.Minute)}
+	_, err = suite.pushedAuthorizationUsecase.Resolve(ctx, "client-1", pushed.RequestURI)
+	suite.ErrorIs(err, ErrInvalidRequestURI)
+
+	// 期限切れのものは次のリクエストを受け付けたときに削除する
+	_, err = suite.pushedAuthorizationUsecase.Push(ctx, suite.client, suite.validRequest())
+	suite.Require().NoError(err)
+	_, err = suite.store.PushedAuthorization().Get(ctx, pushed.RequestURI)
+	suite.Error(err)
+}
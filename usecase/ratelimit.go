@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/pkg/tracing"
+
+	"gorm.io/gorm"
+)
+
+var ErrRateLimited = errors.New("rate limit exceeded")
+
+// RateLimitConfig はレート制限の既定値
+type RateLimitConfig struct {
+	// Client はクライアントごとの既定値。entity.Client の設定で上書きする。
+	Client entity.RateLimit
+	// Customer はクライアントと顧客の組ごとの制限。PerMinute が 0 の場合は制限しない。
+	Customer entity.RateLimit
+	// Source は接続元 IP ごとの制限。認証に失敗するリクエストも数えるため、認証より前に確認する。
+	// PerMinute が 0 の場合は制限しない。
+	Source entity.RateLimit
+	// Registration は動的クライアント登録の接続元 IP ごとの制限。登録はクライアント認証なしで受け付けるため、クライアントの制限とは別に数える。
+	// PerMinute が 0 の場合は制限しない。
+	Registration entity.RateLimit
+	// ClientCacheTTL はクライアントごとの設定を読み直す間隔
+	ClientCacheTTL time.Duration
+}
+
+type RateLimitUsecase interface {
+	// Allow は clientID（cifNo が 0 以外なら顧客も）のバケットから 1 回分を取り出す。
+	// 超過した場合はどのバケットからも取り出さず、ErrRateLimited とともに超過した制限の結果を返す。
+	Allow(ctx context.Context, clientID string, cifNo int) (*gateway.RateLimitResult, error)
+	// AllowSource は認証前のリクエストを数える sourceIP ごとのバケットから 1 回分を取り出す
+	AllowSource(ctx context.Context, sourceIP string) (*gateway.RateLimitResult, error)
+	// AllowRegistration は動的クライアント登録の sourceIP ごとのバケットから 1 回分を取り出す
+	AllowRegistration(ctx context.Context, sourceIP string) (*gateway.RateLimitResult, error)
+}
+
+type rateLimitUsecase struct {
+	store            gateway.RateLimitStore
+	clientRepository gateway.ClientRepository
+	config           RateLimitConfig
+	clock            pkg.Clock
+
+	mu           sync.Mutex
+	clientLimits map[string]clientLimitEntry
+}
+
+type clientLimitEntry struct {
+	limit     entity.RateLimit
+	expiresAt time.Time
+}
+
+func NewRateLimitUsecase(store gateway.RateLimitStore, clientRepository gateway.ClientRepository, config RateLimitConfig, clock pkg.Clock) *rateLimitUsecase {
+	if clock == nil {
+		clock = pkg.RealClock{}
+	}
+	return &rateLimitUsecase{
+		store:            store,
+		clientRepository: clientRepository,
+		config:           config,
+		clock:            clock,
+		clientLimits:     map[string]clientLimitEntry{},
+	}
+}
+
+func (r *rateLimitUsecase) Allow(ctx context.Context, clientID string, cifNo int) (_ *gateway.RateLimitResult, err error) {
+	ctx, span := tracing.Start(ctx, "RateLimitUsecase.Allow")
+	defer func() { tracing.End(span, err) }()
+
+	clientLimit, err := r.clientLimit(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// どちらかが超過した場合はもう一方からも取り出さないよう、まとめて取り出す
+	var keys []gateway.RateLimitKey
+	var scopes []string
+	if clientLimit.Enabled() {
+		keys = append(keys, gateway.RateLimitKey{Key: "client:" + clientID, Limit: clientLimit})
+		scopes = append(scopes, "client")
+	}
+	if cifNo != 0 && r.config.Customer.Enabled() {
+		keys = append(keys, gateway.RateLimitKey{Key: "customer:" + clientID + ":" + strconv.Itoa(cifNo), Limit: r.config.Customer})
+		scopes = append(scopes, "customer")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	return r.take(ctx, keys, scopes)
+}
+
+func (r *rateLimitUsecase) AllowSource(ctx context.Context, sourceIP string) (_ *gateway.RateLimitResult, err error) {
+	ctx, span := tracing.Start(ctx, "RateLimitUsecase.AllowSource")
+	defer func() { tracing.End(span, err) }()
+
+	if !r.config.Source.Enabled() {
+		return nil, nil
+	}
+	return r.take(ctx, []gateway.RateLimitKey{{Key: "source:" + sourceIP, Limit: r.config.Source}}, []string{"source"})
+}
+
+func (r *rateLimitUsecase) AllowRegistration(ctx context.Context, sourceIP string) (_ *gateway.RateLimitResult, err error) {
+	ctx, span := tracing.Start(ctx, "RateLimitUsecase.AllowRegistration")
+	defer func() { tracing.End(span, err) }()
+
+	if !r.config.Registration.Enabled() {
+		return nil, nil
+	}
+	return r.take(ctx, []gateway.RateLimitKey{{Key: "registration:" + sourceIP, Limit: r.config.Registration}}, []string{"registration"})
+}
+
+// take は keys のバケットからまとめて取り出し、超過した場合は scopes の対応する値でメトリクスに数える
+func (r *rateLimitUsecase) take(ctx context.Context, keys []gateway.RateLimitKey, scopes []string) (*gateway.RateLimitResult, error) {
+	results, err := r.store.Take(ctx, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *gateway.RateLimitResult
+	for i := range results {
+		if !results[i].Allowed {
+			metrics.RateLimited(scopes[i])
+			return &results[i], ErrRateLimited
+		}
+		if result == nil || results[i].Remaining < result.Remaining {
+			result = &results[i]
+		}
+	}
+	return result, nil
+}
+
+// clientLimit はクライアントごとの設定を ClientCacheTTL の間キャッシュして返す
+func (r *rateLimitUsecase) clientLimit(ctx context.Context, clientID string) (entity.RateLimit, error) {
+	now := r.clock.Now()
+	r.mu.Lock()
+	entry, ok := r.clientLimits[clientID]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.limit, nil
+	}
+
+	limit := r.config.Client
+	client, err := r.clientRepository.Get(ctx, clientID)
+	switch {
+	case err == nil:
+		limit = client.RateLimit(r.config.Client)
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return entity.RateLimit{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// 存在しないクライアントで埋め尽くされないよう、期限切れのものを掃除する
+	for key, cached := range r.clientLimits {
+		if !now.Before(cached.expiresAt) {
+			delete(r.clientLimits, key)
+		}
+	}
+	r.clientLimits[clientID] = clientLimitEntry{limit: limit, expiresAt: now.Add(r.config.ClientCacheTTL)}
+	return limit, nil
+}
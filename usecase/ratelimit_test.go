@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-banking-api/adapter/gateway"
+	"go-banking-api/entity"
+	"go-banking-api/pkg"
+)
+
+type RateLimitUsecaseSuite struct {
+	suite.Suite
+	clientRepository *mockClientRepository
+	clock            pkg.FixedClock
+	config           RateLimitConfig
+}
+
+func TestRateLimitUsecaseSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitUsecaseSuite))
+}
+
+func (suite *RateLimitUsecaseSuite) SetupTest() {
+	suite.clientRepository = NewMockClientRepository()
+	suite.clock = pkg.FixedClock{T: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	suite.config = RateLimitConfig{
+		Client:         entity.RateLimit{PerMinute: 60, Burst: 2},
+		ClientCacheTTL: time.Minute,
+	}
+}
+
+func (suite *RateLimitUsecaseSuite) newUsecase() *rateLimitUsecase {
+	return NewRateLimitUsecase(gateway.NewMemoryRateLimitStore(100, suite.clock), suite.clientRepository, suite.config, suite.clock)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowDefaultLimit() {
+	suite.clientRepository.On("Get", "client-1").Return(&entity.Client{ClientID: "client-1"}, nil).Once()
+	rateLimitUsecase := suite.newUsecase()
+
+	for i := 0; i < 2; i++ {
+		result, err := rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+		suite.Require().NoError(err)
+		suite.Assert().Equal(2, result.Limit)
+		suite.Assert().Equal(1-i, result.Remaining)
+	}
+
+	result, err := rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+	suite.Assert().ErrorIs(err, ErrRateLimited)
+	suite.Assert().False(result.Allowed)
+	suite.Assert().Equal(time.Second, result.RetryAfter)
+	// クライアントの設定はキャッシュされる
+	suite.clientRepository.AssertNumberOfCalls(suite.T(), "Get", 1)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowClientOverride() {
+	suite.clientRepository.On("Get", "client-1").Return(&entity.Client{ClientID: "client-1", RateLimitPerMinute: 60, RateLimitBurst: 1}, nil)
+	rateLimitUsecase := suite.newUsecase()
+
+	_, err := rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+	suite.Require().NoError(err)
+	_, err = rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+	suite.Assert().ErrorIs(err, ErrRateLimited)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowCustomerLimit() {
+	suite.config.Client = entity.RateLimit{PerMinute: 60, Burst: 10}
+	suite.config.Customer = entity.RateLimit{PerMinute: 60, Burst: 1}
+	suite.clientRepository.On("Get", "client-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	rateLimitUsecase := suite.newUsecase()
+
+	result, err := rateLimitUsecase.Allow(context.Background(), "client-1", 1)
+	suite.Require().NoError(err)
+	// 残りの少ない顧客の制限を返す
+	suite.Assert().Equal(1, result.Limit)
+	suite.Assert().Equal(0, result.Remaining)
+
+	_, err = rateLimitUsecase.Allow(context.Background(), "client-1", 1)
+	suite.Assert().ErrorIs(err, ErrRateLimited)
+
+	// 別の顧客は制限されず、拒否した分はクライアントの制限から差し引かない
+	result, err = rateLimitUsecase.Allow(context.Background(), "client-1", 2)
+	suite.Require().NoError(err)
+	result, err = rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(7, result.Remaining)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowDisabled() {
+	suite.config.Client = entity.RateLimit{}
+	suite.clientRepository.On("Get", "client-1").Return(&entity.Client{ClientID: "client-1"}, nil)
+	rateLimitUsecase := suite.newUsecase()
+
+	for i := 0; i < 10; i++ {
+		result, err := rateLimitUsecase.Allow(context.Background(), "client-1", 1)
+		suite.Require().NoError(err)
+		suite.Assert().Nil(result)
+	}
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowUnknownClientUsesDefault() {
+	suite.clientRepository.On("Get", "unknown").Return(nil, gorm.ErrRecordNotFound)
+	rateLimitUsecase := suite.newUsecase()
+
+	result, err := rateLimitUsecase.Allow(context.Background(), "unknown", 0)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(2, result.Limit)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowRepositoryError() {
+	suite.clientRepository.On("Get", "client-1").Return(nil, errors.New("db error"))
+	rateLimitUsecase := suite.newUsecase()
+
+	_, err := rateLimitUsecase.Allow(context.Background(), "client-1", 0)
+	suite.Assert().EqualError(err, "db error")
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowRegistration() {
+	suite.config.Registration = entity.RateLimit{PerMinute: 10, Burst: 1}
+	rateLimitUsecase := suite.newUsecase()
+
+	result, err := rateLimitUsecase.AllowRegistration(context.Background(), "192.0.2.1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(1, result.Limit)
+	_, err = rateLimitUsecase.AllowRegistration(context.Background(), "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrRateLimited)
+	// 接続元 IP ごとに数え、クライアントの設定は読まない
+	_, err = rateLimitUsecase.AllowRegistration(context.Background(), "192.0.2.2")
+	suite.Assert().NoError(err)
+	suite.clientRepository.AssertNumberOfCalls(suite.T(), "Get", 0)
+
+	suite.config.Registration = entity.RateLimit{}
+	result, err = suite.newUsecase().AllowRegistration(context.Background(), "192.0.2.1")
+	suite.Require().NoError(err)
+	suite.Assert().Nil(result)
+}
+
+func (suite *RateLimitUsecaseSuite) TestAllowSource() {
+	suite.config.Source = entity.RateLimit{PerMinute: 60, Burst: 1}
+	rateLimitUsecase := suite.newUsecase()
+
+	result, err := rateLimitUsecase.AllowSource(context.Background(), "192.0.2.1")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(1, result.Limit)
+	_, err = rateLimitUsecase.AllowSource(context.Background(), "192.0.2.1")
+	suite.Assert().ErrorIs(err, ErrRateLimited)
+	_, err = rateLimitUsecase.AllowSource(context.Background(), "192.0.2.2")
+	suite.Assert().NoError(err)
+	// 登録の制限とは別に数える
+	suite.config.Registration = entity.RateLimit{PerMinute: 10, Burst: 1}
+	rateLimitUsecase = suite.newUsecase()
+	_, err = rateLimitUsecase.AllowSource(context.Background(), "192.0.2.1")
+	suite.Require().NoError(err)
+	_, err = rateLimitUsecase.AllowRegistration(context.Background(), "192.0.2.1")
+	suite.Assert().NoError(err)
+	suite.clientRepository.AssertNumberOfCalls(suite.T(), "Get", 0)
+
+	suite.config.Source = entity.RateLimit{}
+	result, err = suite.newUsecase().AllowSource(context.Background(), "192.0.2.1")
+	suite.Require().NoError(err)
+	suite.Assert().Nil(result)
+}
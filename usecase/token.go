@@ -1,67 +1,111 @@
 package usecase
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
+	"strconv"
 	"time"
 
 	"go-banking-api/adapter/gateway"
 	"go-banking-api/entity"
 	"go-banking-api/pkg"
+	"go-banking-api/pkg/logger"
+	"go-banking-api/pkg/metrics"
+	"go-banking-api/pkg/tracing"
 
 	"gorm.io/gorm"
 )
 
 type TokenUsecase interface {
-	Validate(accessTokenFromHeader string, requiredScope string) (*entity.Token, error)
-	Refresh(refreshToken string, clientID string) (*entity.Token, error)
+	Validate(ctx context.Context, accessTokenFromHeader string, requiredScope string) (*entity.Token, error)
+	// Refresh は client が refresh_token グラントを許可されていない場合 ErrUnauthorizedClient を返す
+	Refresh(ctx context.Context, refreshToken string, client *entity.Client) (*entity.Token, error)
 }
 
 type tokenUsecase struct {
-	tokenRepository gateway.TokenRepository
-	clock           pkg.Clock
+	tokenRepository    gateway.TokenRepository
+	auditRepository    gateway.AuditRepository
+	transactionManager TransactionManager
+	idTokenIssuer      IDTokenIssuer
+	clock              pkg.Clock
 }
 
 const accessTokenTTL = time.Hour
 
 var (
+	ErrAccessTokenRequired  = errors.New("access token is required")
+	ErrInvalidAccessToken   = errors.New("invalid access token")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrInvalidScope         = errors.New("invalid scope")
 	ErrRefreshTokenRequired = errors.New("refresh token is required")
 	ErrInvalidRefreshToken  = errors.New("invalid refresh token")
 )
 
-func NewTokenUsecase(tokenRepository gateway.TokenRepository, clock pkg.Clock) *tokenUsecase {
+func NewTokenUsecase(tokenRepository gateway.TokenRepository, auditRepository gateway.AuditRepository, transactionManager TransactionManager, idTokenIssuer IDTokenIssuer, clock pkg.Clock) *tokenUsecase {
 	if clock == nil {
 		clock = pkg.RealClock{}
 	}
-	return &tokenUsecase{tokenRepository: tokenRepository, clock: clock}
+	return &tokenUsecase{
+		tokenRepository:    tokenRepository,
+		auditRepository:    auditRepository,
+		transactionManager: transactionManager,
+		idTokenIssuer:      idTokenIssuer,
+		clock:              clock,
+	}
 }
 
-func (t *tokenUsecase) Validate(accessTokenFromHeader string, requiredScope string) (*entity.Token, error) {
+func (t *tokenUsecase) Validate(ctx context.Context, accessTokenFromHeader string, requiredScope string) (_ *entity.Token, err error) {
+	ctx, span := tracing.Start(ctx, "TokenUsecase.Validate")
+	defer func() {
+		if err != nil {
+			metrics.TokenValidationFailed(failureReason(err))
+		}
+		tracing.End(span, err)
+	}()
+
 	if accessTokenFromHeader == "" {
-		return nil, errors.New("access token is required")
+		return nil, ErrAccessTokenRequired
 	}
 
-	storedToken, err := t.tokenRepository.Get(accessTokenFromHeader)
+	storedToken, err := t.tokenRepository.Get(ctx, accessTokenFromHeader)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("invalid access token")
+			return nil, ErrInvalidAccessToken
 		}
 		return nil, err
 	}
 
 	if storedToken.IsExpired(t.clock) {
-		return nil, errors.New("token expired")
+		return nil, ErrTokenExpired
 	}
 
 	if !storedToken.HasScope(requiredScope) {
-		return nil, errors.New("invalid scope")
+		return nil, ErrInvalidScope
 	}
 
 	return storedToken, nil
 }
 
-func (t *tokenUsecase) Refresh(refreshToken string, clientID string) (*entity.Token, error) {
+func (t *tokenUsecase) Refresh(ctx context.Context, refreshToken string, client *entity.Client) (_ *entity.Token, err error) {
+	ctx, span := tracing.Start(ctx, "TokenUsecase.Refresh")
+	clientID := client.ClientID
+	// cifNo はリフレッシュトークンが見つかった場合のみ監査ログに残す
+	cifNo := 0
+	defer func() {
+		if err != nil {
+			metrics.TokenRefreshFailed(failureReason(err))
+			recordAuditEvent(ctx, t.auditRepository, newAuditEvent(ctx, t.clock, entity.AuditOperationTokenRefresh, clientID, cifNo, err))
+		} else {
+			metrics.TokenIssued("refresh_token")
+		}
+		tracing.End(span, err)
+	}()
+
+	if !client.AllowsGrantType(entity.GrantTypeRefreshToken) {
+		return nil, ErrUnauthorizedClient
+	}
 	if refreshToken == "" {
 		return nil, ErrRefreshTokenRequired
 	}
@@ -69,17 +113,6 @@ func (t *tokenUsecase) Refresh(refreshToken string, clientID string) (*entity.To
 		return nil, ErrInvalidRefreshToken
 	}
 
-	storedToken, err := t.tokenRepository.GetByRefreshToken(refreshToken)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrInvalidRefreshToken
-		}
-		return nil, err
-	}
-	if storedToken.ClientID != clientID {
-		return nil, ErrInvalidRefreshToken
-	}
-
 	accessToken, err := generateToken()
 	if err != nil {
 		return nil, err
@@ -88,20 +121,57 @@ func (t *tokenUsecase) Refresh(refreshToken string, clientID string) (*entity.To
 	if err != nil {
 		return nil, err
 	}
-
 	expiresAt := t.clock.Now().Add(accessTokenTTL)
-	if err := t.tokenRepository.UpdateByRefreshToken(refreshToken, accessToken, newRefreshToken, expiresAt); err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrInvalidRefreshToken
+
+	var idToken, scopes string
+	err = t.transactionManager.Do(ctx, func(repos gateway.Repositories) error {
+		storedToken, err := repos.Token().GetByRefreshToken(ctx, refreshToken)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return err
+		}
+		cifNo = storedToken.CifNo
+		scopes = storedToken.Scopes
+		if storedToken.ClientID != clientID {
+			logger.WarnContext(ctx, "refresh token presented by another client", "subject", logger.HashSubject(strconv.Itoa(storedToken.CifNo)))
+			return ErrInvalidRefreshToken
 		}
+		// 同意の期限が切れたら、リフレッシュトークンが残っていても顧客に同意し直してもらう
+		if err := checkConsent(ctx, repos, t.clock, storedToken.CifNo, clientID); err != nil {
+			return err
+		}
+		// 署名できなければ古いリフレッシュトークンを残したまま失敗させる
+		if t.idTokenIssuer != nil && storedToken.HasScope(OpenIDScope) {
+			if idToken, err = t.idTokenIssuer.Issue(storedToken.CifNo, clientID, ""); err != nil {
+				return err
+			}
+		}
+
+		if err := repos.Token().UpdateByRefreshToken(ctx, refreshToken, accessToken, newRefreshToken, expiresAt); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidRefreshToken
+			}
+			return err
+		}
+		// 発行の記録はトークンの更新と同じトランザクションで行い、記録できなければ発行しない
+		return repos.Audit().Append(ctx, newAuditEvent(ctx, t.clock, entity.AuditOperationTokenRefresh, clientID, cifNo, nil))
+	})
+	if err != nil {
 		return nil, err
 	}
+	logger.InfoContext(ctx, "token refreshed")
 
+	// スコープと顧客は再発行しても変わらない
 	return &entity.Token{
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
 		ClientID:     clientID,
+		CifNo:        cifNo,
+		Scopes:       scopes,
 		ExpiresAt:    expiresAt,
+		IDToken:      idToken,
 	}, nil
 }
 
@@ -1,14 +1,20 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"gorm.io/gorm"
 
+	"go-banking-api/adapter/gateway"
 	"go-banking-api/entity"
 	"go-banking-api/pkg"
 )
@@ -21,7 +27,7 @@ func NewMockTokenRepository() *mockTokenRepository {
 	return &mockTokenRepository{}
 }
 
-func (m *mockTokenRepository) Get(token string) (*entity.Token, error) {
+func (m *mockTokenRepository) Get(_ context.Context, token string) (*entity.Token, error) {
 	args := m.Called(token)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -29,7 +35,7 @@ func (m *mockTokenRepository) Get(token string) (*entity.Token, error) {
 	return args.Get(0).(*entity.Token), args.Error(1)
 }
 
-func (m *mockTokenRepository) GetByRefreshToken(refreshToken string) (*entity.Token, error) {
+func (m *mockTokenRepository) GetByRefreshToken(_ context.Context, refreshToken string) (*entity.Token, error) {
 	args := m.Called(refreshToken)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
@@ -37,11 +43,85 @@ func (m *mockTokenRepository) GetByRefreshToken(refreshToken string) (*entity.To
 	return args.Get(0).(*entity.Token), args.Error(1)
 }
 
-func (m *mockTokenRepository) UpdateByRefreshToken(refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
+func (m *mockTokenRepository) UpdateByRefreshToken(_ context.Context, refreshToken string, accessToken string, newRefreshToken string, expiresAt time.Time) error {
 	args := m.Called(refreshToken, accessToken, newRefreshToken, expiresAt)
 	return args.Error(0)
 }
 
+func (m *mockTokenRepository) Create(_ context.Context, token *entity.Token) error {
+	args := m.Called(token)
+	return args.Error(0)
+}
+
+func (m *mockTokenRepository) DeleteByClientID(_ context.Context, clientID string) (int64, error) {
+	args := m.Called(clientID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockTokenRepository) DeleteByCustomerAndClient(_ context.Context, cifNo int, clientID string) (int64, error) {
+	args := m.Called(cifNo, clientID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type mockRepositories struct {
+	tokenRepository   gateway.TokenRepository
+	auditRepository   gateway.AuditRepository
+	consentRepository gateway.ConsentRepository
+}
+
+func (m *mockRepositories) Customer() gateway.CustomerRepository {
+	return nil
+}
+
+func (m *mockRepositories) Account() gateway.AccountRepository {
+	return nil
+}
+
+func (m *mockRepositories) Client() gateway.ClientRepository {
+	return nil
+}
+
+func (m *mockRepositories) Token() gateway.TokenRepository {
+	return m.tokenRepository
+}
+
+func (m *mockRepositories) Audit() gateway.AuditRepository {
+	return m.auditRepository
+}
+
+func (m *mockRepositories) AuthLockout() gateway.AuthLockoutRepository {
+	return nil
+}
+
+func (m *mockRepositories) Consent() gateway.ConsentRepository {
+	return m.consentRepository
+}
+
+func (m *mockRepositories) PushedAuthorization() gateway.PushedAuthorizationRepository {
+	return nil
+}
+
+func (m *mockRepositories) DeviceAuthorization() gateway.DeviceAuthorizationRepository {
+	return nil
+}
+
+func (m *mockRepositories) AuthorizationCode() gateway.AuthorizationCodeRepository {
+	return nil
+}
+
+// mockTransactionManager は fn をそのまま実行し、トランザクション内のリポジトリとしてモックを渡す
+type mockTransactionManager struct {
+	repos gateway.Repositories
+}
+
+func NewMockTransactionManager(tokenRepository gateway.TokenRepository, auditRepository gateway.AuditRepository, consentRepository gateway.ConsentRepository) *mockTransactionManager {
+	return &mockTransactionManager{repos: &mockRepositories{tokenRepository: tokenRepository, auditRepository: auditRepository, consentRepository: consentRepository}}
+}
+
+func (m *mockTransactionManager) Do(_ context.Context, fn func(repos gateway.Repositories) error) error {
+	return fn(m.repos)
+}
+
 type TokenUsecaseSuite struct {
 	suite.Suite
 	tokenUsecase *tokenUsecase
@@ -53,9 +133,10 @@ func TestTokenUsecaseTestSuite(t *testing.T) {
 
 func (suite *TokenUsecaseSuite) TestValidate() {
 	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
 	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
 	clock := pkg.FixedClock{T: fixedNow}
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, clock)
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, clock)
 
 	expiresAt := fixedNow.Add(1 * time.Hour)
 	requiredScope := "read:account_and_transactions"
@@ -66,16 +147,37 @@ func (suite *TokenUsecaseSuite) TestValidate() {
 		CifNo:       1,
 	}, nil)
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", requiredScope)
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", requiredScope)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("access-token-1", token.AccessToken)
 }
 
+func (suite *TokenUsecaseSuite) TestValidateSpan() {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
+	mockTokenRepository.On("Get", "access-token-1").Return(nil, gorm.ErrRecordNotFound)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+	_, err := suite.tokenUsecase.Validate(ctx, "access-token-1", "read:account_and_transactions")
+	parent.End()
+	suite.Assert().ErrorIs(err, ErrInvalidAccessToken)
+
+	spans := recorder.Ended()
+	suite.Require().Len(spans, 2)
+	suite.Assert().Equal("TokenUsecase.Validate", spans[0].Name())
+	suite.Assert().Equal(parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	suite.Assert().Equal(codes.Error, spans[0].Status().Code)
+}
+
 func (suite *TokenUsecaseSuite) TestValidateEmptyAccessToken() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
-	token, err := suite.tokenUsecase.Validate("", "read:account_and_transactions")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "", "read:account_and_transactions")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("access token is required", err.Error())
@@ -83,11 +185,12 @@ func (suite *TokenUsecaseSuite) TestValidateEmptyAccessToken() {
 
 func (suite *TokenUsecaseSuite) TestValidateInvalidAccessToken() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
 	mockTokenRepository.On("Get", "access-token-1").Return(nil, gorm.ErrRecordNotFound)
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", "read:account_and_transactions")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", "read:account_and_transactions")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("invalid access token", err.Error())
@@ -95,11 +198,12 @@ func (suite *TokenUsecaseSuite) TestValidateInvalidAccessToken() {
 
 func (suite *TokenUsecaseSuite) TestValidateRepositoryError() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
 	mockTokenRepository.On("Get", "access-token-1").Return(nil, errors.New("get error"))
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", "read:account_and_transactions")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", "read:account_and_transactions")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -107,9 +211,10 @@ func (suite *TokenUsecaseSuite) TestValidateRepositoryError() {
 
 func (suite *TokenUsecaseSuite) TestValidateExpired() {
 	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
 	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
 	clock := pkg.FixedClock{T: fixedNow}
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, clock)
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, clock)
 
 	mockTokenRepository.On("Get", "access-token-1").Return(&entity.Token{
 		AccessToken: "access-token-1",
@@ -118,7 +223,7 @@ func (suite *TokenUsecaseSuite) TestValidateExpired() {
 		CifNo:       1,
 	}, nil)
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", "read:account_and_transactions")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", "read:account_and_transactions")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("token expired", err.Error())
@@ -126,9 +231,10 @@ func (suite *TokenUsecaseSuite) TestValidateExpired() {
 
 func (suite *TokenUsecaseSuite) TestValidateInvalidScope() {
 	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
 	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
 	clock := pkg.FixedClock{T: fixedNow}
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, clock)
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, clock)
 
 	mockTokenRepository.On("Get", "access-token-1").Return(&entity.Token{
 		AccessToken: "access-token-1",
@@ -137,7 +243,7 @@ func (suite *TokenUsecaseSuite) TestValidateInvalidScope() {
 		CifNo:       1,
 	}, nil)
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", "read:account_and_transactions")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", "read:account_and_transactions")
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("invalid scope", err.Error())
@@ -145,9 +251,10 @@ func (suite *TokenUsecaseSuite) TestValidateInvalidScope() {
 
 func (suite *TokenUsecaseSuite) TestValidateScopeNotRequired() {
 	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
 	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
 	clock := pkg.FixedClock{T: fixedNow}
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, clock)
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, clock)
 
 	mockTokenRepository.On("Get", "access-token-1").Return(&entity.Token{
 		AccessToken: "access-token-1",
@@ -156,22 +263,32 @@ func (suite *TokenUsecaseSuite) TestValidateScopeNotRequired() {
 		CifNo:       1,
 	}, nil)
 
-	token, err := suite.tokenUsecase.Validate("access-token-1", "")
+	token, err := suite.tokenUsecase.Validate(context.Background(), "access-token-1", "")
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("access-token-1", token.AccessToken)
 }
 
+// refreshClient は refresh_token グラントを許可したクライアントを返す
+func refreshClient(clientID string) *entity.Client {
+	return &entity.Client{ClientID: clientID, GrantTypes: entity.GrantTypeRefreshToken}
+}
+
 func (suite *TokenUsecaseSuite) TestRefresh() {
 	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
 	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
 	clock := pkg.FixedClock{T: fixedNow}
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, clock)
+	mockConsentRepository := NewMockConsentRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, mockConsentRepository), nil, clock)
 
 	expectedExpiresAt := fixedNow.Add(1 * time.Hour)
 	mockTokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(&entity.Token{
 		RefreshToken: "refresh-token-1",
 		ClientID:     "client-1",
+		CifNo:        1,
+		Scopes:       "read:account_and_transactions",
 	}, nil)
+	mockConsentRepository.On("Get", 1, "client-1").Return(&entity.Consent{ExpiresAt: fixedNow.Add(time.Hour)}, nil)
 	mockTokenRepository.On(
 		"UpdateByRefreshToken",
 		"refresh-token-1",
@@ -180,30 +297,47 @@ func (suite *TokenUsecaseSuite) TestRefresh() {
 		expectedExpiresAt,
 	).Return(nil)
 
-	token, err := suite.tokenUsecase.Refresh("refresh-token-1", "client-1")
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "refresh-token-1", refreshClient("client-1"))
 	suite.Assert().Nil(err)
 	suite.Assert().NotEmpty(token.AccessToken)
 	suite.Assert().NotEmpty(token.RefreshToken)
 	suite.Assert().Equal(expectedExpiresAt, token.ExpiresAt)
+	// スコープと顧客は元のトークンから引き継ぐ
+	suite.Assert().Equal("read:account_and_transactions", token.Scopes)
+	suite.Assert().Equal(1, token.CifNo)
 }
 
 func (suite *TokenUsecaseSuite) TestRefreshEmptyRefreshToken() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
-	token, err := suite.tokenUsecase.Refresh("", "client-1")
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "", refreshClient("client-1"))
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("refresh token is required", err.Error())
 }
 
+func (suite *TokenUsecaseSuite) TestRefreshUnauthorizedClient() {
+	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
+
+	// デバイス認可グラントだけを許可したクライアントはリフレッシュトークンを使えない
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "refresh-token-1", &entity.Client{ClientID: "client-1", GrantTypes: entity.GrantTypeDeviceCode})
+	suite.Assert().Nil(token)
+	suite.Assert().ErrorIs(err, ErrUnauthorizedClient)
+	mockTokenRepository.AssertNotCalled(suite.T(), "GetByRefreshToken", mock.Anything)
+}
+
 func (suite *TokenUsecaseSuite) TestRefreshInvalidRefreshToken() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
 	mockTokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(nil, gorm.ErrRecordNotFound)
 
-	token, err := suite.tokenUsecase.Refresh("refresh-token-1", "client-1")
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "refresh-token-1", refreshClient("client-1"))
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("invalid refresh token", err.Error())
@@ -211,14 +345,15 @@ func (suite *TokenUsecaseSuite) TestRefreshInvalidRefreshToken() {
 
 func (suite *TokenUsecaseSuite) TestRefreshClientMismatch() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, nil), nil, pkg.FixedClock{T: time.Now()})
 
 	mockTokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(&entity.Token{
 		RefreshToken: "refresh-token-1",
 		ClientID:     "client-1",
 	}, nil)
 
-	token, err := suite.tokenUsecase.Refresh("refresh-token-1", "client-2")
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "refresh-token-1", refreshClient("client-2"))
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("invalid refresh token", err.Error())
@@ -226,17 +361,43 @@ func (suite *TokenUsecaseSuite) TestRefreshClientMismatch() {
 
 func (suite *TokenUsecaseSuite) TestRefreshUpdateError() {
 	mockTokenRepository := NewMockTokenRepository()
-	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, pkg.FixedClock{T: time.Now()})
+	mockAuditRepository := NewMockAuditRepository()
+	mockConsentRepository := NewMockConsentRepository()
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, mockConsentRepository), nil, pkg.FixedClock{T: time.Now()})
 
 	mockTokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(&entity.Token{
 		RefreshToken: "refresh-token-1",
 		ClientID:     "client-1",
 	}, nil)
+	mockConsentRepository.On("Get", 0, "client-1").Return(&entity.Consent{ExpiresAt: time.Now().Add(time.Hour)}, nil)
 	mockTokenRepository.On("UpdateByRefreshToken", "refresh-token-1", mock.Anything, mock.Anything, mock.Anything).
 		Return(errors.New("update error"))
 
-	token, err := suite.tokenUsecase.Refresh("refresh-token-1", "client-1")
+	token, err := suite.tokenUsecase.Refresh(context.Background(), "refresh-token-1", refreshClient("client-1"))
 	suite.Assert().Nil(token)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("update error", err.Error())
 }
+
+func (suite *TokenUsecaseSuite) TestRefreshConsentExpired() {
+	mockTokenRepository := NewMockTokenRepository()
+	mockAuditRepository := NewMockAuditRepository()
+	mockConsentRepository := NewMockConsentRepository()
+	fixedNow := time.Date(2025, 12, 21, 0, 0, 0, 0, time.UTC)
+	suite.tokenUsecase = NewTokenUsecase(mockTokenRepository, mockAuditRepository, NewMockTransactionManager(mockTokenRepository, mockAuditRepository, mockConsentRepository), nil, pkg.FixedClock{T: fixedNow})
+
+	mockTokenRepository.On("GetByRefreshToken", "refresh-token-1").Return(&entity.Token{RefreshToken: "refresh-token-1", ClientID: "client-1", CifNo: 1}, nil)
+	mockTokenRepository.On("GetByRefreshToken", "refresh-token-2").Return(&entity.Token{RefreshToken: "refresh-token-2", ClientID: "client-1", CifNo: 2}, nil)
+	mockTokenRepository.On("GetByRefreshToken", "refresh-token-3").Return(&entity.Token{RefreshToken: "refresh-token-3", ClientID: "client-1", CifNo: 3}, nil)
+	mockConsentRepository.On("Get", 1, "client-1").Return(&entity.Consent{ExpiresAt: fixedNow}, nil)
+	mockConsentRepository.On("Get", 2, "client-1").Return(&entity.Consent{ExpiresAt: fixedNow.Add(time.Hour), RevokedAt: &fixedNow}, nil)
+	mockConsentRepository.On("Get", 3, "client-1").Return(nil, gorm.ErrRecordNotFound)
+
+	// 期限切れ・取り消し済み・同意なしのいずれもトークンを更新しない
+	for _, refreshToken := range []string{"refresh-token-1", "refresh-token-2", "refresh-token-3"} {
+		token, err := suite.tokenUsecase.Refresh(context.Background(), refreshToken, refreshClient("client-1"))
+		suite.Assert().Nil(token)
+		suite.Assert().ErrorIs(err, ErrConsentExpired, refreshToken)
+	}
+	mockTokenRepository.AssertNotCalled(suite.T(), "UpdateByRefreshToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
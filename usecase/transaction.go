@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+
+	"go-banking-api/adapter/gateway"
+)
+
+// TransactionManager は複数のリポジトリにまたがる処理を一つのトランザクションで実行する
+type TransactionManager interface {
+	Do(ctx context.Context, fn func(repos gateway.Repositories) error) error
+}